@@ -43,6 +43,10 @@ type InjectorControllerOptions struct {
 	RenewDeadline           time.Duration
 	RetryPeriod             time.Duration
 
+	// InjectorsConfigFile is the path to a file configuring injectors for
+	// resource types that have no built-in injector.
+	InjectorsConfigFile string
+
 	StdOut io.Writer
 	StdErr io.Writer
 
@@ -74,6 +78,12 @@ func (o *InjectorControllerOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.DurationVar(&o.RetryPeriod, "leader-election-retry-period", 2*time.Second, ""+
 		"The duration the clients should wait between attempting acquisition and renewal "+
 		"of a leadership. This is only applicable if leader election is enabled.")
+	fs.StringVar(&o.InjectorsConfigFile, "injectors-config", "", ""+
+		"Optional path to a file configuring injectors for resource types that have no "+
+		"built-in injector, such as custom resources. Each injector names the group, "+
+		"version and kind of a resource type, and the paths of the fields that CA bundles "+
+		"are injected into. cainjector must be allowed to get, list, watch and update "+
+		"the configured resources, and fails to start if any of them are not served.")
 }
 
 func NewInjectorControllerOptions(out, errOut io.Writer) *InjectorControllerOptions {
@@ -116,6 +126,16 @@ servers and webhook servers.`,
 }
 
 func (o InjectorControllerOptions) RunInjectorController(ctx context.Context) error {
+	var injectors []cainjector.InjectorConfig
+	if o.InjectorsConfigFile != "" {
+		var err error
+		injectors, err = cainjector.LoadInjectorsConfig(o.InjectorsConfigFile)
+		if err != nil {
+			return err
+		}
+		o.log.V(logf.InfoLevel).Info("loaded configured injectors", "injectors_config", o.InjectorsConfigFile, "count", len(injectors))
+	}
+
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 		Scheme:                  api.Scheme,
 		Namespace:               o.Namespace,
@@ -163,7 +183,7 @@ func (o InjectorControllerOptions) RunInjectorController(ctx context.Context) er
 	// Never retry if the controller exits cleanly.
 	g.Go(func() (err error) {
 		for {
-			err = cainjector.RegisterCertificateBased(gctx, mgr)
+			err = cainjector.RegisterCertificateBased(gctx, mgr, injectors)
 			if err == nil {
 				return
 			}
@@ -182,7 +202,7 @@ func (o InjectorControllerOptions) RunInjectorController(ctx context.Context) er
 	// We do not retry this controller because it only interacts with core APIs
 	// which should always be in a working state.
 	g.Go(func() (err error) {
-		if err = cainjector.RegisterSecretBased(gctx, mgr); err != nil {
+		if err = cainjector.RegisterSecretBased(gctx, mgr, injectors); err != nil {
 			return fmt.Errorf("error registering secret controller: %v", err)
 		}
 		return
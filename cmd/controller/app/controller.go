@@ -18,13 +18,17 @@ package app
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"sync"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/labels"
 	kubeinformers "k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -43,6 +47,7 @@ import (
 	informers "github.com/jetstack/cert-manager/pkg/client/informers/externalversions"
 	"github.com/jetstack/cert-manager/pkg/controller"
 	"github.com/jetstack/cert-manager/pkg/controller/clusterissuers"
+	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/embedded"
 	dnsutil "github.com/jetstack/cert-manager/pkg/issuer/acme/dns/util"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
 	"github.com/jetstack/cert-manager/pkg/metrics"
@@ -76,6 +81,19 @@ func Run(opts *options.ControllerOptions, stopCh <-chan struct{}) {
 		os.Exit(1)
 	}
 
+	// The embedded DNS01 server is run on every replica, regardless of leader
+	// election, so that any replica is able to answer queries.
+	if ctx.DNS01ServerRecords != nil {
+		ctx.SharedInformerFactory.Acme().V1().Challenges().Informer().AddEventHandler(embedded.ChallengeEventHandler(ctx.DNS01ServerRecords))
+		ctx.SharedInformerFactory.Start(stopCh)
+
+		dnsServer := &embedded.Server{ListenAddress: opts.DNS01ServerListenAddress, Records: ctx.DNS01ServerRecords}
+		if err := dnsServer.Start(log, stopCh); err != nil {
+			log.Error(err, "failed to start DNS01 server", "address", opts.DNS01ServerListenAddress)
+			os.Exit(1)
+		}
+	}
+
 	var wg sync.WaitGroup
 	run := func(_ context.Context) {
 		for n, fn := range controller.Known() {
@@ -204,6 +222,27 @@ func buildControllerContext(ctx context.Context, stopCh <-chan struct{}, opts *o
 
 	acmeAccountRegistry := accounts.NewDefaultRegistry()
 
+	var additionalSecretTargetNamespaceSelector labels.Selector
+	if len(opts.AdditionalSecretTargetNamespaceSelector) > 0 {
+		additionalSecretTargetNamespaceSelector, err = labels.Parse(opts.AdditionalSecretTargetNamespaceSelector)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error parsing AdditionalSecretTargetNamespaceSelector: %s", err.Error())
+		}
+	}
+
+	var externalApproverTLSConfig *tls.Config
+	if len(opts.ExternalApproverURL) > 0 {
+		externalApproverTLSConfig, err = buildExternalApproverTLSConfig(opts)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error building external approver TLS config: %s", err.Error())
+		}
+	}
+
+	var dns01ServerRecords *embedded.RecordSet
+	if len(opts.DNS01ServerListenAddress) > 0 {
+		dns01ServerRecords = embedded.NewRecordSet()
+	}
+
 	return &controller.Context{
 		RootContext:               ctx,
 		StopCh:                    stopCh,
@@ -226,6 +265,8 @@ func buildControllerContext(ctx context.Context, stopCh <-chan struct{}, opts *o
 			DNS01Nameservers:                  nameservers,
 			AccountRegistry:                   acmeAccountRegistry,
 			DNS01CheckRetryPeriod:             opts.DNS01CheckRetryPeriod,
+			DNS01ServerRecords:                dns01ServerRecords,
+			DNS01BatchWindow:                  opts.DNS01BatchWindow,
 		},
 		IssuerOptions: controller.IssuerOptions{
 			ClusterIssuerAmbientCredentials: opts.ClusterIssuerAmbientCredentials,
@@ -239,14 +280,54 @@ func buildControllerContext(ctx context.Context, stopCh <-chan struct{}, opts *o
 			DefaultAutoCertificateAnnotations: opts.DefaultAutoCertificateAnnotations,
 		},
 		CertificateOptions: controller.CertificateOptions{
-			EnableOwnerRef: opts.EnableCertificateOwnerRef,
+			EnableOwnerRef:                          opts.EnableCertificateOwnerRef,
+			DefaultRenewBeforePercentage:            opts.DefaultRenewBeforePercentage,
+			DefaultRenewalJitter:                    opts.DefaultRenewalJitter,
+			IssuanceRetryBackoffMin:                 opts.IssuanceRetryBackoffMin,
+			IssuanceRetryBackoffMax:                 opts.IssuanceRetryBackoffMax,
+			AdditionalSecretTargetNamespaceSelector: additionalSecretTargetNamespaceSelector,
 		},
 		SchedulerOptions: controller.SchedulerOptions{
 			MaxConcurrentChallenges: opts.MaxConcurrentChallenges,
 		},
+		ExternalApproverOptions: controller.ExternalApproverOptions{
+			ExternalApproverURL:           opts.ExternalApproverURL,
+			ExternalApproverTLSConfig:     externalApproverTLSConfig,
+			ExternalApproverTimeout:       opts.ExternalApproverTimeout,
+			ExternalApproverFailurePolicy: opts.ExternalApproverFailurePolicy,
+			ExternalApproverCacheTTL:      opts.ExternalApproverCacheTTL,
+		},
 	}, kubeCfg, nil
 }
 
+// buildExternalApproverTLSConfig returns the TLS config used to connect to
+// the external approver, using the configured CA and client certificate.
+func buildExternalApproverTLSConfig(opts *options.ControllerOptions) (*tls.Config, error) {
+	tlsConfig := &tls.Config{}
+
+	if len(opts.ExternalApproverCAFile) > 0 {
+		caPEM, err := ioutil.ReadFile(opts.ExternalApproverCAFile)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("no certificates found in %s", opts.ExternalApproverCAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if len(opts.ExternalApproverClientCertificateFile) > 0 {
+		cert, err := tls.LoadX509KeyPair(opts.ExternalApproverClientCertificateFile, opts.ExternalApproverClientKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
 func startLeaderElection(ctx context.Context, opts *options.ControllerOptions, leaderElectionClient kubernetes.Interface, recorder record.EventRecorder, run func(context.Context)) {
 	log := logf.FromContext(ctx, "leader-election")
 
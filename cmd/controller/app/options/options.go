@@ -19,18 +19,23 @@ package options
 import (
 	"fmt"
 	"net"
+	"net/url"
 	"strings"
 	"time"
 
 	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	cm "github.com/jetstack/cert-manager/pkg/apis/certmanager"
 	challengescontroller "github.com/jetstack/cert-manager/pkg/controller/acmechallenges"
 	orderscontroller "github.com/jetstack/cert-manager/pkg/controller/acmeorders"
+	bundlescontroller "github.com/jetstack/cert-manager/pkg/controller/bundles"
 	cracmecontroller "github.com/jetstack/cert-manager/pkg/controller/certificaterequests/acme"
 	crapprovercontroller "github.com/jetstack/cert-manager/pkg/controller/certificaterequests/approver"
 	crcacontroller "github.com/jetstack/cert-manager/pkg/controller/certificaterequests/ca"
+	crexternalapprovercontroller "github.com/jetstack/cert-manager/pkg/controller/certificaterequests/externalapprover"
+	crpolicyapprovercontroller "github.com/jetstack/cert-manager/pkg/controller/certificaterequests/policyapprover"
 	crselfsignedcontroller "github.com/jetstack/cert-manager/pkg/controller/certificaterequests/selfsigned"
 	crvaultcontroller "github.com/jetstack/cert-manager/pkg/controller/certificaterequests/vault"
 	crvenaficontroller "github.com/jetstack/cert-manager/pkg/controller/certificaterequests/venafi"
@@ -93,6 +98,37 @@ type ControllerOptions struct {
 
 	EnableCertificateOwnerRef bool
 
+	// The percentage of a certificate's duration before expiry at which it
+	// should be renewed, for Certificates that do not configure this
+	// themselves. Zero means 2/3 through the certificate's duration.
+	DefaultRenewBeforePercentage int32
+	// The maximum amount of time by which certificate renewals are brought
+	// forward, for Certificates that do not configure this themselves.
+	DefaultRenewalJitter time.Duration
+	// The amount of time after a failed issuance before it is first
+	// retried, and the maximum that this grows to after repeated failures,
+	// for Certificates that do not configure this themselves.
+	IssuanceRetryBackoffMin time.Duration
+	IssuanceRetryBackoffMax time.Duration
+	// A label selector for the namespaces that Certificates may copy their
+	// Secret to using spec.additionalSecretTargets. Copies to other
+	// namespaces are disabled if empty.
+	AdditionalSecretTargetNamespaceSelector string
+
+	// The HTTPS endpoint that the external approver controller sends
+	// CertificateRequests to for review, and how to connect to it.
+	ExternalApproverURL                   string
+	ExternalApproverCAFile                string
+	ExternalApproverClientCertificateFile string
+	ExternalApproverClientKeyFile         string
+	ExternalApproverTimeout               time.Duration
+	// What happens to CertificateRequests if the external approver cannot
+	// be called. One of Retry or Deny.
+	ExternalApproverFailurePolicy string
+	// The amount of time that verdicts of the external approver are cached
+	// for. Zero disables caching.
+	ExternalApproverCacheTTL time.Duration
+
 	MaxConcurrentChallenges int
 
 	// The host and port address, separated by a ':', that the Prometheus server
@@ -103,6 +139,14 @@ type ControllerOptions struct {
 	EnablePprof bool
 
 	DNS01CheckRetryPeriod time.Duration
+
+	// The host and port address, separated by a ':', that the embedded DNS01
+	// server should listen on. The server is disabled if this is empty.
+	DNS01ServerListenAddress string
+
+	// The amount of time to wait for DNS01 record changes to be batched
+	// together. Batching is disabled if this is zero.
+	DNS01BatchWindow time.Duration
 }
 
 const (
@@ -128,6 +172,16 @@ const (
 	defaultTLSACMEIssuerGroup        = cm.GroupName
 	defaultEnableCertificateOwnerRef = false
 
+	defaultRenewBeforePercentage = 0
+	defaultRenewalJitter         = 0
+
+	defaultIssuanceRetryBackoffMin = time.Hour
+	defaultIssuanceRetryBackoffMax = time.Hour * 32
+
+	defaultExternalApproverTimeout       = 10 * time.Second
+	defaultExternalApproverFailurePolicy = crexternalapprovercontroller.FailurePolicyRetry
+	defaultExternalApproverCacheTTL      = 5 * time.Minute
+
 	defaultDNS01RecursiveNameserversOnly = false
 
 	defaultMaxConcurrentChallenges = 60
@@ -135,6 +189,8 @@ const (
 	defaultPrometheusMetricsServerAddress = "0.0.0.0:9402"
 
 	defaultDNS01CheckRetryPeriod = 10 * time.Second
+
+	defaultDNS01BatchWindow = 0
 )
 
 var (
@@ -155,6 +211,8 @@ var (
 		challengescontroller.ControllerName,
 		cracmecontroller.CRControllerName,
 		crapprovercontroller.ControllerName,
+		crpolicyapprovercontroller.ControllerName,
+		crexternalapprovercontroller.ControllerName,
 		crcacontroller.CRControllerName,
 		crselfsignedcontroller.CRControllerName,
 		crvaultcontroller.CRControllerName,
@@ -166,6 +224,8 @@ var (
 		requestmanager.ControllerName,
 		readiness.ControllerName,
 		revisionmanager.ControllerName,
+		// trust distribution controllers
+		bundlescontroller.ControllerName,
 	}
 
 	defaultEnabledControllers = []string{
@@ -219,8 +279,16 @@ func NewControllerOptions() *ControllerOptions {
 		DNS01RecursiveNameservers:         []string{},
 		DNS01RecursiveNameserversOnly:     defaultDNS01RecursiveNameserversOnly,
 		EnableCertificateOwnerRef:         defaultEnableCertificateOwnerRef,
+		DefaultRenewBeforePercentage:      defaultRenewBeforePercentage,
+		DefaultRenewalJitter:              defaultRenewalJitter,
+		IssuanceRetryBackoffMin:           defaultIssuanceRetryBackoffMin,
+		IssuanceRetryBackoffMax:           defaultIssuanceRetryBackoffMax,
+		ExternalApproverTimeout:           defaultExternalApproverTimeout,
+		ExternalApproverFailurePolicy:     defaultExternalApproverFailurePolicy,
+		ExternalApproverCacheTTL:          defaultExternalApproverCacheTTL,
 		MetricsListenAddress:              defaultPrometheusMetricsServerAddress,
 		DNS01CheckRetryPeriod:             defaultDNS01CheckRetryPeriod,
+		DNS01BatchWindow:                  defaultDNS01BatchWindow,
 		EnablePprof:                       false,
 	}
 }
@@ -317,11 +385,63 @@ func (s *ControllerOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.BoolVar(&s.EnableCertificateOwnerRef, "enable-certificate-owner-ref", defaultEnableCertificateOwnerRef, ""+
 		"Whether to set the certificate resource as an owner of secret where the tls certificate is stored. "+
 		"When this flag is enabled, the secret will be automatically removed when the certificate resource is deleted.")
+	fs.Int32Var(&s.DefaultRenewBeforePercentage, "default-renew-before-percentage", defaultRenewBeforePercentage, ""+
+		"The percentage of a certificate's duration before expiry at which it is renewed, for Certificates "+
+		"that set neither spec.renewBefore nor spec.renewBeforePercentage. Must be between 1 and 99. "+
+		"If not set, certificates are renewed 2/3 through their duration.")
+	fs.DurationVar(&s.DefaultRenewalJitter, "default-renewal-jitter", defaultRenewalJitter, ""+
+		"The maximum amount of time by which the renewal of a certificate is brought forward, for Certificates "+
+		"that do not set spec.renewalJitter. The offset is chosen at random per certificate, which spreads "+
+		"out the renewal of certificates that were issued at the same time. Set to 0 to disable jitter.")
+	fs.DurationVar(&s.IssuanceRetryBackoffMin, "issuance-retry-backoff-min", defaultIssuanceRetryBackoffMin, ""+
+		"The amount of time after a failed issuance before it is first retried, for Certificates that do not "+
+		"set spec.retryBackoff.min. The delay doubles with each consecutive failure, up to issuance-retry-backoff-max.")
+	fs.DurationVar(&s.IssuanceRetryBackoffMax, "issuance-retry-backoff-max", defaultIssuanceRetryBackoffMax, ""+
+		"The maximum amount of time after a failed issuance before it is retried, for Certificates that do not "+
+		"set spec.retryBackoff.max.")
+	fs.StringVar(&s.AdditionalSecretTargetNamespaceSelector, "additional-secret-target-namespace-selector", "", ""+
+		"A label selector for the namespaces that Certificates may copy their Secret to using "+
+		"spec.additionalSecretTargets, for example 'cert-manager.io/shared-certificates=true'. "+
+		"Copies to namespaces other than the Certificate's own are disabled if this is empty. "+
+		"Cannot be set together with --namespace.")
+	fs.StringVar(&s.ExternalApproverURL, "external-approver-url", "", ""+
+		"The HTTPS endpoint that the "+crexternalapprovercontroller.ControllerName+" controller POSTs "+
+		"CertificateRequestReviews to. The endpoint responds with a verdict that is used to approve "+
+		"or deny each CertificateRequest. Required if the controller is enabled.")
+	fs.StringVar(&s.ExternalApproverCAFile, "external-approver-ca-file", "", ""+
+		"Path to a file containing the PEM encoded CA certificates used to verify the serving "+
+		"certificate of the external approver. If empty, the system's root CAs are used.")
+	fs.StringVar(&s.ExternalApproverClientCertificateFile, "external-approver-client-certificate-file", "", ""+
+		"Path to a file containing the PEM encoded client certificate presented to the external approver.")
+	fs.StringVar(&s.ExternalApproverClientKeyFile, "external-approver-client-key-file", "", ""+
+		"Path to a file containing the PEM encoded private key of the client certificate presented "+
+		"to the external approver.")
+	fs.DurationVar(&s.ExternalApproverTimeout, "external-approver-timeout", defaultExternalApproverTimeout, ""+
+		"The maximum amount of time to wait for the external approver to respond.")
+	fs.StringVar(&s.ExternalApproverFailurePolicy, "external-approver-failure-policy", defaultExternalApproverFailurePolicy, ""+
+		"What happens to a CertificateRequest if the external approver cannot be called or returns an "+
+		"invalid response. '"+crexternalapprovercontroller.FailurePolicyRetry+"' leaves the CertificateRequest "+
+		"pending and retries with a backoff, '"+crexternalapprovercontroller.FailurePolicyDeny+"' denies it.")
+	fs.DurationVar(&s.ExternalApproverCacheTTL, "external-approver-cache-ttl", defaultExternalApproverCacheTTL, ""+
+		"The amount of time that the verdict of the external approver on a CertificateRequest is cached "+
+		"for, so that it is not called again if updating the CertificateRequest fails. Set to 0 to disable caching.")
 	fs.IntVar(&s.MaxConcurrentChallenges, "max-concurrent-challenges", defaultMaxConcurrentChallenges, ""+
 		"The maximum number of challenges that can be scheduled as 'processing' at once.")
 	fs.DurationVar(&s.DNS01CheckRetryPeriod, "dns01-check-retry-period", defaultDNS01CheckRetryPeriod, ""+
 		"The duration the controller should wait between checking if a ACME dns entry exists."+
 		"This should be a valid duration string, for example 180s or 1h")
+	fs.StringVar(&s.DNS01ServerListenAddress, "dns01-server-listen-address", "", ""+
+		"The host and port that the embedded DNS01 server should listen on for UDP and TCP queries, "+
+		"for example 0.0.0.0:5353. The server answers TXT queries for '_acme-challenge' zones "+
+		"delegated to it, and is used by Issuers with the 'embedded' DNS01 provider. "+
+		"The server is disabled if this is empty.")
+	fs.DurationVar(&s.DNS01BatchWindow, "dns01-batch-window", defaultDNS01BatchWindow, ""+
+		"The amount of time to wait for DNS01 record changes from other challenges before applying them, "+
+		"so that changes for the same zone and DNS provider config are made in a single API request. "+
+		"Only applies to DNS providers that support batching changes (route53 and rfc2136). "+
+		"Batching is disabled if this is 0, which is the default, as it delays presenting and cleaning up every "+
+		"DNS01 challenge by up to twice the window. Enable it if many challenges for the same zone are solved "+
+		"at once and the DNS provider rate limits API requests; a window of a few seconds is usually enough.")
 
 	fs.StringVar(&s.MetricsListenAddress, "metrics-listen-address", defaultPrometheusMetricsServerAddress, ""+
 		"The host and port that the metrics endpoint should listen on.")
@@ -357,6 +477,44 @@ func (o *ControllerOptions) Validate() error {
 		}
 	}
 
+	if o.DefaultRenewBeforePercentage != 0 && (o.DefaultRenewBeforePercentage < 1 || o.DefaultRenewBeforePercentage > 99) {
+		return fmt.Errorf("invalid value for default-renew-before-percentage: %v must be between 1 and 99", o.DefaultRenewBeforePercentage)
+	}
+
+	if o.DefaultRenewalJitter < 0 {
+		return fmt.Errorf("invalid value for default-renewal-jitter: %v must not be negative", o.DefaultRenewalJitter)
+	}
+
+	if o.IssuanceRetryBackoffMin <= 0 {
+		return fmt.Errorf("invalid value for issuance-retry-backoff-min: %v must be greater than 0", o.IssuanceRetryBackoffMin)
+	}
+
+	if o.IssuanceRetryBackoffMax < o.IssuanceRetryBackoffMin {
+		return fmt.Errorf("invalid value for issuance-retry-backoff-max: %v must not be less than issuance-retry-backoff-min (%v)", o.IssuanceRetryBackoffMax, o.IssuanceRetryBackoffMin)
+	}
+
+	if err := o.validateAdditionalSecretTargetNamespaceSelector(); err != nil {
+		return err
+	}
+
+	if err := o.validateExternalApprover(); err != nil {
+		return err
+	}
+
+	if err := o.validateApprovers(); err != nil {
+		return err
+	}
+
+	if o.DNS01BatchWindow < 0 {
+		return fmt.Errorf("invalid value for dns01-batch-window: %v must not be negative", o.DNS01BatchWindow)
+	}
+
+	if len(o.DNS01ServerListenAddress) > 0 {
+		if _, _, err := net.SplitHostPort(o.DNS01ServerListenAddress); err != nil {
+			return fmt.Errorf("invalid value for dns01-server-listen-address (%v): %v", err, o.DNS01ServerListenAddress)
+		}
+	}
+
 	errs := []error{}
 	allControllersSet := sets.NewString(allControllers...)
 	for _, controller := range o.controllers {
@@ -377,6 +535,76 @@ func (o *ControllerOptions) Validate() error {
 	return nil
 }
 
+// validateApprovers ensures that the default approver, which approves every
+// CertificateRequest, is not enabled alongside an approver that is meant to
+// restrict which CertificateRequests are approved.
+// validateAdditionalSecretTargetNamespaceSelector checks that the selector
+// parses, and that it is not set on a controller restricted to a single
+// namespace. Such a controller only watches Secrets in its own namespace, so
+// it can neither find nor keep up to date copies in other namespaces.
+func (o *ControllerOptions) validateAdditionalSecretTargetNamespaceSelector() error {
+	if len(o.AdditionalSecretTargetNamespaceSelector) == 0 {
+		return nil
+	}
+	if _, err := labels.Parse(o.AdditionalSecretTargetNamespaceSelector); err != nil {
+		return fmt.Errorf("invalid value for additional-secret-target-namespace-selector: %v", err)
+	}
+	if len(o.Namespace) > 0 {
+		return fmt.Errorf("additional-secret-target-namespace-selector cannot be set together with namespace, as copies to other namespaces are not watched by a namespace-scoped controller")
+	}
+	return nil
+}
+
+func (o *ControllerOptions) validateApprovers() error {
+	enabled := o.EnabledControllers()
+	if !enabled.Has(crapprovercontroller.ControllerName) {
+		return nil
+	}
+	for _, approver := range []string{crpolicyapprovercontroller.ControllerName, crexternalapprovercontroller.ControllerName} {
+		if enabled.Has(approver) {
+			return fmt.Errorf("the %s controller cannot be enabled together with the %s controller, as every "+
+				"CertificateRequest would be approved by the latter; disable it with '--controllers=*,-%s,%s'",
+				approver, crapprovercontroller.ControllerName, crapprovercontroller.ControllerName, approver)
+		}
+	}
+	return nil
+}
+
+func (o *ControllerOptions) validateExternalApprover() error {
+	if len(o.ExternalApproverURL) > 0 {
+		u, err := url.Parse(o.ExternalApproverURL)
+		if err != nil {
+			return fmt.Errorf("invalid value for external-approver-url: %v", err)
+		}
+		if u.Scheme != "https" {
+			return fmt.Errorf("invalid value for external-approver-url: %q must use the https scheme", o.ExternalApproverURL)
+		}
+	} else if o.EnabledControllers().Has(crexternalapprovercontroller.ControllerName) {
+		return fmt.Errorf("external-approver-url must be set if the %s controller is enabled", crexternalapprovercontroller.ControllerName)
+	}
+
+	if (len(o.ExternalApproverClientCertificateFile) == 0) != (len(o.ExternalApproverClientKeyFile) == 0) {
+		return fmt.Errorf("external-approver-client-certificate-file and external-approver-client-key-file must be set together")
+	}
+
+	if o.ExternalApproverTimeout <= 0 {
+		return fmt.Errorf("invalid value for external-approver-timeout: %v must be greater than 0", o.ExternalApproverTimeout)
+	}
+
+	switch o.ExternalApproverFailurePolicy {
+	case crexternalapprovercontroller.FailurePolicyRetry, crexternalapprovercontroller.FailurePolicyDeny:
+	default:
+		return fmt.Errorf("invalid value for external-approver-failure-policy: %q must be one of %s or %s", o.ExternalApproverFailurePolicy,
+			crexternalapprovercontroller.FailurePolicyRetry, crexternalapprovercontroller.FailurePolicyDeny)
+	}
+
+	if o.ExternalApproverCacheTTL < 0 {
+		return fmt.Errorf("invalid value for external-approver-cache-ttl: %v must not be negative", o.ExternalApproverCacheTTL)
+	}
+
+	return nil
+}
+
 func (o *ControllerOptions) EnabledControllers() sets.String {
 	var disabled []string
 	enabled := sets.NewString()
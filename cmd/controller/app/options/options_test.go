@@ -63,3 +63,80 @@ func TestEnabledControllers(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateApprovers(t *testing.T) {
+	tests := map[string]struct {
+		controllers []string
+		expErr      bool
+	}{
+		"default controllers are valid": {
+			controllers: []string{"*"},
+		},
+		"policy approver without the default approver is valid": {
+			controllers: []string{"*", "-certificaterequests-approver", "certificaterequests-policy-approver"},
+		},
+		"policy approver with the default approver is rejected": {
+			controllers: []string{"*", "certificaterequests-policy-approver"},
+			expErr:      true,
+		},
+		"external approver without the default approver is valid": {
+			controllers: []string{"*", "-certificaterequests-approver", "certificaterequests-external-approver"},
+		},
+		"external approver with the default approver is rejected": {
+			controllers: []string{"*", "certificaterequests-external-approver"},
+			expErr:      true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			o := ControllerOptions{
+				controllers: test.controllers,
+			}
+
+			err := o.validateApprovers()
+			if test.expErr != (err != nil) {
+				t.Errorf("unexpected error, exp=%t got=%v", test.expErr, err)
+			}
+		})
+	}
+}
+
+func TestValidateAdditionalSecretTargetNamespaceSelector(t *testing.T) {
+	tests := map[string]struct {
+		selector  string
+		namespace string
+		expErr    bool
+	}{
+		"no selector is valid": {},
+		"no selector with a namespace is valid": {
+			namespace: "cert-manager",
+		},
+		"selector on a cluster-scoped controller is valid": {
+			selector: "cert-manager.io/shared-certificates=true",
+		},
+		"invalid selector is rejected": {
+			selector: "cert-manager.io/shared-certificates in (",
+			expErr:   true,
+		},
+		"selector on a namespace-scoped controller is rejected": {
+			selector:  "cert-manager.io/shared-certificates=true",
+			namespace: "cert-manager",
+			expErr:    true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			o := ControllerOptions{
+				AdditionalSecretTargetNamespaceSelector: test.selector,
+				Namespace:                               test.namespace,
+			}
+
+			err := o.validateAdditionalSecretTargetNamespaceSelector()
+			if test.expErr != (err != nil) {
+				t.Errorf("unexpected error, exp=%t got=%v", test.expErr, err)
+			}
+		})
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	restclient "k8s.io/client-go/rest"
@@ -30,6 +31,7 @@ import (
 	"k8s.io/kubectl/pkg/util/templates"
 
 	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager"
 	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
 	cmclient "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
@@ -78,9 +80,11 @@ func NewOptions(ioStreams genericclioptions.IOStreams) *Options {
 func NewCmdApprove(ctx context.Context, ioStreams genericclioptions.IOStreams, factory cmdutil.Factory) *cobra.Command {
 	o := NewOptions(ioStreams)
 	cmd := &cobra.Command{
-		Use:     "approve",
-		Short:   "Approve a CertificateRequest",
-		Long:    `Mark a CertificateRequest as Approved, so it may be signed by a configured Issuer.`,
+		Use:   "approve",
+		Short: "Approve a CertificateRequest",
+		Long: `Mark a CertificateRequest as Approved, so it may be signed by a configured Issuer.
+The approval is recorded against the current user. If the Issuer requires approval by a number of
+distinct users, the CertificateRequest is only marked as Approved once enough users have approved it.`,
 		Example: example,
 		Run: func(cmd *cobra.Command, args []string) {
 			cmdutil.CheckErr(o.Validate(args))
@@ -154,15 +158,81 @@ func (o *Options) Run(ctx context.Context, args []string) error {
 		return errors.New("CertificateRequest is already denied")
 	}
 
-	apiutil.SetCertificateRequestCondition(cr, cmapi.CertificateRequestConditionApproved,
-		cmmeta.ConditionTrue, o.Reason, o.Message)
+	required, err := o.requiredApprovals(ctx, cr)
+	if err != nil {
+		return err
+	}
+
+	// The username of the approval is set by the webhook to that of the
+	// current user.
+	now := metav1.Now()
+	cr.Status.Approvals = append(cr.Status.Approvals, cmapi.CertificateRequestApproval{
+		ApprovalTime: &now,
+		Reason:       o.Reason,
+		Message:      o.Message,
+	})
+
+	approvers := len(cr.Status.Approvals)
+	if approvers >= required {
+		apiutil.SetCertificateRequestCondition(cr, cmapi.CertificateRequestConditionApproved,
+			cmmeta.ConditionTrue, o.Reason, o.Message)
+	}
 
 	_, err = o.CMClient.CertmanagerV1().CertificateRequests(o.CmdNamespace).UpdateStatus(ctx, cr, metav1.UpdateOptions{})
 	if err != nil {
 		return err
 	}
 
+	if approvers < required {
+		fmt.Fprintf(o.Out, "Recorded approval of CertificateRequest '%s/%s' (%d of %d required approvals)\n", cr.Namespace, cr.Name, approvers, required)
+		return nil
+	}
+
 	fmt.Fprintf(o.Out, "Approved CertificateRequest '%s/%s'\n", cr.Namespace, cr.Name)
 
 	return nil
 }
+
+// requiredApprovals returns the number of distinct users that must approve the
+// CertificateRequest, as configured on the referenced cert-manager Issuer or
+// ClusterIssuer. If the issuer cannot be read by the current user, no
+// approvals are assumed to be required and the webhook will reject the
+// approval if the issuer's requirement has not been met.
+func (o *Options) requiredApprovals(ctx context.Context, cr *cmapi.CertificateRequest) (int, error) {
+	ref := cr.Spec.IssuerRef
+	if len(ref.Group) > 0 && ref.Group != certmanager.GroupName {
+		return 0, nil
+	}
+
+	var (
+		spec *cmapi.IssuerSpec
+		err  error
+	)
+	switch ref.Kind {
+	case "", cmapi.IssuerKind:
+		var iss *cmapi.Issuer
+		iss, err = o.CMClient.CertmanagerV1().Issuers(cr.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+		if err == nil {
+			spec = &iss.Spec
+		}
+	case cmapi.ClusterIssuerKind:
+		var iss *cmapi.ClusterIssuer
+		iss, err = o.CMClient.CertmanagerV1().ClusterIssuers().Get(ctx, ref.Name, metav1.GetOptions{})
+		if err == nil {
+			spec = &iss.Spec
+		}
+	default:
+		return 0, nil
+	}
+	if apierrors.IsNotFound(err) || apierrors.IsForbidden(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	if spec.Approval == nil {
+		return 0, nil
+	}
+	return spec.Approval.RequiredApprovals, nil
+}
@@ -17,7 +17,17 @@ limitations under the License.
 package approve
 
 import (
+	"context"
 	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	cmfake "github.com/jetstack/cert-manager/pkg/client/clientset/versioned/fake"
 )
 
 func TestValidate(t *testing.T) {
@@ -82,3 +92,88 @@ func TestValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestRun(t *testing.T) {
+	issuer := func(requiredApprovals int) *cmapi.Issuer {
+		iss := &cmapi.Issuer{ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "ca-issuer"}}
+		if requiredApprovals > 0 {
+			iss.Spec.Approval = &cmapi.IssuerApproval{RequiredApprovals: requiredApprovals}
+		}
+		return iss
+	}
+	request := func(approvers ...string) *cmapi.CertificateRequest {
+		cr := &cmapi.CertificateRequest{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "cr-1"},
+			Spec: cmapi.CertificateRequestSpec{
+				IssuerRef: cmmeta.ObjectReference{Name: "ca-issuer"},
+			},
+		}
+		for _, approver := range approvers {
+			cr.Status.Approvals = append(cr.Status.Approvals, cmapi.CertificateRequestApproval{Username: approver})
+		}
+		return cr
+	}
+
+	tests := map[string]struct {
+		objects      []runtime.Object
+		expApprovals int
+		expApproved  bool
+		expOutput    string
+	}{
+		"issuer not requiring approvals should approve": {
+			objects:      []runtime.Object{issuer(0), request()},
+			expApprovals: 1,
+			expApproved:  true,
+			expOutput:    "Approved CertificateRequest 'testns/cr-1'\n",
+		},
+		"issuer that cannot be found should approve": {
+			objects:      []runtime.Object{request()},
+			expApprovals: 1,
+			expApproved:  true,
+			expOutput:    "Approved CertificateRequest 'testns/cr-1'\n",
+		},
+		"issuer requiring more approvals should only record the approval": {
+			objects:      []runtime.Object{issuer(2), request()},
+			expApprovals: 1,
+			expApproved:  false,
+			expOutput:    "Recorded approval of CertificateRequest 'testns/cr-1' (1 of 2 required approvals)\n",
+		},
+		"issuer whose required approvals are reached should approve": {
+			objects:      []runtime.Object{issuer(2), request("user-1")},
+			expApprovals: 2,
+			expApproved:  true,
+			expOutput:    "Approved CertificateRequest 'testns/cr-1'\n",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			streams, _, out, _ := genericclioptions.NewTestIOStreams()
+			opts := &Options{
+				CMClient:     cmfake.NewSimpleClientset(test.objects...),
+				CmdNamespace: "testns",
+				Reason:       "KubectlCertManager",
+				Message:      "approved",
+				IOStreams:    streams,
+			}
+
+			if err := opts.Run(context.TODO(), []string{"cr-1"}); err != nil {
+				t.Fatal(err)
+			}
+
+			cr, err := opts.CMClient.CertmanagerV1().CertificateRequests("testns").Get(context.TODO(), "cr-1", metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(cr.Status.Approvals) != test.expApprovals {
+				t.Errorf("expected %d approvals, got %d", test.expApprovals, len(cr.Status.Approvals))
+			}
+			if approved := apiutil.CertificateRequestIsApproved(cr); approved != test.expApproved {
+				t.Errorf("expected approved=%t, got %t", test.expApproved, approved)
+			}
+			if out.String() != test.expOutput {
+				t.Errorf("expected output %q, got %q", test.expOutput, out.String())
+			}
+		})
+	}
+}
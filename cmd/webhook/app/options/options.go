@@ -56,6 +56,16 @@ type WebhookOptions struct {
 	// MinTLSVersion is the minimum TLS version supported.
 	// Values are from tls package constants (https://golang.org/pkg/crypto/tls/#pkg-constants).
 	MinTLSVersion string
+
+	// Optional path to a PEM encoded CA bundle used to verify client
+	// certificates, e.g. the kube-apiserver's proxy client CA.
+	// If specified, clients that do not present a certificate signed by one
+	// of these CAs will be rejected.
+	ClientCAFile string
+	// List of names that a verified client certificate must have as its
+	// common name or as a DNS subject alternative name.
+	// May only be specified if ClientCAFile is set.
+	AllowedClientNames []string
 }
 
 func (o *WebhookOptions) AddFlags(fs *pflag.FlagSet) {
@@ -81,6 +91,12 @@ func (o *WebhookOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&o.MinTLSVersion, "tls-min-version", o.MinTLSVersion,
 		"Minimum TLS version supported. "+
 			"Possible values: "+strings.Join(tlsPossibleVersions, ", "))
+	fs.StringVar(&o.ClientCAFile, "client-ca-file", "", ""+
+		"Optional path to a PEM encoded CA bundle used to verify client certificates. "+
+		"If specified, clients that do not present a certificate signed by one of these CAs will be rejected.")
+	fs.StringSliceVar(&o.AllowedClientNames, "allowed-client-names", nil, ""+
+		"Optional list of names that a verified client certificate must have as its common name or "+
+		"as a DNS subject alternative name. Requires --client-ca-file to be set.")
 }
 
 func FileTLSSourceEnabled(o WebhookOptions) bool {
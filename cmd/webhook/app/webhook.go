@@ -18,7 +18,9 @@ package app
 
 import (
 	"context"
+	"crypto/x509"
 	"fmt"
+	"io/ioutil"
 
 	"github.com/go-logr/logr"
 	"github.com/spf13/cobra"
@@ -26,6 +28,7 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 
 	"github.com/jetstack/cert-manager/cmd/webhook/app/options"
+	cmclient "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
 	"github.com/jetstack/cert-manager/pkg/util"
 	"github.com/jetstack/cert-manager/pkg/webhook"
@@ -39,7 +42,7 @@ var validationHook handlers.ValidatingAdmissionHook = handlers.NewRegistryBacked
 var mutationHook handlers.MutatingAdmissionHook = handlers.NewRegistryBackedMutator(logf.Log, webhook.Scheme, webhook.MutationRegistry)
 var conversionHook handlers.ConversionHook = handlers.NewSchemeBackedConverter(logf.Log, webhook.Scheme)
 
-func NewServerWithOptions(log logr.Logger, opts options.WebhookOptions) (*server.Server, error) {
+func NewServerWithOptions(stopCh <-chan struct{}, log logr.Logger, opts options.WebhookOptions) (*server.Server, error) {
 	restcfg, err := clientcmd.BuildConfigFromFlags(opts.APIServerHost, opts.Kubeconfig)
 	if err != nil {
 		return nil, err
@@ -49,7 +52,14 @@ func NewServerWithOptions(log logr.Logger, opts options.WebhookOptions) (*server
 	if err != nil {
 		return nil, fmt.Errorf("error creating kubernetes client: %s", err)
 	}
-	validationHook.InitPlugins(cl)
+	cmcl, err := cmclient.NewForConfig(restcfg)
+	if err != nil {
+		return nil, fmt.Errorf("error creating cert-manager client: %s", err)
+	}
+	validationHook.InitPlugins(cl, cmcl)
+	if err := mutationHook.InitPlugins(cl, stopCh); err != nil {
+		return nil, fmt.Errorf("error initialising mutation plugins: %v", err)
+	}
 
 	var source tls.CertificateSource
 	switch {
@@ -81,17 +91,32 @@ func NewServerWithOptions(log logr.Logger, opts options.WebhookOptions) (*server
 		log.V(logf.WarnLevel).Info("serving insecurely as tls certificate data not provided")
 	}
 
+	var clientCAs *x509.CertPool
+	if opts.ClientCAFile != "" {
+		log.V(logf.InfoLevel).Info("verifying client certificates using CA bundle from local filesystem", "client_ca_file", opts.ClientCAFile)
+		caPEM, err := ioutil.ReadFile(opts.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading client CA file: %v", err)
+		}
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("no valid certificates found in client CA file %q", opts.ClientCAFile)
+		}
+	}
+
 	return &server.Server{
-		ListenAddr:        fmt.Sprintf(":%d", opts.ListenPort),
-		HealthzAddr:       fmt.Sprintf(":%d", opts.HealthzPort),
-		EnablePprof:       true,
-		CertificateSource: source,
-		CipherSuites:      opts.TLSCipherSuites,
-		MinTLSVersion:     opts.MinTLSVersion,
-		ValidationWebhook: validationHook,
-		MutationWebhook:   mutationHook,
-		ConversionWebhook: conversionHook,
-		Log:               log,
+		ListenAddr:         fmt.Sprintf(":%d", opts.ListenPort),
+		HealthzAddr:        fmt.Sprintf(":%d", opts.HealthzPort),
+		EnablePprof:        true,
+		CertificateSource:  source,
+		CipherSuites:       opts.TLSCipherSuites,
+		MinTLSVersion:      opts.MinTLSVersion,
+		ClientCAs:          clientCAs,
+		AllowedClientNames: opts.AllowedClientNames,
+		ValidationWebhook:  validationHook,
+		MutationWebhook:    mutationHook,
+		ConversionWebhook:  conversionHook,
+		Log:                log,
 	}, nil
 }
 
@@ -106,7 +131,7 @@ func NewServerCommand(stopCh <-chan struct{}) *cobra.Command {
 			ctx = logf.NewContext(ctx, nil, "webhook")
 			log := logf.FromContext(ctx)
 
-			srv, err := NewServerWithOptions(log, opts)
+			srv, err := NewServerWithOptions(stopCh, log, opts)
 			if err != nil {
 				return err
 			}
@@ -21,6 +21,7 @@ require (
 	github.com/cpu/goacmedns v0.0.3
 	github.com/digitalocean/godo v1.44.0
 	github.com/go-logr/logr v0.4.0
+	github.com/google/cel-go v0.7.2
 	github.com/google/gofuzz v1.2.0
 	github.com/googleapis/gnostic v0.5.5
 	github.com/hashicorp/vault/api v1.0.4
@@ -45,6 +46,7 @@ require (
 	golang.org/x/sync v0.0.0-20210220032951-036812b2e83c
 	gomodules.xyz/jsonpatch/v2 v2.2.0
 	google.golang.org/api v0.20.0
+	google.golang.org/genproto v0.0.0-20201110150050-8816d57aaa9a
 	helm.sh/helm/v3 v3.6.2
 	k8s.io/api v0.21.2
 	k8s.io/apiextensions-apiserver v0.21.2
@@ -78,3 +80,9 @@ replace k8s.io/gengo => github.com/kmodules/gengo v0.0.0-20210428002657-a8850da6
 
 // See https://github.com/kubernetes/kubernetes/pull/99817
 replace k8s.io/kube-openapi => k8s.io/kube-openapi v0.0.0-20210305001622-591a79e4bda7
+
+// cel-go raises the required version of grpc to v1.33.2, but the etcd client
+// that k8s.io/apiserver depends on does not build with grpc v1.30 or later.
+// Kubernetes pins the same version. None of the cel-go packages used here
+// import grpc.
+replace google.golang.org/grpc => google.golang.org/grpc v1.27.1
@@ -92,6 +92,15 @@ def go_repositories():
         sum = "h1:bvNMNQO63//z+xNgfBlViaCIJKLlCJ6/fmUseuG0wVQ=",
         version = "v0.0.0-20170406064948-c7f18ee00883",
     )
+    go_repository(
+        name = "com_github_antlr_antlr4",
+        build_file_generation = "on",
+        build_file_proto_mode = "disable",
+        importpath = "github.com/antlr/antlr4",
+        sum = "h1:0cEys61Sr2hUBEXfNV8eyQP01oZuBgoMeHunebPirK8=",
+        version = "v0.0.0-20200503195918-621b933c7a7f",
+    )
+
     go_repository(
         name = "com_github_apache_thrift",
         build_file_generation = "on",
@@ -458,14 +467,7 @@ def go_repositories():
         sum = "h1:EdRZT3IeKQmfCSrgo8SZ8V3MEnskuJP0wCYNpe+aiXo=",
         version = "v0.0.0-20191024224557-825249438eec",
     )
-    go_repository(
-        name = "com_github_client9_misspell",
-        build_file_generation = "on",
-        build_file_proto_mode = "disable",
-        importpath = "github.com/client9/misspell",
-        sum = "h1:ta993UF76GwbvJcIo3Y68y/M3WxlpEHPWIGDkJYwzJI=",
-        version = "v0.3.4",
-    )
+
     go_repository(
         name = "com_github_cloudflare_cloudflare_go",
         build_file_generation = "on",
@@ -1295,14 +1297,6 @@ def go_repositories():
         version = "v0.8.0",
     )
 
-    go_repository(
-        name = "com_github_gogo_googleapis",
-        build_file_generation = "on",
-        build_file_proto_mode = "disable",
-        importpath = "github.com/gogo/googleapis",
-        sum = "h1:kFkMAZBNAn4j7K0GiZr8cRYzejq68VbheufiV3YuyFI=",
-        version = "v1.1.0",
-    )
     go_repository(
         name = "com_github_gogo_protobuf",
         build_file_generation = "on",
@@ -1376,6 +1370,23 @@ def go_repositories():
         sum = "h1:0udJVsspx3VBr5FwtLhQQtuAsVc79tTq0ocGIPAU6qo=",
         version = "v1.0.0",
     )
+    go_repository(
+        name = "com_github_google_cel_go",
+        build_file_generation = "on",
+        build_file_proto_mode = "disable",
+        importpath = "github.com/google/cel-go",
+        sum = "h1:FoLWxW4h8SV1UEOwth7xOU0tpeY7l58ycOs00xs6eu8=",
+        version = "v0.7.2",
+    )
+    go_repository(
+        name = "com_github_google_cel_spec",
+        build_file_generation = "on",
+        build_file_proto_mode = "disable",
+        importpath = "github.com/google/cel-spec",
+        sum = "h1:hWEzw+1L1UNxfHAbKXYbirsPGlG8ArXNcTnBKvBqRJ0=",
+        version = "v0.5.0",
+    )
+
     go_repository(
         name = "com_github_google_go_cmp",
         build_file_generation = "on",
@@ -2054,14 +2065,6 @@ def go_repositories():
         version = "v1.1.0",
     )
 
-    go_repository(
-        name = "com_github_lyft_protoc_gen_validate",
-        build_file_generation = "on",
-        build_file_proto_mode = "disable",
-        importpath = "github.com/lyft/protoc-gen-validate",
-        sum = "h1:KNt/RhmQTOLr7Aj8PsJ7mTronaFyx80mRTT9qF261dA=",
-        version = "v0.0.13",
-    )
     go_repository(
         name = "com_github_magiconair_properties",
         build_file_generation = "on",
@@ -3054,6 +3057,14 @@ def go_repositories():
         sum = "h1:xVKxvI7ouOI5I+U9s2eeiUfMaWBVoXA3AWskkrqK0VM=",
         version = "v1.7.0",
     )
+    go_repository(
+        name = "com_github_stoewer_go_strcase",
+        build_file_generation = "on",
+        build_file_proto_mode = "disable",
+        importpath = "github.com/stoewer/go-strcase",
+        sum = "h1:Z2iHWqGXH00XYgqDmNgQbIBxf3wrNq0F3feEy0ainaU=",
+        version = "v1.2.0",
+    )
 
     go_repository(
         name = "com_github_streadway_amqp",
@@ -3839,6 +3850,7 @@ def go_repositories():
         build_file_generation = "on",
         build_file_proto_mode = "disable",
         importpath = "google.golang.org/grpc",
+        replace = "google.golang.org/grpc",
         sum = "h1:zvIju4sqAGvwKspUQOhwnpcqSbzi7/H6QomNNjTL4sk=",
         version = "v1.27.1",
     )
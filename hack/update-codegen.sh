@@ -45,6 +45,8 @@ deepcopy_inputs=(
   pkg/apis/acme/v1beta1 \
   pkg/apis/acme/v1 \
   pkg/internal/apis/acme \
+  pkg/apis/trust/v1alpha1 \
+  pkg/internal/apis/trust \
   pkg/apis/meta/v1 \
   pkg/internal/apis/meta \
   pkg/webhook/handlers/testdata/apis/testgroup/v2 \
@@ -65,6 +67,7 @@ client_inputs=(
   pkg/apis/acme/v1alpha3 \
   pkg/apis/acme/v1beta1 \
   pkg/apis/acme/v1 \
+  pkg/apis/trust/v1alpha1 \
 )
 
 # Generate defaulting functions to be used by the mutating webhook
@@ -77,6 +80,7 @@ defaulter_inputs=(
   pkg/internal/apis/acme/v1alpha3 \
   pkg/internal/apis/acme/v1beta1 \
   pkg/internal/apis/acme/v1 \
+  pkg/internal/apis/trust/v1alpha1 \
   pkg/internal/apis/meta/v1 \
   pkg/webhook/handlers/testdata/apis/testgroup/v2 \
   pkg/webhook/handlers/testdata/apis/testgroup/v1 \
@@ -92,6 +96,7 @@ conversion_inputs=(
   pkg/internal/apis/acme/v1alpha3 \
   pkg/internal/apis/acme/v1beta1 \
   pkg/internal/apis/acme/v1 \
+  pkg/internal/apis/trust/v1alpha1 \
   pkg/internal/apis/meta/v1 \
   pkg/webhook/handlers/testdata/apis/testgroup/v2 \
   pkg/webhook/handlers/testdata/apis/testgroup/v1 \
@@ -264,6 +269,11 @@ gen-conversions() {
       -O zz_generated.conversion \
       --extra-dirs "github.com/jetstack/cert-manager/pkg/internal/apis/meta/v1,github.com/jetstack/cert-manager/pkg/internal/apis/acme/$v"
   done
+  # trust apis
+  "$conversiongen" --go-header-file hack/boilerplate/boilerplate.generatego.txt \
+    --input-dirs github.com/jetstack/cert-manager/pkg/internal/apis/trust/v1alpha1 \
+    -O zz_generated.conversion \
+    --extra-dirs "github.com/jetstack/cert-manager/pkg/internal/apis/meta/v1"
   # test apis
   "$conversiongen" --go-header-file hack/boilerplate/boilerplate.generatego.txt \
     --input-dirs github.com/jetstack/cert-manager/pkg/webhook/handlers/testdata/apis/testgroup/v2,github.com/jetstack/cert-manager/pkg/webhook/handlers/testdata/apis/testgroup/v1 \
@@ -36,6 +36,7 @@ import (
 	cmapiv1alpha3 "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha3"
 	cmapiv1beta1 "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1beta1"
 	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	trustv1alpha1 "github.com/jetstack/cert-manager/pkg/apis/trust/v1alpha1"
 )
 
 // This package defines a Scheme and Codec that has the *external* API types
@@ -60,6 +61,7 @@ var localSchemeBuilder = runtime.SchemeBuilder{
 	cmacmev1beta1.AddToScheme,
 	cmacmev1.AddToScheme,
 	cmmeta.AddToScheme,
+	trustv1alpha1.AddToScheme,
 	whapi.AddToScheme,
 	kscheme.AddToScheme,
 	apireg.AddToScheme,
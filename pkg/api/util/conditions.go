@@ -22,6 +22,7 @@ import (
 
 	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	trustapi "github.com/jetstack/cert-manager/pkg/apis/trust/v1alpha1"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
 )
 
@@ -370,3 +371,43 @@ func CertificateRequestIsDenied(cr *cmapi.CertificateRequest) bool {
 
 	return false
 }
+
+// SetBundleCondition will set a 'condition' on the given Bundle.
+// - If no condition of the same type already exists, the condition will be
+//   inserted with the LastTransitionTime set to the current time.
+// - If a condition of the same type and state already exists, the condition
+//   will be updated but the LastTransitionTime will not be modified.
+// - If a condition of the same type and different state already exists, the
+//   condition will be updated and the LastTransitionTime set to the current
+//   time.
+func SetBundleCondition(bundle *trustapi.Bundle, observedGeneration int64, conditionType trustapi.BundleConditionType, status cmmeta.ConditionStatus, reason, message string) {
+	nowTime := metav1.NewTime(Clock.Now())
+	newCondition := trustapi.BundleCondition{
+		Type:               conditionType,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: &nowTime,
+		ObservedGeneration: observedGeneration,
+	}
+
+	for idx, cond := range bundle.Status.Conditions {
+		if cond.Type != conditionType {
+			continue
+		}
+
+		// If this update doesn't contain a state transition, we don't update
+		// the conditions LastTransitionTime to Now()
+		if cond.Status == status {
+			newCondition.LastTransitionTime = cond.LastTransitionTime
+		} else {
+			logf.V(logf.InfoLevel).Infof("Found status change for Bundle %q condition %q: %q -> %q; setting lastTransitionTime to %v", bundle.Name, conditionType, cond.Status, status, nowTime.Time)
+		}
+
+		bundle.Status.Conditions[idx] = newCondition
+		return
+	}
+
+	bundle.Status.Conditions = append(bundle.Status.Conditions, newCondition)
+	logf.V(logf.InfoLevel).Infof("Setting lastTransitionTime for Bundle %q condition %q to %v", bundle.Name, conditionType, nowTime.Time)
+}
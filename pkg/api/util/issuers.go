@@ -19,6 +19,9 @@ package util
 import (
 	"fmt"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
 	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
 )
@@ -61,3 +64,19 @@ func IssuerKind(ref cmmeta.ObjectReference) string {
 	}
 	return ref.Kind
 }
+
+// IssuerSelectsNamespace returns true if resources in a namespace with the
+// given labels may reference an issuer with the given spec. Issuers without a
+// namespaceSelector may be referenced from any namespace.
+func IssuerSelectsNamespace(spec *cmapi.IssuerSpec, namespaceLabels map[string]string) (bool, error) {
+	if spec.NamespaceSelector == nil {
+		return true, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(spec.NamespaceSelector)
+	if err != nil {
+		return false, fmt.Errorf("invalid namespaceSelector: %w", err)
+	}
+
+	return selector.Matches(labels.Set(namespaceLabels)), nil
+}
@@ -142,6 +142,16 @@ func (in *ACMEChallengeSolverDNS01) DeepCopyInto(out *ACMEChallengeSolverDNS01)
 	if in.RFC2136 != nil {
 		in, out := &in.RFC2136, &out.RFC2136
 		*out = new(ACMEIssuerDNS01ProviderRFC2136)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExternalDNS != nil {
+		in, out := &in.ExternalDNS, &out.ExternalDNS
+		*out = new(ACMEIssuerDNS01ProviderExternalDNS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Embedded != nil {
+		in, out := &in.Embedded, &out.Embedded
+		*out = new(ACMEIssuerDNS01ProviderEmbedded)
 		**out = **in
 	}
 	if in.Webhook != nil {
@@ -511,10 +521,66 @@ func (in *ACMEIssuerDNS01ProviderDigitalOcean) DeepCopy() *ACMEIssuerDNS01Provid
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ACMEIssuerDNS01ProviderEmbedded) DeepCopyInto(out *ACMEIssuerDNS01ProviderEmbedded) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ACMEIssuerDNS01ProviderEmbedded.
+func (in *ACMEIssuerDNS01ProviderEmbedded) DeepCopy() *ACMEIssuerDNS01ProviderEmbedded {
+	if in == nil {
+		return nil
+	}
+	out := new(ACMEIssuerDNS01ProviderEmbedded)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ACMEIssuerDNS01ProviderExternalDNS) DeepCopyInto(out *ACMEIssuerDNS01ProviderExternalDNS) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ACMEIssuerDNS01ProviderExternalDNS.
+func (in *ACMEIssuerDNS01ProviderExternalDNS) DeepCopy() *ACMEIssuerDNS01ProviderExternalDNS {
+	if in == nil {
+		return nil
+	}
+	out := new(ACMEIssuerDNS01ProviderExternalDNS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ACMEIssuerDNS01ProviderRFC2136) DeepCopyInto(out *ACMEIssuerDNS01ProviderRFC2136) {
 	*out = *in
+	if in.FailoverNameservers != nil {
+		in, out := &in.FailoverNameservers, &out.FailoverNameservers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	out.TSIGSecret = in.TSIGSecret
+	if in.SIG0 != nil {
+		in, out := &in.SIG0, &out.SIG0
+		*out = new(ACMEIssuerDNS01ProviderRFC2136SIG0)
+		**out = **in
+	}
 	return
 }
 
@@ -528,6 +594,23 @@ func (in *ACMEIssuerDNS01ProviderRFC2136) DeepCopy() *ACMEIssuerDNS01ProviderRFC
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ACMEIssuerDNS01ProviderRFC2136SIG0) DeepCopyInto(out *ACMEIssuerDNS01ProviderRFC2136SIG0) {
+	*out = *in
+	out.PrivateKey = in.PrivateKey
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ACMEIssuerDNS01ProviderRFC2136SIG0.
+func (in *ACMEIssuerDNS01ProviderRFC2136SIG0) DeepCopy() *ACMEIssuerDNS01ProviderRFC2136SIG0 {
+	if in == nil {
+		return nil
+	}
+	out := new(ACMEIssuerDNS01ProviderRFC2136SIG0)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ACMEIssuerDNS01ProviderRoute53) DeepCopyInto(out *ACMEIssuerDNS01ProviderRoute53) {
 	*out = *in
@@ -348,6 +348,22 @@ type ACMEChallengeSolverDNS01 struct {
 	// +optional
 	RFC2136 *ACMEIssuerDNS01ProviderRFC2136 `json:"rfc2136,omitempty"`
 
+	// Use external-dns (https://github.com/kubernetes-sigs/external-dns) to
+	// manage DNS01 challenge records by creating DNSEndpoint resources.
+	// This does not require cert-manager to have any credentials for the DNS
+	// provider.
+	// +optional
+	ExternalDNS *ACMEIssuerDNS01ProviderExternalDNS `json:"externalDNS,omitempty"`
+
+	// Use the DNS server embedded in the cert-manager controller to serve
+	// DNS01 challenge records.
+	// The '_acme-challenge' subdomain of each domain must be delegated to the
+	// embedded DNS server using NS records, and the server must be enabled
+	// using the controller's '--dns01-server-listen-address' flag.
+	// CNAME records are not followed when using this provider.
+	// +optional
+	Embedded *ACMEIssuerDNS01ProviderEmbedded `json:"embedded,omitempty"`
+
 	// Configure an external webhook based DNS01 challenge solver to manage
 	// DNS01 challenge records.
 	// +optional
@@ -500,6 +516,12 @@ type ACMEIssuerDNS01ProviderRFC2136 struct {
 	// This field is required.
 	Nameserver string `json:"nameserver"`
 
+	// A list of additional nameservers, in the same form as ``nameserver``,
+	// that dynamic updates will be sent to, in order, if sending an update to
+	// ``nameserver`` fails. This allows for redundant primary nameservers.
+	// +optional
+	FailoverNameservers []string `json:"failoverNameservers,omitempty"`
+
 	// The name of the secret containing the TSIG value.
 	// If ``tsigKeyName`` is defined, this field is required.
 	// +optional
@@ -516,8 +538,53 @@ type ACMEIssuerDNS01ProviderRFC2136 struct {
 	// ``HMACSHA1``, ``HMACSHA256`` or ``HMACSHA512``.
 	// +optional
 	TSIGAlgorithm string `json:"tsigAlgorithm,omitempty"`
+
+	// Configures SIG(0) (RFC2931) public key signing of dynamic updates, as
+	// an alternative to TSIG.
+	// May not be specified together with ``tsigKeyName`` or
+	// ``tsigSecretSecretRef``.
+	// +optional
+	SIG0 *ACMEIssuerDNS01ProviderRFC2136SIG0 `json:"sig0,omitempty"`
+}
+
+// ACMEIssuerDNS01ProviderRFC2136SIG0 is a structure containing the
+// configuration for SIG(0) signing of RFC2136 dynamic updates.
+type ACMEIssuerDNS01ProviderRFC2136SIG0 struct {
+	// The public key used to sign updates as a DNS KEY record, e.g. the
+	// contents of the '.key' file generated by ``dnssec-keygen -T KEY``.
+	// The name of the record is used as the signer name.
+	PublicKey string `json:"publicKey"`
+
+	// The name of the secret containing the private key used to sign updates
+	// in the BIND private key format, e.g. the contents of the '.private' file
+	// generated by ``dnssec-keygen -T KEY``.
+	PrivateKey cmmeta.SecretKeySelector `json:"privateKeySecretRef"`
+}
+
+// ACMEIssuerDNS01ProviderExternalDNS is a structure containing the
+// configuration for solving DNS01 challenges using external-dns.
+// A DNSEndpoint resource (externaldns.k8s.io/v1alpha1) containing the TXT
+// record will be created in the issuer's resource namespace, and external-dns
+// must be configured with the 'crd' source in order to publish it.
+type ACMEIssuerDNS01ProviderExternalDNS struct {
+	// Labels that will be added to the created DNSEndpoint resources.
+	// This can be used to match an external-dns instance configured with
+	// '--label-filter'.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations that will be added to the created DNSEndpoint resources.
+	// This can be used to match an external-dns instance configured with
+	// '--annotation-filter'.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
 }
 
+// ACMEIssuerDNS01ProviderEmbedded is a structure containing the
+// configuration for solving DNS01 challenges using the DNS server embedded in
+// the cert-manager controller.
+type ACMEIssuerDNS01ProviderEmbedded struct{}
+
 // ACMEIssuerDNS01ProviderWebhook specifies configuration for a webhook DNS01
 // provider, including where to POST ChallengePayload resources.
 type ACMEIssuerDNS01ProviderWebhook struct {
@@ -0,0 +1,158 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package v1alpha1 contains the types exchanged with external approvers of
+// CertificateRequests.
+//
+// An external approver is an HTTPS endpoint that the cert-manager controller
+// POSTs a CertificateRequestReview to, with the request field set. The
+// endpoint responds with a CertificateRequestReview with the response field
+// set, containing the verdict on the CertificateRequest.
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+)
+
+const (
+	// SchemeGroupVersion is the apiVersion of CertificateRequestReviews.
+	SchemeGroupVersion = "approval.cert-manager.io/v1alpha1"
+
+	// CertificateRequestReviewKind is the kind of CertificateRequestReviews.
+	CertificateRequestReviewKind = "CertificateRequestReview"
+)
+
+// CertificateRequestReview describes a review of a CertificateRequest by an
+// external approver.
+type CertificateRequestReview struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Request describes the CertificateRequest being reviewed. It is set by
+	// cert-manager.
+	// +optional
+	Request *CertificateRequestReviewRequest `json:"request,omitempty"`
+
+	// Response is the verdict of the external approver. It is set by the
+	// external approver.
+	// +optional
+	Response *CertificateRequestReviewResponse `json:"response,omitempty"`
+}
+
+// CertificateRequestReviewRequest describes the CertificateRequest being
+// reviewed.
+type CertificateRequestReviewRequest struct {
+	// UID identifies the review. It is the UID of the CertificateRequest, and
+	// must be copied into the response.
+	UID types.UID `json:"uid"`
+
+	// CertificateRequest is the CertificateRequest being reviewed.
+	CertificateRequest cmapi.CertificateRequest `json:"certificateRequest"`
+
+	// CSR contains the fields of the decoded CSR of the CertificateRequest.
+	CSR CertificateSigningRequestFields `json:"csr"`
+
+	// Username of the user that created the CertificateRequest.
+	// +optional
+	Username string `json:"username,omitempty"`
+
+	// Groups of the user that created the CertificateRequest.
+	// +optional
+	Groups []string `json:"groups,omitempty"`
+}
+
+// CertificateSigningRequestFields are the fields of a decoded CSR.
+type CertificateSigningRequestFields struct {
+	// CommonName requested in the subject of the CSR.
+	// +optional
+	CommonName string `json:"commonName,omitempty"`
+
+	// Organizations requested in the subject of the CSR.
+	// +optional
+	Organizations []string `json:"organizations,omitempty"`
+
+	// Countries requested in the subject of the CSR.
+	// +optional
+	Countries []string `json:"countries,omitempty"`
+
+	// OrganizationalUnits requested in the subject of the CSR.
+	// +optional
+	OrganizationalUnits []string `json:"organizationalUnits,omitempty"`
+
+	// Localities requested in the subject of the CSR.
+	// +optional
+	Localities []string `json:"localities,omitempty"`
+
+	// Provinces requested in the subject of the CSR.
+	// +optional
+	Provinces []string `json:"provinces,omitempty"`
+
+	// StreetAddresses requested in the subject of the CSR.
+	// +optional
+	StreetAddresses []string `json:"streetAddresses,omitempty"`
+
+	// PostalCodes requested in the subject of the CSR.
+	// +optional
+	PostalCodes []string `json:"postalCodes,omitempty"`
+
+	// SerialNumber requested in the subject of the CSR.
+	// +optional
+	SerialNumber string `json:"serialNumber,omitempty"`
+
+	// DNSNames requested in the subjectAltNames of the CSR.
+	// +optional
+	DNSNames []string `json:"dnsNames,omitempty"`
+
+	// IPAddresses requested in the subjectAltNames of the CSR.
+	// +optional
+	IPAddresses []string `json:"ipAddresses,omitempty"`
+
+	// URIs requested in the subjectAltNames of the CSR.
+	// +optional
+	URIs []string `json:"uris,omitempty"`
+
+	// EmailAddresses requested in the subjectAltNames of the CSR.
+	// +optional
+	EmailAddresses []string `json:"emailAddresses,omitempty"`
+
+	// PublicKeyAlgorithm is the algorithm of the public key of the CSR. One
+	// of RSA, ECDSA or Ed25519.
+	// +optional
+	PublicKeyAlgorithm cmapi.PrivateKeyAlgorithm `json:"publicKeyAlgorithm,omitempty"`
+
+	// PublicKeySize is the size of the public key of the CSR, in bits for
+	// RSA keys or the curve size for ECDSA keys.
+	// +optional
+	PublicKeySize int `json:"publicKeySize,omitempty"`
+}
+
+// CertificateRequestReviewResponse is the verdict of an external approver on
+// a CertificateRequest.
+type CertificateRequestReviewResponse struct {
+	// UID is the UID of the review request being responded to.
+	UID types.UID `json:"uid"`
+
+	// Approved is true if the CertificateRequest should be approved, and
+	// false if it should be denied.
+	Approved bool `json:"approved"`
+
+	// Message is a human readable reason for the verdict, which is set on the
+	// condition of the CertificateRequest.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
@@ -54,6 +54,51 @@ const (
 	// Annotation key used to denote whether a Secret is named on a Certificate
 	// as a 'next private key' Secret resource.
 	IsNextPrivateKeySecretLabelKey = "cert-manager.io/next-private-key"
+
+	// Label key used to denote that a Secret is a copy of a Certificate's
+	// Secret, written to one of the Certificate's additional secret targets.
+	IsAdditionalSecretTargetLabelKey = "cert-manager.io/additional-secret-target"
+
+	// Annotation key for the namespace and name, formatted as
+	// 'namespace/name', of the Certificate that a Secret is an additional
+	// secret target of.
+	AdditionalSecretTargetOfAnnotationKey = "cert-manager.io/additional-secret-target-of"
+
+	// Annotation key for the comma separated names of the private key
+	// profiles whose certificates and private keys cert-manager has stored
+	// in a Secret.
+	PrivateKeyProfilesAnnotationKey = "cert-manager.io/private-key-profiles"
+)
+
+// Annotation keys that may be set on a Namespace to default fields that are
+// left unset on Certificates created in that Namespace.
+const (
+	// Annotation key for the default 'name' of the Issuer resource.
+	DefaultIssuerNameAnnotationKey = "defaults.cert-manager.io/issuer-name"
+
+	// Annotation key for the default 'kind' of the Issuer resource.
+	DefaultIssuerKindAnnotationKey = "defaults.cert-manager.io/issuer-kind"
+
+	// Annotation key for the default 'group' of the Issuer resource.
+	DefaultIssuerGroupAnnotationKey = "defaults.cert-manager.io/issuer-group"
+
+	// Annotation key for the default certificate duration.
+	DefaultDurationAnnotationKey = "defaults.cert-manager.io/duration"
+
+	// Annotation key for the default certificate renewBefore.
+	DefaultRenewBeforeAnnotationKey = "defaults.cert-manager.io/renew-before"
+
+	// Annotation key for the default private key algorithm.
+	DefaultPrivateKeyAlgorithmAnnotationKey = "defaults.cert-manager.io/private-key-algorithm"
+
+	// Annotation key for the default private key rotation policy.
+	DefaultPrivateKeyRotationPolicyAnnotationKey = "defaults.cert-manager.io/private-key-rotation-policy"
+
+	// Annotation key for the default, comma separated, subject organizations.
+	DefaultOrganizationsAnnotationKey = "defaults.cert-manager.io/organizations"
+
+	// Annotation key for the default, comma separated, certificate key usages.
+	DefaultUsagesAnnotationKey = "defaults.cert-manager.io/usages"
 )
 
 const (
@@ -85,6 +130,11 @@ const (
 
 	// Annotation to declare the CertificateRequest "revision", belonging to a Certificate Resource
 	CertificateRequestRevisionAnnotationKey = "cert-manager.io/certificate-revision"
+
+	// Annotation to declare the name of the private key profile of a Certificate
+	// that a CertificateRequest was created for. Not set on CertificateRequests
+	// for the Certificate's primary private key.
+	CertificateRequestPrivateKeyProfileAnnotationKey = "cert-manager.io/private-key-profile"
 )
 
 const (
@@ -94,6 +144,15 @@ const (
 	// stored in the target Secret resource whilst the real Issuer is processing
 	// the certificate request.
 	IssueTemporaryCertificateAnnotation = "cert-manager.io/issue-temporary-certificate"
+
+	// AdoptExistingSecretAnnotation is an annotation that can be added to
+	// Certificate resources.
+	// If it is set to "true" and the Certificate's Secret already contains a
+	// certificate that was not issued by cert-manager, the Secret will be
+	// taken over without re-issuing the certificate, as long as the
+	// certificate matches the Certificate's spec and was signed by the CA of
+	// the referenced issuer.
+	AdoptExistingSecretAnnotation = "cert-manager.io/adopt-existing-secret"
 )
 
 // Common/known resource kinds.
@@ -106,8 +165,14 @@ const (
 
 const (
 	// WantInjectAnnotation is the annotation that specifies that a particular
-	// object wants injection of CAs.  It takes the form of a reference to a certificate
-	// as namespace/name.  The certificate is expected to have the is-serving-for annotations.
+	// object wants injection of CAs.  It takes the form of a comma separated list of
+	// references to certificates as namespace/name.  The certificate is expected to have
+	// the is-serving-for annotations.
+	// The CAs of all referenced certificates, and of any other injection annotations of
+	// the object, are merged into a single de-duplicated bundle.
+	// A list may only reference Certificates. To inject the CAs of both Certificates and
+	// Secrets, for example during a CA rollover, also set the inject-ca-from-secret
+	// annotation.
 	WantInjectAnnotation = "cert-manager.io/inject-ca-from"
 
 	// WantInjectAPIServerCAAnnotation, if set to "true", will make the cainjector
@@ -117,8 +182,10 @@ const (
 	WantInjectAPIServerCAAnnotation = "cert-manager.io/inject-apiserver-ca"
 
 	// WantInjectFromSecretAnnotation is the annotation that specifies that a particular
-	// object wants injection of CAs.  It takes the form of a reference to a Secret
-	// as namespace/name.
+	// object wants injection of CAs.  It takes the form of a comma separated list of
+	// references to Secrets as namespace/name. It may be set together with the
+	// inject-ca-from annotation, in which case the CAs of the referenced Certificates
+	// and Secrets are merged.
 	WantInjectFromSecretAnnotation = "cert-manager.io/inject-ca-from-secret"
 
 	// AllowsInjectionFromSecretAnnotation is an annotation that must be added
@@ -83,6 +83,30 @@ const (
 	PKCS8 PrivateKeyEncoding = "PKCS8"
 )
 
+// CertificateOutputFormatType specifies an additional output format to be
+// written to the Certificate's target Secret.
+// +kubebuilder:validation:Enum=CombinedPEM;DER;FullChainPEM
+type CertificateOutputFormatType string
+
+const (
+	// CertificateOutputFormatCombinedPEM writes an additional
+	// `tls-combined.pem` entry to the Secret, containing the PEM encoded
+	// private key followed by the signed certificate chain
+	// (tls.key + tls.crt concatenated).
+	CertificateOutputFormatCombinedPEM CertificateOutputFormatType = "CombinedPEM"
+
+	// CertificateOutputFormatDER writes additional `key.der` and `tls.der`
+	// entries to the Secret, containing the DER (binary) encoded private key
+	// and leaf certificate.
+	CertificateOutputFormatDER CertificateOutputFormatType = "DER"
+
+	// CertificateOutputFormatFullChainPEM writes an additional
+	// `fullchain.pem` entry to the Secret, containing the signed certificate
+	// chain followed by the CA certificate (tls.crt + ca.crt concatenated),
+	// so that the chain includes the root.
+	CertificateOutputFormatFullChainPEM CertificateOutputFormatType = "FullChainPEM"
+)
+
 // CertificateSpec defines the desired state of Certificate.
 // A valid Certificate requires at least one of a CommonName, DNSName, or
 // URISAN to be valid.
@@ -116,6 +140,38 @@ type CertificateSpec struct {
 	// +optional
 	RenewBefore *metav1.Duration `json:"renewBefore,omitempty"`
 
+	// RenewBeforePercentage is like RenewBefore, except it is expressed as
+	// a percentage of the issued certificate's duration rather than as an
+	// absolute duration. For example, a value of 25 renews the certificate
+	// when a quarter of its duration remains. Must be between 1 and 99, and
+	// cannot be set together with renewBefore.
+	// +optional
+	RenewBeforePercentage *int32 `json:"renewBeforePercentage,omitempty"`
+
+	// RenewalJitter is the maximum amount of time by which the renewal of
+	// the certificate may be brought forward. The offset used is chosen at
+	// random per certificate and issuance, so that certificates issued at
+	// the same time do not all renew at the same time. If not set, the
+	// controller's default renewal jitter is used, which is disabled unless
+	// configured. Value must be in units accepted by Go time.ParseDuration
+	// https://golang.org/pkg/time/#ParseDuration
+	// +optional
+	RenewalJitter *metav1.Duration `json:"renewalJitter,omitempty"`
+
+	// RenewalWindows restricts when a renewal of the certificate, triggered
+	// by it reaching its renewal time, may start. If set, such renewals are
+	// deferred until one of the windows is open. Renewals are not deferred
+	// if the next window opens less than 24 hours before the certificate
+	// expires, nor for other reasons for issuance such as a change to the
+	// Certificate's spec.
+	// +optional
+	RenewalWindows []CertificateRenewalWindow `json:"renewalWindows,omitempty"`
+
+	// RetryBackoff overrides the controller's default backoff between
+	// failed attempts to issue the certificate.
+	// +optional
+	RetryBackoff *CertificateRetryBackoff `json:"retryBackoff,omitempty"`
+
 	// DNSNames is a list of DNS subjectAltNames to be set on the Certificate.
 	// +optional
 	DNSNames []string `json:"dnsNames,omitempty"`
@@ -138,11 +194,37 @@ type CertificateSpec struct {
 	// denoted issuer.
 	SecretName string `json:"secretName"`
 
+	// SecretTemplate defines annotations and labels to be copied to the
+	// Certificate's Secret. They are reconciled onto the Secret each time it
+	// is issued and whenever the template changes. Labels and annotations
+	// removed from the template are not removed from the Secret.
+	// SecretTemplate annotations are added in conjunction with, and cannot
+	// overwrite, the base set of annotations cert-manager sets on the
+	// Certificate's Secret.
+	// +optional
+	SecretTemplate *CertificateSecretTemplate `json:"secretTemplate,omitempty"`
+
 	// Keystores configures additional keystore output formats stored in the
 	// `secretName` Secret resource.
 	// +optional
 	Keystores *CertificateKeystores `json:"keystores,omitempty"`
 
+	// AdditionalOutputFormats defines extra output formats of the private key
+	// and signed certificate chain to be written to this Certificate's target
+	// Secret. Entries for formats that are removed from this list are removed
+	// from the Secret.
+	// +optional
+	AdditionalOutputFormats []CertificateAdditionalOutputFormat `json:"additionalOutputFormats,omitempty"`
+
+	// AdditionalSecretTargets are Secrets, in the Certificate's namespace or
+	// in other namespaces, that the contents of the Secret named by
+	// secretName are copied to and kept in sync with. Copies in other
+	// namespaces are only written if the namespace is selected by the
+	// controller's --additional-secret-target-namespace-selector flag.
+	// Copies are deleted when they are removed from this list.
+	// +optional
+	AdditionalSecretTargets []CertificateSecretTarget `json:"additionalSecretTargets,omitempty"`
+
 	// IssuerRef is a reference to the issuer for this certificate.
 	// If the `kind` field is not set, or set to `Issuer`, an Issuer resource
 	// with the given name in the same namespace as the Certificate will be used.
@@ -165,6 +247,15 @@ type CertificateSpec struct {
 	// +optional
 	PrivateKey *CertificatePrivateKey `json:"privateKey,omitempty"`
 
+	// PrivateKeyProfiles are additional private keys to issue certificates
+	// for, alongside the private key configured by `privateKey`. Each
+	// certificate has the same subject and names, and is stored in the Secret
+	// together with its private key under the `tls-<name>.crt` and
+	// `tls-<name>.key` keys. This can be used to serve both an RSA and an
+	// ECDSA certificate from a single Certificate.
+	// +optional
+	PrivateKeyProfiles []CertificatePrivateKeyProfile `json:"privateKeyProfiles,omitempty"`
+
 	// EncodeUsagesInRequest controls whether key usages should be present
 	// in the CertificateRequest
 	// +optional
@@ -226,6 +317,19 @@ type CertificatePrivateKey struct {
 	Size int `json:"size,omitempty"` // Validated by webhook. Be mindful of adding OpenAPI validation- see https://github.com/jetstack/cert-manager/issues/3644
 }
 
+// CertificatePrivateKeyProfile is an additional private key for which a
+// certificate is issued.
+type CertificatePrivateKeyProfile struct {
+	// Name of the profile, which must be a valid DNS label. The private key
+	// and certificate of the profile are stored in the Secret under the
+	// `tls-<name>.key` and `tls-<name>.crt` keys.
+	Name string `json:"name"`
+
+	// Options to control the private key of the profile.
+	// +optional
+	PrivateKey CertificatePrivateKey `json:"privateKey,omitempty"`
+}
+
 // Denotes how private keys should be generated or sourced when a Certificate
 // is being issued.
 type PrivateKeyRotationPolicy string
@@ -270,6 +374,75 @@ type X509Subject struct {
 	SerialNumber string `json:"serialNumber,omitempty"`
 }
 
+// CertificateSecretTemplate defines the default labels and annotations
+// to be copied to the Kubernetes Secret resource named in `CertificateSpec.secretName`.
+type CertificateSecretTemplate struct {
+	// Annotations is a key value map to be copied to the target Kubernetes Secret.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// Labels is a key value map to be copied to the target Kubernetes Secret.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// CertificateRenewalWindow is a recurring window of time in which the
+// renewal of a certificate may start.
+type CertificateRenewalWindow struct {
+	// Schedule is a standard 5 field cron schedule, such as "0 3 * * SUN",
+	// which determines when the window opens. The @yearly, @monthly,
+	// @weekly, @daily and @hourly descriptors are also accepted.
+	Schedule string `json:"schedule"`
+
+	// Duration is how long the window stays open for once it has opened.
+	// Value must be in units accepted by Go time.ParseDuration
+	// https://golang.org/pkg/time/#ParseDuration
+	Duration metav1.Duration `json:"duration"`
+
+	// TimeZone is the IANA time zone name, such as "Europe/London", in
+	// which the schedule is evaluated. Defaults to UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// CertificateRetryBackoff configures the exponential backoff between failed
+// attempts to issue a certificate. The delay after the first failure is Min,
+// and doubles with each further consecutive failure up to Max.
+type CertificateRetryBackoff struct {
+	// Min is the delay after the first failed issuance attempt. Defaults to
+	// the controller's minimum issuance retry backoff.
+	// Value must be in units accepted by Go time.ParseDuration
+	// https://golang.org/pkg/time/#ParseDuration
+	// +optional
+	Min *metav1.Duration `json:"min,omitempty"`
+
+	// Max is the maximum delay between failed issuance attempts. Defaults to
+	// the controller's maximum issuance retry backoff.
+	// Value must be in units accepted by Go time.ParseDuration
+	// https://golang.org/pkg/time/#ParseDuration
+	// +optional
+	Max *metav1.Duration `json:"max,omitempty"`
+}
+
+// CertificateAdditionalOutputFormat defines an additional output format of a
+// Certificate resource. These contain supplementary data formats of the
+// signed certificate chain and paired private key.
+type CertificateAdditionalOutputFormat struct {
+	// Type is the name of the format type that should be written to the
+	// Certificate's target Secret.
+	Type CertificateOutputFormatType `json:"type"`
+}
+
+// CertificateSecretTarget is a Secret that the contents of a Certificate's
+// Secret are copied to.
+type CertificateSecretTarget struct {
+	// Namespace of the Secret.
+	Namespace string `json:"namespace"`
+
+	// Name of the Secret.
+	Name string `json:"name"`
+}
+
 // CertificateKeystores configures additional keystore output formats to be
 // created in the Certificate's output Secret.
 type CertificateKeystores struct {
@@ -331,10 +504,16 @@ type CertificateStatus struct {
 	// of the most recent failure to complete a CertificateRequest for this
 	// Certificate resource.
 	// If set, cert-manager will not re-request another Certificate until
-	// 1 hour has elapsed from this time.
+	// the retry backoff has elapsed from this time.
 	// +optional
 	LastFailureTime *metav1.Time `json:"lastFailureTime,omitempty"`
 
+	// FailedIssuanceAttempts is the number of consecutive failed attempts to
+	// issue the certificate since it was last successfully issued. It
+	// determines the exponential backoff before issuance is retried.
+	// +optional
+	FailedIssuanceAttempts *int `json:"failedIssuanceAttempts,omitempty"`
+
 	// The time after which the certificate stored in the secret named
 	// by this resource in spec.secretName is valid.
 	// +optional
@@ -351,6 +530,13 @@ type CertificateStatus struct {
 	// +optional
 	RenewalTime *metav1.Time `json:"renewalTime,omitempty"`
 
+	// IssuerCAFingerprint is the SHA-256 fingerprint of the CA certificate
+	// that signed the currently issued certificate, if it could be found in
+	// the certificate chain or CA stored in the Secret. It is used to
+	// re-issue the certificate when the issuer's CA is rotated.
+	// +optional
+	IssuerCAFingerprint string `json:"issuerCAFingerprint,omitempty"`
+
 	// The current 'revision' of the certificate as issued.
 	//
 	// When a CertificateRequest resource is created, it will have the
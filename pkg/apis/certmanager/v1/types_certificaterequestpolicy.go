@@ -0,0 +1,205 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// A CertificateRequestPolicy describes the CertificateRequests that may be
+// approved for a set of issuers.
+//
+// A policy only applies to CertificateRequests whose requester is bound to it
+// through RBAC, by being allowed the 'use' verb on the
+// 'certificaterequestpolicies' resource with the name of the policy, either
+// cluster wide or in the namespace of the CertificateRequest. A
+// CertificateRequest is approved if it is allowed by at least one of the
+// policies that apply to it, and denied otherwise.
+// +k8s:openapi-gen=true
+type CertificateRequestPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Desired state of the CertificateRequestPolicy resource.
+	Spec CertificateRequestPolicySpec `json:"spec"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CertificateRequestPolicyList is a list of CertificateRequestPolicies
+type CertificateRequestPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []CertificateRequestPolicy `json:"items"`
+}
+
+// CertificateRequestPolicySpec describes the CertificateRequests that are
+// allowed by a policy.
+//
+// The allowed values of names and subject fields are patterns, in which '*'
+// matches any sequence of characters. If the list of allowed values of a field
+// is empty, CertificateRequests that request any value for that field are not
+// allowed.
+type CertificateRequestPolicySpec struct {
+	// IssuerRefs selects the issuers that the policy applies to. The policy
+	// applies to CertificateRequests that reference any of the given issuers.
+	// If empty, the policy applies to all issuers.
+	// +optional
+	IssuerRefs []CertificateRequestPolicyIssuerRef `json:"issuerRefs,omitempty"`
+
+	// AllowedCommonNames are the common names that may be requested.
+	// +optional
+	AllowedCommonNames []string `json:"allowedCommonNames,omitempty"`
+
+	// AllowedDNSNames are the DNS subjectAltNames that may be requested.
+	// +optional
+	AllowedDNSNames []string `json:"allowedDNSNames,omitempty"`
+
+	// AllowedIPAddresses are the IP address subjectAltNames that may be
+	// requested.
+	// +optional
+	AllowedIPAddresses []string `json:"allowedIPAddresses,omitempty"`
+
+	// AllowedURIs are the URI subjectAltNames that may be requested.
+	// +optional
+	AllowedURIs []string `json:"allowedURIs,omitempty"`
+
+	// AllowedEmailAddresses are the email address subjectAltNames that may be
+	// requested.
+	// +optional
+	AllowedEmailAddresses []string `json:"allowedEmailAddresses,omitempty"`
+
+	// AllowedSubject are the values of the X509 subject fields, other than
+	// the common name, that may be requested.
+	// +optional
+	AllowedSubject *CertificateRequestPolicySubject `json:"allowedSubject,omitempty"`
+
+	// AllowedUsages are the key usages that may be requested.
+	// +optional
+	AllowedUsages []KeyUsage `json:"allowedUsages,omitempty"`
+
+	// AllowIsCA allows CertificateRequests for CA certificates.
+	// +optional
+	AllowIsCA bool `json:"allowIsCA,omitempty"`
+
+	// MinDuration is the minimum duration that may be requested.
+	// CertificateRequests that do not request a duration are treated as
+	// requesting the default duration of 90 days.
+	// +optional
+	MinDuration *metav1.Duration `json:"minDuration,omitempty"`
+
+	// MaxDuration is the maximum duration that may be requested.
+	// CertificateRequests that do not request a duration are treated as
+	// requesting the default duration of 90 days.
+	// +optional
+	MaxDuration *metav1.Duration `json:"maxDuration,omitempty"`
+
+	// AllowedPrivateKeys are the private key algorithms and sizes that may be
+	// requested. If empty, any private key may be requested.
+	// +optional
+	AllowedPrivateKeys []CertificateRequestPolicyPrivateKey `json:"allowedPrivateKeys,omitempty"`
+
+	// Expressions are CEL expressions that must all evaluate to true for the
+	// policy to allow a CertificateRequest, in addition to the other fields
+	// of the policy. Expressions are evaluated against the 'request'
+	// variable, which has the fields:
+	// - namespace and name of the CertificateRequest.
+	// - username, uid, groups and extra of the requester.
+	// - issuerRef, with the name, kind and group of the issuer.
+	// - commonName, dnsNames, ipAddresses, uris and emailAddresses requested
+	//   in the CSR.
+	// - subject, with the organizations, countries, organizationalUnits,
+	//   localities, provinces, streetAddresses, postalCodes and serialNumber
+	//   requested in the CSR.
+	// - usages, isCA and duration requested by the CertificateRequest.
+	// - keyAlgorithm and keySize of the public key of the CSR.
+	// For example, "request.dnsNames.all(n, n.endsWith(request.namespace +
+	// '.svc'))" only allows DNS names ending in the namespace of the
+	// CertificateRequest.
+	// +optional
+	Expressions []string `json:"expressions,omitempty"`
+}
+
+// CertificateRequestPolicyIssuerRef selects issuers by their name, kind and
+// group, each of which may be a pattern in which '*' matches any sequence of
+// characters. An empty field matches any value.
+type CertificateRequestPolicyIssuerRef struct {
+	// Name of the issuer.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Kind of the issuer. CertificateRequests that do not set the kind of
+	// their issuer are matched as referencing an 'Issuer'.
+	// +optional
+	Kind string `json:"kind,omitempty"`
+
+	// Group of the issuer. CertificateRequests that do not set the group of
+	// their issuer are matched as referencing the 'cert-manager.io' group.
+	// +optional
+	Group string `json:"group,omitempty"`
+}
+
+// CertificateRequestPolicySubject lists the allowed values of each X509
+// subject field.
+type CertificateRequestPolicySubject struct {
+	// Organizations that may be requested.
+	// +optional
+	Organizations []string `json:"organizations,omitempty"`
+	// Countries that may be requested.
+	// +optional
+	Countries []string `json:"countries,omitempty"`
+	// Organizational Units that may be requested.
+	// +optional
+	OrganizationalUnits []string `json:"organizationalUnits,omitempty"`
+	// Cities that may be requested.
+	// +optional
+	Localities []string `json:"localities,omitempty"`
+	// State/Provinces that may be requested.
+	// +optional
+	Provinces []string `json:"provinces,omitempty"`
+	// Street addresses that may be requested.
+	// +optional
+	StreetAddresses []string `json:"streetAddresses,omitempty"`
+	// Postal codes that may be requested.
+	// +optional
+	PostalCodes []string `json:"postalCodes,omitempty"`
+	// Serial numbers that may be requested.
+	// +optional
+	SerialNumbers []string `json:"serialNumbers,omitempty"`
+}
+
+// CertificateRequestPolicyPrivateKey is a private key algorithm, and the
+// range of sizes of that algorithm, that may be requested.
+type CertificateRequestPolicyPrivateKey struct {
+	// Algorithm of the private key.
+	Algorithm PrivateKeyAlgorithm `json:"algorithm"`
+
+	// MinSize is the minimum size of the private key, in bits for RSA keys
+	// or the curve size for ECDSA keys. If not set, there is no minimum.
+	// +optional
+	MinSize int `json:"minSize,omitempty"`
+
+	// MaxSize is the maximum size of the private key, in bits for RSA keys
+	// or the curve size for ECDSA keys. If not set, there is no maximum.
+	// +optional
+	MaxSize int `json:"maxSize,omitempty"`
+}
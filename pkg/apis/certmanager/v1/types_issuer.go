@@ -91,6 +91,82 @@ type IssuerList struct {
 // configuration required for the issuer.
 type IssuerSpec struct {
 	IssuerConfig `json:",inline"`
+
+	// Approval configures how CertificateRequests referencing this issuer
+	// must be approved before they are signed.
+	// +optional
+	Approval *IssuerApproval `json:"approval,omitempty"`
+
+	// Constraints restrict the certificates that this issuer will sign.
+	// Certificates that violate the constraints are rejected when they are
+	// created, and CertificateRequests that violate them are never signed.
+	// +optional
+	Constraints *IssuerConstraints `json:"constraints,omitempty"`
+
+	// NamespaceSelector restricts the namespaces whose Certificates and
+	// CertificateRequests may reference this issuer. It may only be set on
+	// ClusterIssuers. If not set, the ClusterIssuer may be used from any
+	// namespace.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+}
+
+// IssuerApproval configures the approval of CertificateRequests that
+// reference an issuer.
+type IssuerApproval struct {
+	// RequiredApprovals is the number of distinct users that must approve a
+	// CertificateRequest referencing this issuer before it may be marked as
+	// Approved. Approvals are recorded in the `status.approvals` field of the
+	// CertificateRequest. CertificateRequests referencing an issuer that sets
+	// this field are never approved automatically by cert-manager.
+	RequiredApprovals int `json:"requiredApprovals"`
+}
+
+// IssuerConstraints restricts the certificates that an issuer will sign.
+type IssuerConstraints struct {
+	// MinDuration is the minimum duration that may be requested for a
+	// certificate signed by this issuer.
+	// +optional
+	MinDuration *metav1.Duration `json:"minDuration,omitempty"`
+
+	// MaxDuration is the maximum duration that may be requested for a
+	// certificate signed by this issuer.
+	// +optional
+	MaxDuration *metav1.Duration `json:"maxDuration,omitempty"`
+
+	// AllowedPrivateKeys lists the private key algorithms, and their minimum
+	// sizes, that certificates signed by this issuer may use.
+	// If not set, any private key may be used.
+	// +optional
+	AllowedPrivateKeys []IssuerPrivateKeyConstraint `json:"allowedPrivateKeys,omitempty"`
+
+	// AllowedDomains lists the domains that the common name, DNS names and
+	// email address domains of certificates signed by this issuer must be
+	// within. A name is within a domain if it is equal to the domain or is a
+	// subdomain of it, for example `example.com` allows both `example.com`
+	// and `*.foo.example.com`. If set, IP addresses and URIs may not be
+	// requested.
+	// If not set, any DNS name may be requested.
+	// +optional
+	AllowedDomains []string `json:"allowedDomains,omitempty"`
+
+	// AllowCA permits this issuer to sign CA certificates. Defaults to false,
+	// in which case CertificateRequests with `isCA` set are never signed.
+	// +optional
+	AllowCA bool `json:"allowCA,omitempty"`
+}
+
+// IssuerPrivateKeyConstraint allows a private key algorithm to be used by
+// certificates signed by an issuer.
+type IssuerPrivateKeyConstraint struct {
+	// Algorithm is the allowed private key algorithm.
+	Algorithm PrivateKeyAlgorithm `json:"algorithm"`
+
+	// MinSize is the minimum size of allowed private keys: the number of bits
+	// for RSA keys, or the curve size for ECDSA keys. It is ignored for
+	// Ed25519 keys. If not set, any size may be used.
+	// +optional
+	MinSize int `json:"minSize,omitempty"`
 }
 
 // The configuration for the issuer.
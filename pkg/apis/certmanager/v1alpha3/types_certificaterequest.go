@@ -151,6 +151,32 @@ type CertificateRequestStatus struct {
 	// used to influence garbage collection and back-off.
 	// +optional
 	FailureTime *metav1.Time `json:"failureTime,omitempty"`
+
+	// Approvals records the users that have approved this CertificateRequest.
+	// An issuer may require approvals from a number of distinct users before
+	// the CertificateRequest may be marked as Approved.
+	// +optional
+	Approvals []CertificateRequestApproval `json:"approvals,omitempty"`
+}
+
+// CertificateRequestApproval records the approval of a CertificateRequest by
+// a single user.
+type CertificateRequestApproval struct {
+	// Username of the user that approved the CertificateRequest. This is
+	// always set by cert-manager to the user that recorded the approval.
+	Username string `json:"username"`
+
+	// ApprovalTime is the time at which the approval was recorded.
+	// +optional
+	ApprovalTime *metav1.Time `json:"approvalTime,omitempty"`
+
+	// Reason is a brief machine readable explanation for the approval.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Message is a human readable description of the approval.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // CertificateRequestCondition contains condition information for a CertificateRequest.
@@ -45,6 +45,20 @@ const (
 	// Annotation key used to denote whether a Secret is named on a Certificate
 	// as a 'next private key' Secret resource.
 	IsNextPrivateKeySecretLabelKey = "cert-manager.io/next-private-key"
+
+	// Label key used to denote that a Secret is a copy of a Certificate's
+	// Secret, written to one of the Certificate's additional secret targets.
+	IsAdditionalSecretTargetLabelKey = "cert-manager.io/additional-secret-target"
+
+	// Annotation key for the namespace and name, formatted as
+	// 'namespace/name', of the Certificate that a Secret is an additional
+	// secret target of.
+	AdditionalSecretTargetOfAnnotationKey = "cert-manager.io/additional-secret-target-of"
+
+	// Annotation key for the comma separated names of the private key
+	// profiles whose certificates and private keys cert-manager has stored
+	// in a Secret.
+	PrivateKeyProfilesAnnotationKey = "cert-manager.io/private-key-profiles"
 )
 
 // Deprecated annotation names for Secrets
@@ -83,6 +97,11 @@ const (
 
 	// Annotation to declare the CertificateRequest "revision", belonging to a Certificate Resource
 	CertificateRequestRevisionAnnotationKey = "cert-manager.io/certificate-revision"
+
+	// Annotation to declare the name of the private key profile of a Certificate
+	// that a CertificateRequest was created for. Not set on CertificateRequests
+	// for the Certificate's primary private key.
+	CertificateRequestPrivateKeyProfileAnnotationKey = "cert-manager.io/private-key-profile"
 )
 
 const (
@@ -92,6 +111,15 @@ const (
 	// stored in the target Secret resource whilst the real Issuer is processing
 	// the certificate request.
 	IssueTemporaryCertificateAnnotation = "cert-manager.io/issue-temporary-certificate"
+
+	// AdoptExistingSecretAnnotation is an annotation that can be added to
+	// Certificate resources.
+	// If it is set to "true" and the Certificate's Secret already contains a
+	// certificate that was not issued by cert-manager, the Secret will be
+	// taken over without re-issuing the certificate, as long as the
+	// certificate matches the Certificate's spec and was signed by the CA of
+	// the referenced issuer.
+	AdoptExistingSecretAnnotation = "cert-manager.io/adopt-existing-secret"
 )
 
 // Common/known resource kinds.
@@ -0,0 +1,30 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+const (
+	// Label key for the name of the Bundle that a ConfigMap was written for.
+	BundleNameLabelKey = "trust.cert-manager.io/bundle-name"
+
+	// Annotation key for the hash of the contents that a Bundle last wrote to
+	// a ConfigMap.
+	BundleHashAnnotationKey = "trust.cert-manager.io/bundle-hash"
+)
+
+const (
+	BundleKind = "Bundle"
+)
@@ -0,0 +1,227 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// A Bundle distributes a bundle of trusted CA certificates to a ConfigMap in
+// each of the namespaces that it targets.
+//
+// The certificates of all of the Bundle's sources are concatenated and
+// de-duplicated, and written to a ConfigMap with the same name as the Bundle.
+// ConfigMaps that already exist and were not written for the Bundle are
+// never overwritten.
+// +k8s:openapi-gen=true
+type Bundle struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Desired state of the Bundle resource.
+	Spec BundleSpec `json:"spec"`
+
+	// Status of the Bundle. This is set and managed automatically.
+	// +optional
+	Status BundleStatus `json:"status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BundleList is a list of Bundles
+type BundleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []Bundle `json:"items"`
+}
+
+const (
+	// BundleDefaultKey is the default key of the entries that PEM encoded
+	// certificates are read from and written to.
+	BundleDefaultKey = "ca.crt"
+
+	// BundleDefaultJKSKey is the default key of the ConfigMap entry that a
+	// JKS truststore is written to.
+	BundleDefaultJKSKey = "truststore.jks"
+
+	// BundleDefaultPKCS12Key is the default key of the ConfigMap entry that a
+	// PKCS#12 truststore is written to.
+	BundleDefaultPKCS12Key = "truststore.p12"
+
+	// BundleDefaultTruststorePassword is the default password of JKS and
+	// PKCS#12 truststores.
+	BundleDefaultTruststorePassword = "changeit"
+)
+
+// BundleSpec describes where the certificates of a Bundle are read from, and
+// where they are written to.
+type BundleSpec struct {
+	// Sources of the CA certificates in the bundle.
+	Sources []BundleSource `json:"sources"`
+
+	// Target describes how the bundle is written to each namespace.
+	Target BundleTarget `json:"target"`
+}
+
+// BundleSource is a source of PEM encoded CA certificates. Exactly one of its
+// fields must be set.
+type BundleSource struct {
+	// Secret is a key of a Secret containing PEM encoded certificates, such as
+	// the Secret of a CA Issuer.
+	// +optional
+	Secret *BundleSourceObjectKeySelector `json:"secret,omitempty"`
+
+	// ConfigMap is a key of a ConfigMap containing PEM encoded certificates.
+	// +optional
+	ConfigMap *BundleSourceObjectKeySelector `json:"configMap,omitempty"`
+
+	// InlinePEM is a string of PEM encoded certificates.
+	// +optional
+	InlinePEM string `json:"inlinePEM,omitempty"`
+
+	// Issuer is a CA Issuer or ClusterIssuer, whose root CA certificate is
+	// added to the bundle.
+	// +optional
+	Issuer *BundleSourceIssuerRef `json:"issuer,omitempty"`
+}
+
+// BundleSourceObjectKeySelector selects a key of a namespaced resource.
+type BundleSourceObjectKeySelector struct {
+	// Namespace of the resource.
+	Namespace string `json:"namespace"`
+
+	// Name of the resource.
+	Name string `json:"name"`
+
+	// Key of the entry in the resource's data to read certificates from.
+	// Defaults to 'ca.crt'.
+	// +optional
+	Key string `json:"key,omitempty"`
+}
+
+// BundleSourceIssuerRef is a reference to a cert-manager Issuer or
+// ClusterIssuer.
+type BundleSourceIssuerRef struct {
+	// Name of the issuer.
+	Name string `json:"name"`
+
+	// Kind of the issuer, either 'Issuer' or 'ClusterIssuer'.
+	// Defaults to 'Issuer'.
+	// +optional
+	Kind string `json:"kind,omitempty"`
+
+	// Namespace of the Issuer. Must be set for Issuers, and must not be set for
+	// ClusterIssuers.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// BundleTarget describes the ConfigMap that a Bundle is written to in each
+// namespace.
+type BundleTarget struct {
+	// Key of the ConfigMap entry that the PEM encoded bundle is written to.
+	// Defaults to 'ca.crt'.
+	// +optional
+	Key string `json:"key,omitempty"`
+
+	// NamespaceSelector selects the namespaces that the bundle is written to.
+	// If not set, the bundle is written to all namespaces.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// JKS, if set, also writes the bundle to the ConfigMap as a JKS
+	// truststore.
+	// +optional
+	JKS *BundleTargetKeystore `json:"jks,omitempty"`
+
+	// PKCS12, if set, also writes the bundle to the ConfigMap as a PKCS#12
+	// truststore.
+	// +optional
+	PKCS12 *BundleTargetKeystore `json:"pkcs12,omitempty"`
+}
+
+// BundleTargetKeystore describes a truststore entry of a Bundle's ConfigMap.
+type BundleTargetKeystore struct {
+	// Key of the ConfigMap binary data entry that the truststore is written
+	// to. Defaults to 'truststore.jks' for JKS truststores and
+	// 'truststore.p12' for PKCS#12 truststores.
+	// +optional
+	Key string `json:"key,omitempty"`
+
+	// PasswordSecretRef is a reference to a key in a Secret resource in the
+	// cluster resource namespace containing the password used to protect the
+	// integrity of the truststore. Defaults to the password 'changeit' if not
+	// set.
+	// +optional
+	PasswordSecretRef *cmmeta.SecretKeySelector `json:"passwordSecretRef,omitempty"`
+}
+
+// BundleStatus defines the observed state of a Bundle.
+type BundleStatus struct {
+	// List of status conditions to indicate the status of a Bundle.
+	// Known condition types are `Ready`.
+	// +optional
+	Conditions []BundleCondition `json:"conditions,omitempty"`
+}
+
+// BundleCondition contains condition information for a Bundle.
+type BundleCondition struct {
+	// Type of the condition, known values are (`Ready`).
+	Type BundleConditionType `json:"type"`
+
+	// Status of the condition, one of (`True`, `False`, `Unknown`).
+	Status cmmeta.ConditionStatus `json:"status"`
+
+	// LastTransitionTime is the timestamp corresponding to the last status
+	// change of this condition.
+	// +optional
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
+
+	// Reason is a brief machine readable explanation for the condition's last
+	// transition.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Message is a human readable description of the details of the last
+	// transition, complementing reason.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// If set, this represents the .metadata.generation that the condition was
+	// set based upon.
+	// For instance, if .metadata.generation is currently 12, but the
+	// .status.condition[x].observedGeneration is 9, the condition is out of date
+	// with respect to the current state of the Bundle.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// BundleConditionType represents a Bundle condition value.
+type BundleConditionType string
+
+const (
+	// BundleConditionReady indicates that the bundle has been built from all
+	// of its sources and written to all of the namespaces that it targets.
+	BundleConditionReady BundleConditionType = "Ready"
+)
@@ -28,6 +28,7 @@ type CertmanagerV1Interface interface {
 	RESTClient() rest.Interface
 	CertificatesGetter
 	CertificateRequestsGetter
+	CertificateRequestPoliciesGetter
 	ClusterIssuersGetter
 	IssuersGetter
 }
@@ -45,6 +46,10 @@ func (c *CertmanagerV1Client) CertificateRequests(namespace string) CertificateR
 	return newCertificateRequests(c, namespace)
 }
 
+func (c *CertmanagerV1Client) CertificateRequestPolicies() CertificateRequestPolicyInterface {
+	return newCertificateRequestPolicies(c)
+}
+
 func (c *CertmanagerV1Client) ClusterIssuers() ClusterIssuerInterface {
 	return newClusterIssuers(c)
 }
@@ -36,6 +36,10 @@ func (c *FakeCertmanagerV1) CertificateRequests(namespace string) v1.Certificate
 	return &FakeCertificateRequests{c, namespace}
 }
 
+func (c *FakeCertmanagerV1) CertificateRequestPolicies() v1.CertificateRequestPolicyInterface {
+	return &FakeCertificateRequestPolicies{c}
+}
+
 func (c *FakeCertmanagerV1) ClusterIssuers() v1.ClusterIssuerInterface {
 	return &FakeClusterIssuers{c}
 }
@@ -29,6 +29,7 @@ import (
 	certmanagerv1alpha2 "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha2"
 	certmanagerv1alpha3 "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha3"
 	certmanagerv1beta1 "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1beta1"
+	trustv1alpha1 "github.com/jetstack/cert-manager/pkg/apis/trust/v1alpha1"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	cache "k8s.io/client-go/tools/cache"
 )
@@ -88,6 +89,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Certmanager().V1().Certificates().Informer()}, nil
 	case certmanagerv1.SchemeGroupVersion.WithResource("certificaterequests"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Certmanager().V1().CertificateRequests().Informer()}, nil
+	case certmanagerv1.SchemeGroupVersion.WithResource("certificaterequestpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Certmanager().V1().CertificateRequestPolicies().Informer()}, nil
 	case certmanagerv1.SchemeGroupVersion.WithResource("clusterissuers"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Certmanager().V1().ClusterIssuers().Informer()}, nil
 	case certmanagerv1.SchemeGroupVersion.WithResource("issuers"):
@@ -123,6 +126,10 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	case certmanagerv1beta1.SchemeGroupVersion.WithResource("issuers"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Certmanager().V1beta1().Issuers().Informer()}, nil
 
+		// Group=trust.cert-manager.io, Version=v1alpha1
+	case trustv1alpha1.SchemeGroupVersion.WithResource("bundles"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Trust().V1alpha1().Bundles().Informer()}, nil
+
 	}
 
 	return nil, fmt.Errorf("no informer found for %v", resource)
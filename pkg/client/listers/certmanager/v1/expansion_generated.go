@@ -34,6 +34,10 @@ type CertificateRequestListerExpansion interface{}
 // CertificateRequestNamespaceLister.
 type CertificateRequestNamespaceListerExpansion interface{}
 
+// CertificateRequestPolicyListerExpansion allows custom methods to be added to
+// CertificateRequestPolicyLister.
+type CertificateRequestPolicyListerExpansion interface{}
+
 // ClusterIssuerListerExpansion allows custom methods to be added to
 // ClusterIssuerLister.
 type ClusterIssuerListerExpansion interface{}
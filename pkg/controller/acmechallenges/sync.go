@@ -18,7 +18,9 @@ package acmechallenges
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	acmeapi "golang.org/x/crypto/acme"
 	corev1 "k8s.io/api/core/v1"
@@ -32,6 +34,7 @@ import (
 	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
 	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
 	"github.com/jetstack/cert-manager/pkg/feature"
+	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns"
 	dnsutil "github.com/jetstack/cert-manager/pkg/issuer/acme/dns/util"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
 	utilfeature "github.com/jetstack/cert-manager/pkg/util/feature"
@@ -103,6 +106,10 @@ func (c *controller) Sync(ctx context.Context, ch *cmacme.Challenge) (err error)
 			}
 
 			err = solver.CleanUp(ctx, genericIssuer, ch)
+			if retryAfter, ok := changePending(err); ok {
+				log.V(logf.DebugLevel).Info("waiting for DNS01 record clean up to be applied")
+				return c.requeueAfter(ch, retryAfter)
+			}
 			if err != nil {
 				c.recorder.Eventf(ch, corev1.EventTypeWarning, reasonCleanUpError, "Error cleaning up challenge: %v", err)
 				ch.Status.Reason = err.Error()
@@ -170,6 +177,10 @@ func (c *controller) Sync(ctx context.Context, ch *cmacme.Challenge) (err error)
 
 	if !ch.Status.Presented {
 		err := solver.Present(ctx, genericIssuer, ch)
+		if retryAfter, ok := changePending(err); ok {
+			ch.Status.Reason = "Waiting for DNS01 record to be presented"
+			return c.requeueAfter(ch, retryAfter)
+		}
 		if err != nil {
 			c.recorder.Eventf(ch, corev1.EventTypeWarning, reasonPresentError, "Error presenting challenge: %v", err)
 			ch.Status.Reason = err.Error()
@@ -282,6 +293,12 @@ func (c *controller) handleFinalizer(ctx context.Context, ch *cmacme.Challenge)
 	}
 
 	err = solver.CleanUp(ctx, genericIssuer, ch)
+	if _, ok := changePending(err); ok {
+		// the change is applied whether or not the challenge still exists,
+		// so there is no need to wait for it before removing the finalizer
+		log.V(logf.DebugLevel).Info("DNS01 record clean up has been queued")
+		return nil
+	}
 	if err != nil {
 		c.recorder.Eventf(ch, corev1.EventTypeWarning, reasonCleanUpError, "Error cleaning up challenge: %v", err)
 		ch.Status.Reason = err.Error()
@@ -292,6 +309,27 @@ func (c *controller) handleFinalizer(ctx context.Context, ch *cmacme.Challenge)
 	return nil
 }
 
+// changePending returns the amount of time after which a DNS01 record change
+// should be submitted again if err reports that it has been queued by the
+// solver to be applied together with other changes.
+func changePending(err error) (time.Duration, bool) {
+	var pending *dns.ChangePendingError
+	if errors.As(err, &pending) {
+		return pending.RetryAfter, true
+	}
+	return 0, false
+}
+
+// requeueAfter adds the challenge back to the queue after the given duration.
+func (c *controller) requeueAfter(ch *cmacme.Challenge, after time.Duration) error {
+	key, err := controllerpkg.KeyFunc(ch)
+	if err != nil {
+		return err
+	}
+	c.queue.AddAfter(key, after)
+	return nil
+}
+
 // syncChallengeStatus will communicate with the ACME server to retrieve the current
 // state of the Challenge. It will then update the Challenge's status block with the new
 // state of the Challenge.
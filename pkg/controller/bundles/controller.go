@@ -0,0 +1,244 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bundles
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	trustapi "github.com/jetstack/cert-manager/pkg/apis/trust/v1alpha1"
+	cmclient "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
+	cmlisters "github.com/jetstack/cert-manager/pkg/client/listers/certmanager/v1"
+	trustlisters "github.com/jetstack/cert-manager/pkg/client/listers/trust/v1alpha1"
+	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+)
+
+const (
+	ControllerName = "bundles"
+)
+
+// Controller is a Bundle controller which builds a bundle of trusted CA
+// certificates from each Bundle's sources, and writes it to a ConfigMap in
+// each of the namespaces that the Bundle targets. As it watches all
+// ConfigMaps in the cluster, it is not enabled by default.
+type Controller struct {
+	// logger to be used by this controller
+	log logr.Logger
+
+	bundleLister        trustlisters.BundleLister
+	issuerLister        cmlisters.IssuerLister
+	clusterIssuerLister cmlisters.ClusterIssuerLister
+	namespaceLister     corelisters.NamespaceLister
+	configMapLister     corelisters.ConfigMapLister
+	secretLister        corelisters.SecretLister
+
+	cmClient   cmclient.Interface
+	kubeClient kubernetes.Interface
+
+	// clusterResourceNamespace is the namespace that the Secrets of
+	// ClusterIssuers are stored in
+	clusterResourceNamespace string
+
+	recorder record.EventRecorder
+
+	queue workqueue.RateLimitingInterface
+}
+
+func init() {
+	// create bundles controller
+	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.Context) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, ControllerName).
+			For(new(Controller)).Complete()
+	})
+}
+
+// Register registers and constructs the controller using the provided context.
+// It returns the workqueue to be used to enqueue items, a list of
+// InformerSynced functions that must be synced, or an error.
+func (c *Controller) Register(ctx *controllerpkg.Context) (workqueue.RateLimitingInterface, []cache.InformerSynced, error) {
+	c.log = logf.FromContext(ctx.RootContext, ControllerName)
+	c.queue = workqueue.NewNamedRateLimitingQueue(controllerpkg.DefaultItemBasedRateLimiter(), ControllerName)
+
+	bundleInformer := ctx.SharedInformerFactory.Trust().V1alpha1().Bundles()
+	issuerInformer := ctx.SharedInformerFactory.Certmanager().V1().Issuers()
+	clusterIssuerInformer := ctx.SharedInformerFactory.Certmanager().V1().ClusterIssuers()
+	namespaceInformer := ctx.KubeSharedInformerFactory.Core().V1().Namespaces()
+	configMapInformer := ctx.KubeSharedInformerFactory.Core().V1().ConfigMaps()
+	secretInformer := ctx.KubeSharedInformerFactory.Core().V1().Secrets()
+	mustSync := []cache.InformerSynced{
+		bundleInformer.Informer().HasSynced,
+		issuerInformer.Informer().HasSynced,
+		clusterIssuerInformer.Informer().HasSynced,
+		namespaceInformer.Informer().HasSynced,
+		configMapInformer.Informer().HasSynced,
+		secretInformer.Informer().HasSynced,
+	}
+
+	bundleInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: c.queue})
+	// re-sync Bundles when the namespaces they target may have changed
+	namespaceInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{WorkFunc: c.enqueueAllBundles})
+	// re-sync Bundles when one of their sources changes, or when a ConfigMap
+	// written for a Bundle is modified
+	configMapInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{WorkFunc: c.enqueueBundlesForConfigMap})
+	secretInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{WorkFunc: c.enqueueBundlesForSecret})
+	issuerInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{WorkFunc: c.enqueueBundlesForIssuer})
+	clusterIssuerInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{WorkFunc: c.enqueueBundlesForIssuer})
+
+	c.bundleLister = bundleInformer.Lister()
+	c.issuerLister = issuerInformer.Lister()
+	c.clusterIssuerLister = clusterIssuerInformer.Lister()
+	c.namespaceLister = namespaceInformer.Lister()
+	c.configMapLister = configMapInformer.Lister()
+	c.secretLister = secretInformer.Lister()
+	c.cmClient = ctx.CMClient
+	c.kubeClient = ctx.Client
+	c.clusterResourceNamespace = ctx.IssuerOptions.ClusterResourceNamespace
+	c.recorder = ctx.Recorder
+
+	c.log.V(logf.DebugLevel).Info("bundles controller registered")
+
+	return c.queue, mustSync, nil
+}
+
+// enqueueBundles enqueues all Bundles for which the given function returns
+// true.
+func (c *Controller) enqueueBundles(matches func(*trustapi.Bundle) bool) {
+	bundles, err := c.bundleLister.List(labels.Everything())
+	if err != nil {
+		c.log.Error(err, "failed listing Bundle resources")
+		return
+	}
+	for _, bundle := range bundles {
+		if matches(bundle) {
+			c.queue.Add(bundle.Name)
+		}
+	}
+}
+
+func (c *Controller) enqueueAllBundles(_ interface{}) {
+	c.enqueueBundles(func(*trustapi.Bundle) bool { return true })
+}
+
+// enqueueBundlesForConfigMap enqueues the Bundle that a ConfigMap was written
+// for, and all Bundles that use the ConfigMap as a source.
+func (c *Controller) enqueueBundlesForConfigMap(obj interface{}) {
+	cm, ok := obj.(metav1.Object)
+	if !ok {
+		c.log.V(logf.ErrorLevel).Info("Non-Object type resource passed to enqueueBundlesForConfigMap")
+		return
+	}
+	if name, ok := cm.GetLabels()[trustapi.BundleNameLabelKey]; ok {
+		c.queue.Add(name)
+	}
+	c.enqueueBundles(func(bundle *trustapi.Bundle) bool {
+		for _, source := range bundle.Spec.Sources {
+			if source.ConfigMap != nil && source.ConfigMap.Namespace == cm.GetNamespace() && source.ConfigMap.Name == cm.GetName() {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// enqueueBundlesForSecret enqueues all Bundles that use a Secret as a
+// source, either directly or as the Secret of a CA issuer, and all Bundles
+// that read a truststore password from the Secret. The Secrets of CA issuers
+// are matched by namespace only, to avoid looking up each issuer.
+func (c *Controller) enqueueBundlesForSecret(obj interface{}) {
+	secret, ok := obj.(metav1.Object)
+	if !ok {
+		c.log.V(logf.ErrorLevel).Info("Non-Object type resource passed to enqueueBundlesForSecret")
+		return
+	}
+	c.enqueueBundles(func(bundle *trustapi.Bundle) bool {
+		if secret.GetNamespace() == c.clusterResourceNamespace {
+			for _, ks := range []*trustapi.BundleTargetKeystore{bundle.Spec.Target.JKS, bundle.Spec.Target.PKCS12} {
+				if ks != nil && ks.PasswordSecretRef != nil && ks.PasswordSecretRef.Name == secret.GetName() {
+					return true
+				}
+			}
+		}
+		for _, source := range bundle.Spec.Sources {
+			if source.Secret != nil && source.Secret.Namespace == secret.GetNamespace() && source.Secret.Name == secret.GetName() {
+				return true
+			}
+			if source.Issuer != nil && c.issuerResourceNamespace(source.Issuer) == secret.GetNamespace() {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// enqueueBundlesForIssuer enqueues all Bundles that use an Issuer or
+// ClusterIssuer as a source.
+func (c *Controller) enqueueBundlesForIssuer(obj interface{}) {
+	var kind, namespace, name string
+	switch issuer := obj.(type) {
+	case *cmapi.Issuer:
+		kind, namespace, name = cmapi.IssuerKind, issuer.Namespace, issuer.Name
+	case *cmapi.ClusterIssuer:
+		kind, name = cmapi.ClusterIssuerKind, issuer.Name
+	default:
+		c.log.V(logf.ErrorLevel).Info("Non-issuer type resource passed to enqueueBundlesForIssuer")
+		return
+	}
+	c.enqueueBundles(func(bundle *trustapi.Bundle) bool {
+		for _, source := range bundle.Spec.Sources {
+			if source.Issuer != nil && issuerKind(source.Issuer) == kind && source.Issuer.Namespace == namespace && source.Issuer.Name == name {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+func (c *Controller) ProcessItem(ctx context.Context, key string) error {
+	log := logf.FromContext(ctx)
+	dbg := log.V(logf.DebugLevel)
+
+	_, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		log.Error(err, "invalid resource key")
+		return nil
+	}
+
+	bundle, err := c.bundleLister.Get(name)
+	if apierrors.IsNotFound(err) {
+		dbg.Info(fmt.Sprintf("bundle in work queue no longer exists: %s", err))
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	ctx = logf.NewContext(ctx, logf.WithResource(log, bundle))
+	return c.Sync(ctx, bundle.DeepCopy())
+}
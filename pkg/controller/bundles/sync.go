@@ -0,0 +1,447 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bundles
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	trustapi "github.com/jetstack/cert-manager/pkg/apis/trust/v1alpha1"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+	"github.com/jetstack/cert-manager/pkg/util/truststore"
+)
+
+const (
+	reasonSynced         = "Synced"
+	reasonSourceError    = "SourceError"
+	reasonEncodingError  = "EncodingError"
+	reasonInvalidTarget  = "InvalidTarget"
+	reasonTargetConflict = "TargetConflict"
+)
+
+// bundleData is the contents of the ConfigMaps written for a Bundle.
+type bundleData struct {
+	data       map[string]string
+	binaryData map[string][]byte
+	// hash identifies the certificates and truststore configuration that the
+	// data was built from, as truststores are not encoded deterministically
+	hash string
+}
+
+// Sync builds the bundle of certificates of a Bundle, writes it to a ConfigMap
+// in each of the namespaces that the Bundle targets, and deletes the
+// ConfigMaps written for the Bundle in namespaces that it no longer targets.
+// ConfigMaps are not written or deleted if any source of the Bundle cannot be
+// read, so that workloads never lose trust in a CA because of a missing
+// source.
+func (c *Controller) Sync(ctx context.Context, bundle *trustapi.Bundle) error {
+	log := logf.FromContext(ctx)
+
+	certs, err := c.buildBundle(bundle)
+	if err != nil {
+		log.V(logf.WarnLevel).Info("failed to build bundle", "error", err.Error())
+		c.recorder.Event(bundle, corev1.EventTypeWarning, reasonSourceError, err.Error())
+		return c.setReadyCondition(ctx, bundle, cmmeta.ConditionFalse, reasonSourceError, err.Error())
+	}
+
+	passwords, err := c.readTruststorePasswords(bundle.Spec.Target)
+	if err != nil {
+		log.V(logf.WarnLevel).Info("failed to read truststore password", "error", err.Error())
+		c.recorder.Event(bundle, corev1.EventTypeWarning, reasonInvalidTarget, err.Error())
+		return c.setReadyCondition(ctx, bundle, cmmeta.ConditionFalse, reasonInvalidTarget, err.Error())
+	}
+
+	data, err := encodeBundle(bundle.Spec.Target, passwords, certs)
+	if err != nil {
+		c.recorder.Event(bundle, corev1.EventTypeWarning, reasonEncodingError, err.Error())
+		return c.setReadyCondition(ctx, bundle, cmmeta.ConditionFalse, reasonEncodingError, err.Error())
+	}
+
+	selector := labels.Everything()
+	if bundle.Spec.Target.NamespaceSelector != nil {
+		selector, err = metav1.LabelSelectorAsSelector(bundle.Spec.Target.NamespaceSelector)
+		if err != nil {
+			return c.setReadyCondition(ctx, bundle, cmmeta.ConditionFalse, reasonInvalidTarget, fmt.Sprintf("invalid namespace selector: %v", err))
+		}
+	}
+	namespaces, err := c.namespaceLister.List(selector)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	var conflicts []string
+	targeted := make(map[string]bool, len(namespaces))
+	for _, ns := range namespaces {
+		if ns.DeletionTimestamp != nil {
+			continue
+		}
+		targeted[ns.Name] = true
+		written, err := c.syncTarget(ctx, bundle, ns.Name, data)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if !written {
+			conflicts = append(conflicts, ns.Name)
+		}
+	}
+
+	existing, err := c.configMapLister.List(labels.SelectorFromSet(labels.Set{trustapi.BundleNameLabelKey: bundle.Name}))
+	if err != nil {
+		return err
+	}
+	for _, cm := range existing {
+		if targeted[cm.Namespace] && cm.Name == bundle.Name {
+			continue
+		}
+		logf.WithRelatedResource(log, cm).V(logf.DebugLevel).Info("Deleting ConfigMap as its namespace is no longer targeted by the Bundle")
+		err := c.kubeClient.CoreV1().ConfigMaps(cm.Namespace).Delete(ctx, cm.Name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) > 0 {
+		return utilerrors.NewAggregate(errs)
+	}
+
+	if len(conflicts) > 0 {
+		sort.Strings(conflicts)
+		message := fmt.Sprintf("Not writing bundle to namespaces %v as a ConfigMap named %q that was not written for this Bundle already exists", conflicts, bundle.Name)
+		c.recorder.Event(bundle, corev1.EventTypeWarning, reasonTargetConflict, message)
+		return c.setReadyCondition(ctx, bundle, cmmeta.ConditionFalse, reasonTargetConflict, message)
+	}
+
+	message := fmt.Sprintf("Bundle of %d certificates has been written to %d namespaces", len(certs), len(targeted))
+	return c.setReadyCondition(ctx, bundle, cmmeta.ConditionTrue, reasonSynced, message)
+}
+
+// buildBundle reads the certificates of all of the Bundle's sources, in the
+// order that the sources are listed, and de-duplicates them.
+func (c *Controller) buildBundle(bundle *trustapi.Bundle) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	seen := make(map[string]bool)
+	for i, source := range bundle.Spec.Sources {
+		sourceCerts, err := c.readSource(source)
+		if err != nil {
+			return nil, fmt.Errorf("spec.sources[%d]: %v", i, err)
+		}
+		if len(sourceCerts) == 0 {
+			return nil, fmt.Errorf("spec.sources[%d]: no certificates found", i)
+		}
+		for _, cert := range sourceCerts {
+			fingerprint := pki.CertificateFingerprint(cert)
+			if seen[fingerprint] {
+				continue
+			}
+			seen[fingerprint] = true
+			certs = append(certs, cert)
+		}
+	}
+	return certs, nil
+}
+
+func (c *Controller) readSource(source trustapi.BundleSource) ([]*x509.Certificate, error) {
+	switch {
+	case source.Secret != nil:
+		secret, err := c.secretLister.Secrets(source.Secret.Namespace).Get(source.Secret.Name)
+		if err != nil {
+			return nil, err
+		}
+		return pki.DecodeX509CertificateChainBytes(secret.Data[sourceKey(source.Secret)])
+
+	case source.ConfigMap != nil:
+		cm, err := c.configMapLister.ConfigMaps(source.ConfigMap.Namespace).Get(source.ConfigMap.Name)
+		if err != nil {
+			return nil, err
+		}
+		return pki.DecodeX509CertificateChainBytes([]byte(cm.Data[sourceKey(source.ConfigMap)]))
+
+	case len(source.InlinePEM) > 0:
+		return pki.DecodeX509CertificateChainBytes([]byte(source.InlinePEM))
+
+	case source.Issuer != nil:
+		return c.readIssuerCA(source.Issuer)
+	}
+
+	return nil, fmt.Errorf("no source specified")
+}
+
+// readIssuerCA returns the root certificate of the chain stored in the
+// Secret of a CA issuer, or the issuer's own certificate if the chain does
+// not contain its root.
+func (c *Controller) readIssuerCA(ref *trustapi.BundleSourceIssuerRef) ([]*x509.Certificate, error) {
+	var issuer cmapi.GenericIssuer
+	var err error
+	switch issuerKind(ref) {
+	case cmapi.IssuerKind:
+		issuer, err = c.issuerLister.Issuers(ref.Namespace).Get(ref.Name)
+	case cmapi.ClusterIssuerKind:
+		issuer, err = c.clusterIssuerLister.Get(ref.Name)
+	default:
+		return nil, fmt.Errorf("unsupported issuer kind %q", ref.Kind)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if issuer.GetSpec().CA == nil {
+		return nil, fmt.Errorf("%s %q is not a CA issuer", issuerKind(ref), ref.Name)
+	}
+
+	secret, err := c.secretLister.Secrets(c.issuerResourceNamespace(ref)).Get(issuer.GetSpec().CA.SecretName)
+	if err != nil {
+		return nil, err
+	}
+	chain, err := pki.DecodeX509CertificateChainBytes(secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		return nil, err
+	}
+	if ca, err := pki.DecodeX509CertificateChainBytes(secret.Data[cmmeta.TLSCAKey]); err == nil {
+		chain = append(chain, ca...)
+	}
+	if len(chain) == 0 {
+		return nil, nil
+	}
+	return chain[len(chain)-1:], nil
+}
+
+// issuerResourceNamespace returns the namespace that the Secret of the given
+// issuer is stored in.
+func (c *Controller) issuerResourceNamespace(ref *trustapi.BundleSourceIssuerRef) string {
+	if issuerKind(ref) == cmapi.ClusterIssuerKind {
+		return c.clusterResourceNamespace
+	}
+	return ref.Namespace
+}
+
+func issuerKind(ref *trustapi.BundleSourceIssuerRef) string {
+	if len(ref.Kind) == 0 {
+		return cmapi.IssuerKind
+	}
+	return ref.Kind
+}
+
+func sourceKey(sel *trustapi.BundleSourceObjectKeySelector) string {
+	if len(sel.Key) == 0 {
+		return trustapi.BundleDefaultKey
+	}
+	return sel.Key
+}
+
+// truststorePassword is the password used to encode a truststore.
+type truststorePassword struct {
+	password string
+	// version identifies the password in the hash of the bundle data
+	// without revealing it, as the hash is published on each ConfigMap
+	// written for the Bundle. It is the UID and resource version of the
+	// Secret that the password was read from, or empty for the default
+	// password.
+	version string
+}
+
+// truststorePasswords are the passwords used to encode the truststores
+// requested by a Bundle's target.
+type truststorePasswords struct {
+	jks    truststorePassword
+	pkcs12 truststorePassword
+}
+
+// readTruststorePasswords reads the passwords of the truststores requested by
+// the Bundle's target from their Secrets in the cluster resource namespace.
+func (c *Controller) readTruststorePasswords(target trustapi.BundleTarget) (truststorePasswords, error) {
+	var passwords truststorePasswords
+	var err error
+	if target.JKS != nil {
+		passwords.jks, err = c.readTruststorePassword(target.JKS.PasswordSecretRef)
+		if err != nil {
+			return passwords, fmt.Errorf("spec.target.jks.passwordSecretRef: %v", err)
+		}
+	}
+	if target.PKCS12 != nil {
+		passwords.pkcs12, err = c.readTruststorePassword(target.PKCS12.PasswordSecretRef)
+		if err != nil {
+			return passwords, fmt.Errorf("spec.target.pkcs12.passwordSecretRef: %v", err)
+		}
+	}
+	return passwords, nil
+}
+
+func (c *Controller) readTruststorePassword(ref *cmmeta.SecretKeySelector) (truststorePassword, error) {
+	if ref == nil {
+		return truststorePassword{password: trustapi.BundleDefaultTruststorePassword}, nil
+	}
+	secret, err := c.secretLister.Secrets(c.clusterResourceNamespace).Get(ref.Name)
+	if err != nil {
+		return truststorePassword{}, err
+	}
+	password, ok := secret.Data[ref.Key]
+	if !ok {
+		return truststorePassword{}, fmt.Errorf("no data for %q in secret '%s/%s'", ref.Key, c.clusterResourceNamespace, ref.Name)
+	}
+	return truststorePassword{
+		password: string(password),
+		version:  fmt.Sprintf("%s:%s:%s", secret.UID, secret.ResourceVersion, ref.Key),
+	}, nil
+}
+
+// encodeBundle encodes the certificates of a bundle as PEM, and as any
+// truststores requested by the Bundle's target.
+func encodeBundle(target trustapi.BundleTarget, passwords truststorePasswords, certs []*x509.Certificate) (*bundleData, error) {
+	var pem bytes.Buffer
+	for _, cert := range certs {
+		certPEM, err := pki.EncodeX509(cert)
+		if err != nil {
+			return nil, err
+		}
+		pem.Write(certPEM)
+	}
+
+	key := target.Key
+	if len(key) == 0 {
+		key = trustapi.BundleDefaultKey
+	}
+	data := &bundleData{
+		data: map[string]string{key: pem.String()},
+	}
+
+	hash := sha256.New()
+	hash.Write(pem.Bytes())
+
+	if target.JKS != nil {
+		key := truststoreKey(target.JKS, trustapi.BundleDefaultJKSKey)
+		jks, err := truststore.EncodeJKS([]byte(passwords.jks.password), certs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode JKS truststore: %v", err)
+		}
+		if data.binaryData == nil {
+			data.binaryData = make(map[string][]byte)
+		}
+		data.binaryData[key] = jks
+		fmt.Fprintf(hash, "\njks:%s:%s", key, passwords.jks.version)
+	}
+
+	if target.PKCS12 != nil {
+		key := truststoreKey(target.PKCS12, trustapi.BundleDefaultPKCS12Key)
+		p12, err := truststore.EncodePKCS12(passwords.pkcs12.password, certs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode PKCS#12 truststore: %v", err)
+		}
+		if data.binaryData == nil {
+			data.binaryData = make(map[string][]byte)
+		}
+		data.binaryData[key] = p12
+		fmt.Fprintf(hash, "\npkcs12:%s:%s", key, passwords.pkcs12.version)
+	}
+
+	data.hash = hex.EncodeToString(hash.Sum(nil))
+	return data, nil
+}
+
+func truststoreKey(ks *trustapi.BundleTargetKeystore, defaultKey string) string {
+	if len(ks.Key) == 0 {
+		return defaultKey
+	}
+	return ks.Key
+}
+
+// syncTarget creates or updates the ConfigMap written for the Bundle in the
+// given namespace. Existing ConfigMaps that were not written for this Bundle
+// are never overwritten, in which case false is returned.
+func (c *Controller) syncTarget(ctx context.Context, bundle *trustapi.Bundle, namespace string, data *bundleData) (bool, error) {
+	existing, err := c.configMapLister.ConfigMaps(namespace).Get(bundle.Name)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return false, err
+	}
+
+	if existing == nil {
+		cm := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:       namespace,
+				Name:            bundle.Name,
+				Labels:          map[string]string{trustapi.BundleNameLabelKey: bundle.Name},
+				Annotations:     map[string]string{trustapi.BundleHashAnnotationKey: data.hash},
+				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(bundle, trustapi.SchemeGroupVersion.WithKind(trustapi.BundleKind))},
+			},
+			Data:       data.data,
+			BinaryData: data.binaryData,
+		}
+		_, err := c.kubeClient.CoreV1().ConfigMaps(namespace).Create(ctx, cm, metav1.CreateOptions{})
+		return true, err
+	}
+
+	if existing.Labels[trustapi.BundleNameLabelKey] != bundle.Name {
+		return false, nil
+	}
+
+	if existing.Annotations[trustapi.BundleHashAnnotationKey] == data.hash &&
+		apiequality.Semantic.DeepEqual(existing.Data, data.data) &&
+		sameKeys(existing.BinaryData, data.binaryData) {
+		return true, nil
+	}
+
+	cm := existing.DeepCopy()
+	if cm.Annotations == nil {
+		cm.Annotations = make(map[string]string)
+	}
+	cm.Annotations[trustapi.BundleHashAnnotationKey] = data.hash
+	cm.Data = data.data
+	cm.BinaryData = data.binaryData
+	_, err = c.kubeClient.CoreV1().ConfigMaps(namespace).Update(ctx, cm, metav1.UpdateOptions{})
+	return true, err
+}
+
+func sameKeys(a, b map[string][]byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k := range a {
+		if _, ok := b[k]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// setReadyCondition sets the Ready condition of the Bundle, and updates its
+// status if the condition has changed.
+func (c *Controller) setReadyCondition(ctx context.Context, bundle *trustapi.Bundle, status cmmeta.ConditionStatus, reason, message string) error {
+	old := bundle.DeepCopy()
+	apiutil.SetBundleCondition(bundle, bundle.Generation, trustapi.BundleConditionReady, status, reason, message)
+	if apiequality.Semantic.DeepEqual(old.Status, bundle.Status) {
+		return nil
+	}
+	_, err := c.cmClient.TrustV1alpha1().Bundles().UpdateStatus(ctx, bundle, metav1.UpdateOptions{})
+	return err
+}
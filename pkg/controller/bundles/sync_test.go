@@ -0,0 +1,377 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bundles
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	trustapi "github.com/jetstack/cert-manager/pkg/apis/trust/v1alpha1"
+	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
+	testpkg "github.com/jetstack/cert-manager/pkg/controller/test"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+func mustSelfSignCA(t *testing.T, commonName string) *x509.Certificate {
+	pk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, pk.Public(), pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert
+}
+
+func mustEncodePEM(t *testing.T, certs ...*x509.Certificate) string {
+	var out []byte
+	for _, cert := range certs {
+		certPEM, err := pki.EncodeX509(cert)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out = append(out, certPEM...)
+	}
+	return string(out)
+}
+
+func mustEncodeBundle(t *testing.T, certs ...*x509.Certificate) *bundleData {
+	data, err := encodeBundle(trustapi.BundleTarget{}, truststorePasswords{}, certs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestSync(t *testing.T) {
+	// now time is the current time at the start of the test (the clock is fixed)
+	now := time.Now()
+	metaNow := metav1.NewTime(now)
+
+	ca1 := mustSelfSignCA(t, "ca-1")
+	ca2 := mustSelfSignCA(t, "ca-2")
+	bothPEM := mustEncodePEM(t, ca1, ca2)
+	bothData := mustEncodeBundle(t, ca1, ca2)
+
+	namespace := func(name string, labels map[string]string) *corev1.Namespace {
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+	}
+	secret := func(namespace, name string, data map[string]string) *corev1.Secret {
+		s := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}, Data: map[string][]byte{}}
+		for k, v := range data {
+			s.Data[k] = []byte(v)
+		}
+		return s
+	}
+	bundle := func(sources ...trustapi.BundleSource) *trustapi.Bundle {
+		return &trustapi.Bundle{
+			ObjectMeta: metav1.ObjectMeta{Name: "trust", Generation: 2},
+			Spec: trustapi.BundleSpec{
+				Sources: sources,
+				Target: trustapi.BundleTarget{
+					NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"trust": "true"}},
+				},
+			},
+		}
+	}
+	baseBundle := bundle(
+		trustapi.BundleSource{Secret: &trustapi.BundleSourceObjectKeySelector{Namespace: "cert-manager", Name: "ca"}},
+		trustapi.BundleSource{InlinePEM: bothPEM},
+	)
+	passwordBundle := baseBundle.DeepCopy()
+	passwordBundle.Spec.Target.PKCS12 = &trustapi.BundleTargetKeystore{
+		PasswordSecretRef: &cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "truststore"}, Key: "password"},
+	}
+	configMap := func(bundle *trustapi.Bundle, namespace string, labelled bool, data *bundleData) *corev1.ConfigMap {
+		cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: bundle.Name}}
+		if labelled {
+			cm.Labels = map[string]string{trustapi.BundleNameLabelKey: bundle.Name}
+			cm.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(bundle, trustapi.SchemeGroupVersion.WithKind(trustapi.BundleKind))}
+		}
+		if data != nil {
+			cm.Annotations = map[string]string{trustapi.BundleHashAnnotationKey: data.hash}
+			cm.Data = data.data
+			cm.BinaryData = data.binaryData
+		}
+		return cm
+	}
+	createConfigMap := func(cm *corev1.ConfigMap) testpkg.Action {
+		return testpkg.NewAction(coretesting.NewCreateAction(corev1.SchemeGroupVersion.WithResource("configmaps"), cm.Namespace, cm))
+	}
+
+	baseNamespaces := []runtime.Object{
+		namespace("cert-manager", nil),
+		namespace("team-a", map[string]string{"trust": "true"}),
+		namespace("team-b", nil),
+	}
+	caSecret := secret("cert-manager", "ca", map[string]string{trustapi.BundleDefaultKey: mustEncodePEM(t, ca1)})
+
+	tests := map[string]struct {
+		bundle      *trustapi.Bundle
+		kubeObjects []runtime.Object
+		cmObjects   []runtime.Object
+
+		expectedActions   []testpkg.Action
+		expectedCondition *trustapi.BundleCondition
+		expectedEvents    []string
+	}{
+		"write de-duplicated bundle to the selected namespaces": {
+			bundle:          baseBundle,
+			kubeObjects:     append([]runtime.Object{caSecret}, baseNamespaces...),
+			expectedActions: []testpkg.Action{createConfigMap(configMap(baseBundle, "team-a", true, bothData))},
+			expectedCondition: &trustapi.BundleCondition{
+				Status:  cmmeta.ConditionTrue,
+				Reason:  reasonSynced,
+				Message: "Bundle of 2 certificates has been written to 1 namespaces",
+			},
+		},
+		"write the root certificate of a CA ClusterIssuer": {
+			bundle: bundle(trustapi.BundleSource{Issuer: &trustapi.BundleSourceIssuerRef{Name: "ca", Kind: cmapi.ClusterIssuerKind}}),
+			kubeObjects: append([]runtime.Object{
+				secret("cert-manager", "ca-key", map[string]string{
+					corev1.TLSCertKey: mustEncodePEM(t, ca1),
+					cmmeta.TLSCAKey:   mustEncodePEM(t, ca2),
+				}),
+			}, baseNamespaces...),
+			cmObjects: []runtime.Object{&cmapi.ClusterIssuer{
+				ObjectMeta: metav1.ObjectMeta{Name: "ca"},
+				Spec:       cmapi.IssuerSpec{IssuerConfig: cmapi.IssuerConfig{CA: &cmapi.CAIssuer{SecretName: "ca-key"}}},
+			}},
+			expectedActions: []testpkg.Action{createConfigMap(configMap(baseBundle, "team-a", true, &bundleData{
+				data: map[string]string{trustapi.BundleDefaultKey: mustEncodePEM(t, ca2)},
+				hash: mustEncodeBundle(t, ca2).hash,
+			}))},
+			expectedCondition: &trustapi.BundleCondition{
+				Status:  cmmeta.ConditionTrue,
+				Reason:  reasonSynced,
+				Message: "Bundle of 1 certificates has been written to 1 namespaces",
+			},
+		},
+		"do not update a ConfigMap that is up to date": {
+			bundle:      baseBundle,
+			kubeObjects: append([]runtime.Object{caSecret, configMap(baseBundle, "team-a", true, bothData)}, baseNamespaces...),
+			expectedCondition: &trustapi.BundleCondition{
+				Status:  cmmeta.ConditionTrue,
+				Reason:  reasonSynced,
+				Message: "Bundle of 2 certificates has been written to 1 namespaces",
+			},
+		},
+		"update a ConfigMap that is out of date": {
+			bundle:      baseBundle,
+			kubeObjects: append([]runtime.Object{caSecret, configMap(baseBundle, "team-a", true, mustEncodeBundle(t, ca1))}, baseNamespaces...),
+			expectedActions: []testpkg.Action{testpkg.NewAction(coretesting.NewUpdateAction(
+				corev1.SchemeGroupVersion.WithResource("configmaps"), "team-a", configMap(baseBundle, "team-a", true, bothData),
+			))},
+			expectedCondition: &trustapi.BundleCondition{
+				Status:  cmmeta.ConditionTrue,
+				Reason:  reasonSynced,
+				Message: "Bundle of 2 certificates has been written to 1 namespaces",
+			},
+		},
+		"delete ConfigMaps in namespaces that are no longer targeted": {
+			bundle: baseBundle,
+			kubeObjects: append([]runtime.Object{
+				caSecret,
+				configMap(baseBundle, "team-a", true, bothData),
+				configMap(baseBundle, "team-b", true, bothData),
+			}, baseNamespaces...),
+			expectedActions: []testpkg.Action{testpkg.NewAction(coretesting.NewDeleteAction(
+				corev1.SchemeGroupVersion.WithResource("configmaps"), "team-b", baseBundle.Name,
+			))},
+			expectedCondition: &trustapi.BundleCondition{
+				Status:  cmmeta.ConditionTrue,
+				Reason:  reasonSynced,
+				Message: "Bundle of 2 certificates has been written to 1 namespaces",
+			},
+		},
+		"do not overwrite a ConfigMap that was not written for the Bundle": {
+			bundle:      baseBundle,
+			kubeObjects: append([]runtime.Object{caSecret, configMap(baseBundle, "team-a", false, nil)}, baseNamespaces...),
+			expectedCondition: &trustapi.BundleCondition{
+				Status:  cmmeta.ConditionFalse,
+				Reason:  reasonTargetConflict,
+				Message: `Not writing bundle to namespaces [team-a] as a ConfigMap named "trust" that was not written for this Bundle already exists`,
+			},
+			expectedEvents: []string{`Warning TargetConflict Not writing bundle to namespaces [team-a] as a ConfigMap named "trust" that was not written for this Bundle already exists`},
+		},
+		"fail if a truststore password Secret is missing": {
+			bundle:      passwordBundle,
+			kubeObjects: append([]runtime.Object{caSecret}, baseNamespaces...),
+			expectedCondition: &trustapi.BundleCondition{
+				Status:  cmmeta.ConditionFalse,
+				Reason:  reasonInvalidTarget,
+				Message: `spec.target.pkcs12.passwordSecretRef: secret "truststore" not found`,
+			},
+			expectedEvents: []string{`Warning InvalidTarget spec.target.pkcs12.passwordSecretRef: secret "truststore" not found`},
+		},
+		"fail if a truststore password Secret has no data for the key": {
+			bundle:      passwordBundle,
+			kubeObjects: append([]runtime.Object{caSecret, secret("cert-manager", "truststore", nil)}, baseNamespaces...),
+			expectedCondition: &trustapi.BundleCondition{
+				Status:  cmmeta.ConditionFalse,
+				Reason:  reasonInvalidTarget,
+				Message: `spec.target.pkcs12.passwordSecretRef: no data for "password" in secret 'cert-manager/truststore'`,
+			},
+			expectedEvents: []string{`Warning InvalidTarget spec.target.pkcs12.passwordSecretRef: no data for "password" in secret 'cert-manager/truststore'`},
+		},
+		"do not write or delete ConfigMaps if a source is missing": {
+			bundle:      baseBundle,
+			kubeObjects: append([]runtime.Object{configMap(baseBundle, "team-b", true, bothData)}, baseNamespaces...),
+			expectedCondition: &trustapi.BundleCondition{
+				Status:  cmmeta.ConditionFalse,
+				Reason:  reasonSourceError,
+				Message: `spec.sources[0]: secret "ca" not found`,
+			},
+			expectedEvents: []string{`Warning SourceError spec.sources[0]: secret "ca" not found`},
+		},
+		"fail if a source key does not contain certificates": {
+			bundle:      bundle(trustapi.BundleSource{Secret: &trustapi.BundleSourceObjectKeySelector{Namespace: "cert-manager", Name: "ca", Key: "other.crt"}}),
+			kubeObjects: append([]runtime.Object{caSecret}, baseNamespaces...),
+			expectedCondition: &trustapi.BundleCondition{
+				Status:  cmmeta.ConditionFalse,
+				Reason:  reasonSourceError,
+				Message: "spec.sources[0]: error decoding certificate PEM block",
+			},
+			expectedEvents: []string{"Warning SourceError spec.sources[0]: error decoding certificate PEM block"},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{
+				T:                  t,
+				Clock:              fakeclock.NewFakeClock(now),
+				KubeObjects:        test.kubeObjects,
+				CertManagerObjects: append([]runtime.Object{test.bundle}, test.cmObjects...),
+				ExpectedActions:    test.expectedActions,
+				ExpectedEvents:     test.expectedEvents,
+				Context: &controllerpkg.Context{
+					RootContext:   context.Background(),
+					IssuerOptions: controllerpkg.IssuerOptions{ClusterResourceNamespace: "cert-manager"},
+				},
+			}
+			if test.expectedCondition != nil {
+				condition := *test.expectedCondition
+				condition.Type = trustapi.BundleConditionReady
+				condition.LastTransitionTime = &metaNow
+				condition.ObservedGeneration = test.bundle.Generation
+				expectedBundle := test.bundle.DeepCopy()
+				expectedBundle.Status.Conditions = []trustapi.BundleCondition{condition}
+				builder.ExpectedActions = append(builder.ExpectedActions,
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						trustapi.SchemeGroupVersion.WithResource("bundles"),
+						"status",
+						"",
+						expectedBundle,
+					)),
+				)
+			}
+			builder.Init()
+
+			c := new(Controller)
+			if _, _, err := c.Register(builder.Context); err != nil {
+				t.Fatal(err)
+			}
+			builder.Start()
+			defer builder.Stop()
+
+			err := c.Sync(context.Background(), test.bundle.DeepCopy())
+			builder.CheckAndFinish(err)
+		})
+	}
+}
+
+func TestEncodeBundleTruststores(t *testing.T) {
+	ca := mustSelfSignCA(t, "ca")
+	target := trustapi.BundleTarget{
+		Key:    "bundle.pem",
+		JKS:    &trustapi.BundleTargetKeystore{},
+		PKCS12: &trustapi.BundleTargetKeystore{Key: "trust.p12"},
+	}
+	passwords := truststorePasswords{
+		jks:    truststorePassword{password: trustapi.BundleDefaultTruststorePassword},
+		pkcs12: truststorePassword{password: "password", version: "uid:1:password"},
+	}
+	data, err := encodeBundle(target, passwords, []*x509.Certificate{ca})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data.data["bundle.pem"] != mustEncodePEM(t, ca) {
+		t.Errorf("expected PEM bundle to be written to key %q, got data %v", "bundle.pem", data.data)
+	}
+	for _, key := range []string{trustapi.BundleDefaultJKSKey, "trust.p12"} {
+		if len(data.binaryData[key]) == 0 {
+			t.Errorf("expected truststore to be written to key %q", key)
+		}
+	}
+
+	// the hash must be stable across encodings, as truststores are not
+	// encoded deterministically, and must change with the truststore options
+	again, err := encodeBundle(target, passwords, []*x509.Certificate{ca})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data.hash != again.hash {
+		t.Errorf("expected hash to be stable, got %q and %q", data.hash, again.hash)
+	}
+	passwords.pkcs12 = truststorePassword{password: "changed", version: "uid:2:password"}
+	changed, err := encodeBundle(target, passwords, []*x509.Certificate{ca})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data.hash == changed.hash {
+		t.Errorf("expected hash to change with the truststore password Secret")
+	}
+
+	// the hash is published on each ConfigMap, so must not be derived from
+	// the password itself
+	passwords.pkcs12.password = "other"
+	other, err := encodeBundle(target, passwords, []*x509.Certificate{ca})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if changed.hash != other.hash {
+		t.Errorf("expected hash to not depend on the truststore password")
+	}
+}
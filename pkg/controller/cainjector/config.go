@@ -0,0 +1,207 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cainjector
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+)
+
+// InjectorsConfig is the format of the file used to configure injectors for
+// resource types that the cainjector has no built-in injector for, such as
+// custom resources.
+type InjectorsConfig struct {
+	Injectors []InjectorConfig `json:"injectors"`
+}
+
+// InjectorConfig configures an injector for a single resource type.
+type InjectorConfig struct {
+	// Group, Version and Kind of the resource type to inject CA bundles into.
+	Group   string `json:"group"`
+	Version string `json:"version"`
+	Kind    string `json:"kind"`
+
+	// FieldPaths are the paths of the fields that CA bundles are injected
+	// into, as dot separated field names. A field name suffixed with '[]' is
+	// a list, and the rest of the path is followed in each of its items,
+	// e.g. 'spec.backends[].tls.caBundle'.
+	// Fields are only injected into if all of the objects on their path
+	// already exist.
+	FieldPaths []string `json:"fieldPaths"`
+
+	// Format of the injected CA bundle, either 'Base64' for fields that hold
+	// bytes, such as the 'caBundle' fields of Kubernetes resources, or 'PEM'
+	// for fields that hold a PEM encoded string.
+	// Defaults to 'Base64'.
+	Format CAFormat `json:"format,omitempty"`
+}
+
+// CAFormat is the format that a CA bundle is injected into a field in.
+type CAFormat string
+
+const (
+	CAFormatBase64 CAFormat = "Base64"
+	CAFormatPEM    CAFormat = "PEM"
+)
+
+// builtinInjectorKinds are the resource types that have a built-in injector,
+// and so cannot be configured.
+var builtinInjectorKinds = map[schema.GroupKind]bool{
+	{Group: "admissionregistration.k8s.io", Kind: "MutatingWebhookConfiguration"}:   true,
+	{Group: "admissionregistration.k8s.io", Kind: "ValidatingWebhookConfiguration"}: true,
+	{Group: "apiregistration.k8s.io", Kind: "APIService"}:                           true,
+	{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition"}:               true,
+}
+
+// LoadInjectorsConfig reads and validates the injectors configured in the
+// given file.
+func LoadInjectorsConfig(path string) ([]InjectorConfig, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading injectors config file: %v", err)
+	}
+
+	var cfg InjectorsConfig
+	if err := yaml.UnmarshalStrict(data, &cfg); err != nil {
+		return nil, fmt.Errorf("error decoding injectors config file %q: %v", path, err)
+	}
+
+	seen := make(map[schema.GroupKind]bool)
+	for i, injector := range cfg.Injectors {
+		if _, err := injector.setup(); err != nil {
+			return nil, fmt.Errorf("injectors[%d]: %v", i, err)
+		}
+		gk := injector.groupVersionKind().GroupKind()
+		if seen[gk] {
+			return nil, fmt.Errorf("injectors[%d]: duplicate injector for %s", i, gk)
+		}
+		seen[gk] = true
+	}
+
+	return cfg.Injectors, nil
+}
+
+func (c InjectorConfig) groupVersionKind() schema.GroupVersionKind {
+	return schema.GroupVersionKind{Group: c.Group, Version: c.Version, Kind: c.Kind}
+}
+
+// setup validates the injector's configuration, and returns the setup of
+// the injector controller for it.
+func (c InjectorConfig) setup() (injectorSetup, error) {
+	gvk := c.groupVersionKind()
+	if len(gvk.Version) == 0 || len(gvk.Kind) == 0 {
+		return injectorSetup{}, fmt.Errorf("version and kind must be set")
+	}
+	if builtinInjectorKinds[gvk.GroupKind()] {
+		return injectorSetup{}, fmt.Errorf("%s has a built-in injector and cannot be configured", gvk.GroupKind())
+	}
+
+	format := c.Format
+	switch format {
+	case "":
+		format = CAFormatBase64
+	case CAFormatBase64, CAFormatPEM:
+	default:
+		return injectorSetup{}, fmt.Errorf("unsupported format %q, must be one of %q or %q", format, CAFormatBase64, CAFormatPEM)
+	}
+
+	if len(c.FieldPaths) == 0 {
+		return injectorSetup{}, fmt.Errorf("at least one field path must be set")
+	}
+	paths := make([]fieldPath, len(c.FieldPaths))
+	for i, raw := range c.FieldPaths {
+		path, err := parseFieldPath(raw)
+		if err != nil {
+			return injectorSetup{}, fmt.Errorf("fieldPaths[%d]: %v", i, err)
+		}
+		paths[i] = path
+	}
+
+	listType := &unstructured.UnstructuredList{}
+	listType.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+
+	return injectorSetup{
+		resourceName: strings.ToLower(gvk.GroupKind().String()),
+		injector: fieldPathInjector{
+			gvk:    gvk,
+			paths:  paths,
+			format: format,
+		},
+		listType: listType,
+	}, nil
+}
+
+// fieldPathSegment is a field name of a field path.
+type fieldPathSegment struct {
+	name string
+	// list is true if the field is a list, whose items the rest of the path
+	// is followed in.
+	list bool
+}
+
+// fieldPath is a parsed path of a field that a CA bundle is injected into.
+type fieldPath []fieldPathSegment
+
+func parseFieldPath(raw string) (fieldPath, error) {
+	if len(raw) == 0 {
+		return nil, fmt.Errorf("field path must not be empty")
+	}
+	var path fieldPath
+	for _, name := range strings.Split(raw, ".") {
+		segment := fieldPathSegment{name: name}
+		if strings.HasSuffix(name, "[]") {
+			segment = fieldPathSegment{name: strings.TrimSuffix(name, "[]"), list: true}
+		}
+		if len(segment.name) == 0 || strings.ContainsAny(segment.name, "[]") {
+			return nil, fmt.Errorf("invalid field name %q in field path %q", name, raw)
+		}
+		path = append(path, segment)
+	}
+	if path[len(path)-1].list {
+		return nil, fmt.Errorf("field path %q must not end in a list", raw)
+	}
+	return path, nil
+}
+
+// set sets the field at the path in obj to value. Lists and objects along the
+// path that do not exist are not created.
+func (p fieldPath) set(obj map[string]interface{}, value interface{}) {
+	segment := p[0]
+	if len(p) == 1 {
+		obj[segment.name] = value
+		return
+	}
+
+	if !segment.list {
+		if child, ok := obj[segment.name].(map[string]interface{}); ok {
+			p[1:].set(child, value)
+		}
+		return
+	}
+
+	items, _ := obj[segment.name].([]interface{})
+	for _, item := range items {
+		if child, ok := item.(map[string]interface{}); ok {
+			p[1:].set(child, value)
+		}
+	}
+}
@@ -0,0 +1,253 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cainjector
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestLoadInjectorsConfig(t *testing.T) {
+	tests := map[string]struct {
+		config    string
+		expectErr bool
+	}{
+		"valid config": {
+			config: `
+injectors:
+- group: gateway.networking.k8s.io
+  version: v1alpha2
+  kind: BackendTLSPolicy
+  fieldPaths: ["spec.tls.caBundle"]
+- group: example.com
+  version: v1
+  kind: Backend
+  fieldPaths: ["spec.backends[].caPEM"]
+  format: PEM
+`,
+		},
+		"unknown field": {
+			config: `
+injectors:
+- group: example.com
+  version: v1
+  kind: Backend
+  fieldPath: spec.caBundle
+`,
+			expectErr: true,
+		},
+		"missing kind": {
+			config: `
+injectors:
+- group: example.com
+  version: v1
+  fieldPaths: ["spec.caBundle"]
+`,
+			expectErr: true,
+		},
+		"unsupported format": {
+			config: `
+injectors:
+- group: example.com
+  version: v1
+  kind: Backend
+  fieldPaths: ["spec.caBundle"]
+  format: DER
+`,
+			expectErr: true,
+		},
+		"no field paths": {
+			config: `
+injectors:
+- group: example.com
+  version: v1
+  kind: Backend
+`,
+			expectErr: true,
+		},
+		"resource type with a built-in injector": {
+			config: `
+injectors:
+- group: admissionregistration.k8s.io
+  version: v1
+  kind: ValidatingWebhookConfiguration
+  fieldPaths: ["webhooks[].clientConfig.caBundle"]
+`,
+			expectErr: true,
+		},
+		"duplicate resource type": {
+			config: `
+injectors:
+- group: example.com
+  version: v1
+  kind: Backend
+  fieldPaths: ["spec.caBundle"]
+- group: example.com
+  version: v2
+  kind: Backend
+  fieldPaths: ["spec.tls.caBundle"]
+`,
+			expectErr: true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "cainjector-config")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+			path := filepath.Join(dir, "injectors.yaml")
+			if err := ioutil.WriteFile(path, []byte(test.config), 0600); err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = LoadInjectorsConfig(path)
+			if test.expectErr != (err != nil) {
+				t.Errorf("expected error: %t, got: %v", test.expectErr, err)
+			}
+		})
+	}
+}
+
+func TestParseFieldPath(t *testing.T) {
+	tests := map[string]struct {
+		path      string
+		expected  fieldPath
+		expectErr bool
+	}{
+		"single field": {
+			path:     "caBundle",
+			expected: fieldPath{{name: "caBundle"}},
+		},
+		"nested fields and lists": {
+			path:     "spec.backends[].tls.caBundle",
+			expected: fieldPath{{name: "spec"}, {name: "backends", list: true}, {name: "tls"}, {name: "caBundle"}},
+		},
+		"empty path": {
+			path:      "",
+			expectErr: true,
+		},
+		"empty field name": {
+			path:      "spec..caBundle",
+			expectErr: true,
+		},
+		"indexed list": {
+			path:      "spec.backends[0].caBundle",
+			expectErr: true,
+		},
+		"ends in a list": {
+			path:      "spec.caBundles[]",
+			expectErr: true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			path, err := parseFieldPath(test.path)
+			if test.expectErr != (err != nil) {
+				t.Fatalf("expected error: %t, got: %v", test.expectErr, err)
+			}
+			if !reflect.DeepEqual(test.expected, path) {
+				t.Errorf("expected %v, got %v", test.expected, path)
+			}
+		})
+	}
+}
+
+func TestFieldPathTargetSetCA(t *testing.T) {
+	ca := []byte("-----BEGIN CERTIFICATE-----\n")
+	caBase64 := "LS0tLS1CRUdJTiBDRVJUSUZJQ0FURS0tLS0tCg=="
+
+	tests := map[string]struct {
+		injector InjectorConfig
+		obj      map[string]interface{}
+		expected map[string]interface{}
+	}{
+		"inject base64 encoded CA into a nested field": {
+			injector: InjectorConfig{FieldPaths: []string{"spec.tls.caBundle"}},
+			obj: map[string]interface{}{
+				"spec": map[string]interface{}{"tls": map[string]interface{}{"caBundle": "old"}},
+			},
+			expected: map[string]interface{}{
+				"spec": map[string]interface{}{"tls": map[string]interface{}{"caBundle": caBase64}},
+			},
+		},
+		"inject PEM encoded CA into every item of a list": {
+			injector: InjectorConfig{FieldPaths: []string{"spec.backends[].caPEM"}, Format: CAFormatPEM},
+			obj: map[string]interface{}{
+				"spec": map[string]interface{}{"backends": []interface{}{
+					map[string]interface{}{"name": "a"},
+					map[string]interface{}{"name": "b"},
+				}},
+			},
+			expected: map[string]interface{}{
+				"spec": map[string]interface{}{"backends": []interface{}{
+					map[string]interface{}{"name": "a", "caPEM": string(ca)},
+					map[string]interface{}{"name": "b", "caPEM": string(ca)},
+				}},
+			},
+		},
+		"inject into multiple field paths": {
+			injector: InjectorConfig{FieldPaths: []string{"spec.caBundle", "spec.backends[].caBundle"}},
+			obj: map[string]interface{}{
+				"spec": map[string]interface{}{"backends": []interface{}{map[string]interface{}{}}},
+			},
+			expected: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"caBundle": caBase64,
+					"backends": []interface{}{map[string]interface{}{"caBundle": caBase64}},
+				},
+			},
+		},
+		"do not create missing objects on the path": {
+			injector: InjectorConfig{FieldPaths: []string{"spec.tls.caBundle", "spec.backends[].caBundle"}},
+			obj: map[string]interface{}{
+				"spec": map[string]interface{}{},
+			},
+			expected: map[string]interface{}{
+				"spec": map[string]interface{}{},
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cfg := test.injector
+			cfg.Group, cfg.Version, cfg.Kind = "example.com", "v1", "Backend"
+			setup, err := cfg.setup()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			target := setup.injector.NewTarget().(*fieldPathTarget)
+			for k, v := range test.obj {
+				target.obj.Object[k] = v
+			}
+			target.SetCA(ca)
+
+			expected := map[string]interface{}{"apiVersion": "example.com/v1", "kind": "Backend"}
+			for k, v := range test.expected {
+				expected[k] = v
+			}
+			if !reflect.DeepEqual(expected, target.obj.Object) {
+				t.Errorf("expected %v, got %v", expected, target.obj.Object)
+			}
+		})
+	}
+}
@@ -18,7 +18,6 @@ package cainjector
 
 import (
 	"context"
-	"fmt"
 	"strings"
 
 	"github.com/go-logr/logr"
@@ -102,6 +101,11 @@ type genericInjectReconciler struct {
 	// cainjector to run even when Certificate resources cannot we watched due to
 	// the conversion webhook not being available.
 	sources []caDataSource
+	// certificateBased is true if this reconciler injects into the objects
+	// that reference a Certificate, and false if it injects into those that
+	// do not. Objects that reference a Certificate are only injected into by
+	// the certificate based reconcilers, which can read from all data sources.
+	certificateBased bool
 
 	log logr.Logger
 	client.Client
@@ -119,6 +123,21 @@ func splitNamespacedName(nameStr string) types.NamespacedName {
 	return types.NamespacedName{Namespace: nameStr[:splitPoint], Name: nameStr[splitPoint+1:]}
 }
 
+// splitNamespacedNames turns a comma separated list of namespaced names into a
+// list of types.NamespacedName. Empty entries, such as those left by a
+// trailing comma, are skipped.
+func splitNamespacedNames(namesStr string) []types.NamespacedName {
+	var names []types.NamespacedName
+	for _, nameStr := range strings.Split(namesStr, ",") {
+		nameStr = strings.TrimSpace(nameStr)
+		if len(nameStr) == 0 {
+			continue
+		}
+		names = append(names, splitNamespacedName(nameStr))
+	}
+	return names
+}
+
 // Reconcile attempts to ensure that a particular object has all the CAs injected that
 // it has requested.
 func (r *genericInjectReconciler) Reconcile(_ context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -151,19 +170,34 @@ func (r *genericInjectReconciler) Reconcile(_ context.Context, req ctrl.Request)
 	}
 
 	// ensure that it wants injection
-	dataSource, err := r.caDataSourceFor(log, metaObj)
-	if err != nil {
+	if _, ok := metaObj.GetAnnotations()[certmanager.WantInjectAnnotation]; ok != r.certificateBased {
+		log.V(logf.DebugLevel).Info("ignoring", "reason", "object is injected into by the other reconciler")
+		return ctrl.Result{}, nil
+	}
+	dataSources := r.caDataSourcesFor(log, metaObj)
+	if len(dataSources) == 0 {
 		log.V(logf.DebugLevel).Info("failed to determine ca data source for injectable")
 		return ctrl.Result{}, nil
 	}
 
-	caData, err := dataSource.ReadCA(ctx, log, metaObj)
-	if err != nil {
-		log.Error(err, "failed to read CA from data source")
-		return ctrl.Result{}, err
+	// read and merge the CAs of all of the data sources, injecting nothing
+	// until all of them can be read so that no CA is ever dropped
+	caBundles := make([][]byte, 0, len(dataSources))
+	for _, dataSource := range dataSources {
+		caData, err := dataSource.ReadCA(ctx, log, metaObj)
+		if err != nil {
+			log.Error(err, "failed to read CA from data source")
+			return ctrl.Result{}, err
+		}
+		if caData == nil {
+			log.V(logf.InfoLevel).Info("could not find any ca data in data source for target")
+			return ctrl.Result{}, nil
+		}
+		caBundles = append(caBundles, caData)
 	}
+	caData := mergeCABundles(caBundles)
 	if caData == nil {
-		log.V(logf.InfoLevel).Info("could not find any ca data in data source for target")
+		log.V(logf.InfoLevel).Info("could not find any PEM encoded ca data in data sources for target")
 		return ctrl.Result{}, nil
 	}
 
@@ -180,11 +214,14 @@ func (r *genericInjectReconciler) Reconcile(_ context.Context, req ctrl.Request)
 	return ctrl.Result{}, nil
 }
 
-func (r *genericInjectReconciler) caDataSourceFor(log logr.Logger, metaObj metav1.Object) (caDataSource, error) {
+// caDataSourcesFor returns all of the data sources that are configured for
+// the given object.
+func (r *genericInjectReconciler) caDataSourcesFor(log logr.Logger, metaObj metav1.Object) []caDataSource {
+	var sources []caDataSource
 	for _, s := range r.sources {
 		if s.Configured(log, metaObj) {
-			return s, nil
+			sources = append(sources, s)
 		}
 	}
-	return nil, fmt.Errorf("could not determine ca data source for resource")
+	return sources
 }
@@ -122,17 +122,24 @@ func injectableCAFromIndexer(rawObj client.Object) []string {
 		return nil
 	}
 
-	// skip invalid certificate names
-	certNameRaw := metaInfo.GetAnnotations()[cmapi.WantInjectAnnotation]
-	if certNameRaw == "" {
-		return nil
-	}
-	certName := splitNamespacedName(certNameRaw)
-	if certName.Namespace == "" {
+	certNamesRaw, ok := metaInfo.GetAnnotations()[cmapi.WantInjectAnnotation]
+	if !ok {
 		return nil
 	}
+	return namespacedNameIndexValues(certNamesRaw)
+}
 
-	return []string{certNameRaw}
+// namespacedNameIndexValues returns the index values of a comma separated list
+// of namespaced names, skipping invalid names.
+func namespacedNameIndexValues(namesRaw string) []string {
+	var values []string
+	for _, name := range splitNamespacedNames(namesRaw) {
+		if name.Namespace == "" {
+			continue
+		}
+		values = append(values, name.String())
+	}
+	return values
 }
 
 // secretToInjectableFunc converts a given certificate to the reconcile requests for the corresponding injectables
@@ -199,15 +206,9 @@ func injectableCAFromSecretIndexer(rawObj client.Object) []string {
 		return nil
 	}
 
-	// skip invalid secret names
-	secretNameRaw := metaInfo.GetAnnotations()[cmapi.WantInjectFromSecretAnnotation]
-	if secretNameRaw == "" {
-		return nil
-	}
-	secretName := splitNamespacedName(secretNameRaw)
-	if secretName.Namespace == "" {
+	secretNamesRaw, ok := metaInfo.GetAnnotations()[cmapi.WantInjectFromSecretAnnotation]
+	if !ok {
 		return nil
 	}
-
-	return []string{secretNameRaw}
+	return namespacedNameIndexValues(secretNamesRaw)
 }
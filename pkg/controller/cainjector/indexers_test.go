@@ -0,0 +1,81 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cainjector
+
+import (
+	"reflect"
+	"testing"
+
+	admissionreg "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+)
+
+func TestInjectableIndexers(t *testing.T) {
+	tests := map[string]struct {
+		annotations     map[string]string
+		expectedCerts   []string
+		expectedSecrets []string
+	}{
+		"no annotations": {},
+		"single references": {
+			annotations: map[string]string{
+				cmapi.WantInjectAnnotation:           "ns/cert",
+				cmapi.WantInjectFromSecretAnnotation: "ns/secret",
+			},
+			expectedCerts:   []string{"ns/cert"},
+			expectedSecrets: []string{"ns/secret"},
+		},
+		"lists of references": {
+			annotations: map[string]string{
+				cmapi.WantInjectAnnotation:           "ns/old-ca, ns/new-ca",
+				cmapi.WantInjectFromSecretAnnotation: "ns/old-secret,other/new-secret",
+			},
+			expectedCerts:   []string{"ns/old-ca", "ns/new-ca"},
+			expectedSecrets: []string{"ns/old-secret", "other/new-secret"},
+		},
+		"skip empty references": {
+			annotations: map[string]string{
+				cmapi.WantInjectAnnotation:           "ns/old-ca,,ns/new-ca,",
+				cmapi.WantInjectFromSecretAnnotation: " , ns/secret",
+			},
+			expectedCerts:   []string{"ns/old-ca", "ns/new-ca"},
+			expectedSecrets: []string{"ns/secret"},
+		},
+		"skip references without a namespace": {
+			annotations: map[string]string{
+				cmapi.WantInjectAnnotation:           "cert,ns/new-ca",
+				cmapi.WantInjectFromSecretAnnotation: "",
+			},
+			expectedCerts: []string{"ns/new-ca"},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			obj := &admissionreg.ValidatingWebhookConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "webhook", Annotations: test.annotations},
+			}
+			if certs := injectableCAFromIndexer(obj); !reflect.DeepEqual(test.expectedCerts, certs) {
+				t.Errorf("expected certificate index values %v, got %v", test.expectedCerts, certs)
+			}
+			if secrets := injectableCAFromSecretIndexer(obj); !reflect.DeepEqual(test.expectedSecrets, secrets) {
+				t.Errorf("expected secret index values %v, got %v", test.expectedSecrets, secrets)
+			}
+		})
+	}
+}
@@ -17,8 +17,12 @@ limitations under the License.
 package cainjector
 
 import (
+	"encoding/base64"
+
 	admissionreg "k8s.io/api/admissionregistration/v1"
 	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	apireg "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -27,7 +31,8 @@ import (
 // for various Kubernetes types that contain CA bundles.
 // This allows us to build a generic "injection" controller, and parameterize
 // it with these.
-// Ideally, we'd have some generic way to express this as well.
+// Resource types without a built-in injector can be configured using an
+// InjectorConfig, which is implemented by fieldPathInjector.
 
 // mutatingWebhookInjector knows how to create an InjectTarget a MutatingWebhookConfiguration.
 type mutatingWebhookInjector struct{}
@@ -140,3 +145,45 @@ func (t *crdConversionTarget) SetCA(data []byte) {
 	}
 	t.obj.Spec.Conversion.Webhook.ClientConfig.CABundle = data
 }
+
+// fieldPathInjector knows how to create an InjectTarget for a resource type
+// configured using an InjectorConfig.
+type fieldPathInjector struct {
+	gvk    schema.GroupVersionKind
+	paths  []fieldPath
+	format CAFormat
+}
+
+func (i fieldPathInjector) NewTarget() InjectTarget {
+	t := &fieldPathTarget{paths: i.paths, format: i.format}
+	t.obj.SetGroupVersionKind(i.gvk)
+	return t
+}
+
+// IsAlpha returns false, so that cainjector fails to start if the configured
+// resource type is not served, rather than silently never injecting into it.
+func (i fieldPathInjector) IsAlpha() bool {
+	return false
+}
+
+// fieldPathTarget knows how to set CA data for all of the configured fields
+// of an arbitrary resource.
+type fieldPathTarget struct {
+	obj    unstructured.Unstructured
+	paths  []fieldPath
+	format CAFormat
+}
+
+func (t *fieldPathTarget) AsObject() client.Object {
+	return &t.obj
+}
+
+func (t *fieldPathTarget) SetCA(data []byte) {
+	var value interface{} = base64.StdEncoding.EncodeToString(data)
+	if t.format == CAFormatPEM {
+		value = string(data)
+	}
+	for _, path := range t.paths {
+		path.set(t.obj.Object, value)
+	}
+}
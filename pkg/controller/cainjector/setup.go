@@ -75,19 +75,20 @@ var (
 
 // registerAllInjectors registers all injectors and based on the
 // graduation state of the injector decides how to log no kind/resource match errors
-func registerAllInjectors(ctx context.Context, groupName string, mgr ctrl.Manager, sources []caDataSource, client client.Client, ca cache.Cache) error {
-	controllers := make([]controller.Controller, len(injectorSetups))
-	for i, setup := range injectorSetups {
-		controller, err := newGenericInjectionController(ctx, groupName, mgr, setup, sources, ca, client)
+func registerAllInjectors(ctx context.Context, groupName string, mgr ctrl.Manager, setups []injectorSetup, sources []caDataSource, certificateBased bool, client client.Client, ca cache.Cache) error {
+	controllers := make([]controller.Controller, 0, len(setups))
+	for _, setup := range setups {
+		controller, err := newGenericInjectionController(ctx, groupName, mgr, setup, sources, certificateBased, ca, client)
 		if err != nil {
 			if !meta.IsNoMatchError(err) || !setup.injector.IsAlpha() {
 				return err
 			}
-			ctrl.Log.V(logf.WarnLevel).Info("unable to register injector which is still in an alpha phase."+
-				" Enable the feature on the API server in order to use this injector",
+			ctrl.Log.V(logf.WarnLevel).Info("unable to register injector as its resource type is not served by the API server."+
+				" Enable the feature or install the resource type on the API server in order to use this injector",
 				"injector", setup.resourceName)
+			continue
 		}
-		controllers[i] = controller
+		controllers = append(controllers, controller)
 	}
 	g, gctx := errgroup.WithContext(ctx)
 
@@ -123,8 +124,8 @@ func registerAllInjectors(ctx context.Context, groupName string, mgr ctrl.Manage
 // improvements which might make this easier:
 // * https://github.com/kubernetes-sigs/controller-runtime/issues/764
 func newGenericInjectionController(ctx context.Context, groupName string, mgr ctrl.Manager,
-	setup injectorSetup, sources []caDataSource, ca cache.Cache,
-	client client.Client) (controller.Controller, error) {
+	setup injectorSetup, sources []caDataSource, certificateBased bool,
+	ca cache.Cache, client client.Client) (controller.Controller, error) {
 	log := ctrl.Log.WithName(groupName).WithName(setup.resourceName)
 	typ := setup.injector.NewTarget().AsObject()
 
@@ -133,11 +134,12 @@ func newGenericInjectionController(ctx context.Context, groupName string, mgr ct
 		mgr,
 		controller.Options{
 			Reconciler: &genericInjectReconciler{
-				Client:       client,
-				sources:      sources,
-				log:          log.WithName("generic-inject-reconciler"),
-				resourceName: setup.resourceName,
-				injector:     setup.injector,
+				Client:           client,
+				sources:          sources,
+				certificateBased: certificateBased,
+				log:              log.WithName("generic-inject-reconciler"),
+				resourceName:     setup.resourceName,
+				injector:         setup.injector,
 			},
 			Log: log,
 		})
@@ -173,12 +175,33 @@ func dataFromSliceOrFile(data []byte, file string) ([]byte, error) {
 	return nil, nil
 }
 
-// RegisterCertificateBased registers all known injection controllers that
-// target Certificate resources with the  given manager, and adds relevant
-// indices.
+// injectorSetupsWith returns the setups of all built-in injectors, and of the
+// given configured injectors.
+func injectorSetupsWith(injectors []InjectorConfig) ([]injectorSetup, error) {
+	setups := append([]injectorSetup{}, injectorSetups...)
+	for _, injector := range injectors {
+		setup, err := injector.setup()
+		if err != nil {
+			return nil, err
+		}
+		setups = append(setups, setup)
+	}
+	return setups, nil
+}
+
+// RegisterCertificateBased registers all known injection controllers, and
+// those of the given configured injectors, that target Certificate resources
+// with the  given manager, and adds relevant indices.
+// These controllers inject into all objects that reference a Certificate, and
+// as those may reference Secrets too, read from all data sources and merge
+// their CAs.
 // The registered controllers require the cert-manager API to be available
 // in order to run.
-func RegisterCertificateBased(ctx context.Context, mgr ctrl.Manager) error {
+func RegisterCertificateBased(ctx context.Context, mgr ctrl.Manager, injectors []InjectorConfig) error {
+	setups, err := injectorSetupsWith(injectors)
+	if err != nil {
+		return err
+	}
 	cache, client, err := newIndependentCacheAndDelegatingClient(mgr)
 	if err != nil {
 		return err
@@ -187,20 +210,30 @@ func RegisterCertificateBased(ctx context.Context, mgr ctrl.Manager) error {
 		ctx,
 		"certificate",
 		mgr,
+		setups,
 		[]caDataSource{
 			&certificateDataSource{client: cache},
+			&secretDataSource{client: cache},
+			&kubeconfigDataSource{},
 		},
+		true,
 		client,
 		cache,
 	)
 }
 
-// RegisterSecretBased registers all known injection controllers that
-// target Secret resources with the  given manager, and adds relevant
-// indices.
+// RegisterSecretBased registers all known injection controllers, and those
+// of the given configured injectors, that target Secret resources with the
+// given manager, and adds relevant indices.
+// Objects that reference a Certificate are left to the certificate based
+// controllers, which merge the CAs of all data sources.
 // The registered controllers only require the corev1 APi to be available in
 // order to run.
-func RegisterSecretBased(ctx context.Context, mgr ctrl.Manager) error {
+func RegisterSecretBased(ctx context.Context, mgr ctrl.Manager, injectors []InjectorConfig) error {
+	setups, err := injectorSetupsWith(injectors)
+	if err != nil {
+		return err
+	}
 	cache, client, err := newIndependentCacheAndDelegatingClient(mgr)
 	if err != nil {
 		return err
@@ -209,10 +242,12 @@ func RegisterSecretBased(ctx context.Context, mgr ctrl.Manager) error {
 		ctx,
 		"secret",
 		mgr,
+		setups,
 		[]caDataSource{
 			&secretDataSource{client: cache},
 			&kubeconfigDataSource{},
 		},
+		false,
 		client,
 		cache,
 	)
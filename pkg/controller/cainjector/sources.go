@@ -17,7 +17,9 @@ limitations under the License.
 package cainjector
 
 import (
+	"bytes"
 	"context"
+	"encoding/pem"
 
 	logf "github.com/jetstack/cert-manager/pkg/logs"
 
@@ -83,9 +85,9 @@ func (c *kubeconfigDataSource) ApplyTo(ctx context.Context, mgr ctrl.Manager, se
 	return nil
 }
 
-// certificateDataSource reads a CA bundle by fetching the Certificate named in
-// the 'cert-manager.io/inject-ca-from' annotation in the form
-// 'namespace/name'.
+// certificateDataSource reads a CA bundle by fetching the Certificates named in
+// the 'cert-manager.io/inject-ca-from' annotation, as a comma separated list
+// in the form 'namespace/name'.
 type certificateDataSource struct {
 	client client.Reader
 }
@@ -100,9 +102,20 @@ func (c *certificateDataSource) Configured(log logr.Logger, metaObj metav1.Objec
 }
 
 func (c *certificateDataSource) ReadCA(ctx context.Context, log logr.Logger, metaObj metav1.Object) (ca []byte, err error) {
-	certNameRaw := metaObj.GetAnnotations()[cmapi.WantInjectAnnotation]
-	certName := splitNamespacedName(certNameRaw)
-	log = log.WithValues("certificate", certName)
+	certNames := splitNamespacedNames(metaObj.GetAnnotations()[cmapi.WantInjectAnnotation])
+	caBundles := make([][]byte, 0, len(certNames))
+	for _, certName := range certNames {
+		caData, err := c.readCertificateCA(ctx, log.WithValues("certificate", certName), certName)
+		if caData == nil || err != nil {
+			return nil, err
+		}
+		caBundles = append(caBundles, caData)
+	}
+	return mergeCABundles(caBundles), nil
+}
+
+// readCertificateCA reads the CA of a single Certificate.
+func (c *certificateDataSource) readCertificateCA(ctx context.Context, log logr.Logger, certName types.NamespacedName) ([]byte, error) {
 	if certName.Namespace == "" {
 		log.Error(nil, "invalid certificate name; needs a namespace/ prefix")
 		// don't return an error, requeuing won't help till this is changed
@@ -169,9 +182,9 @@ func (c *certificateDataSource) ApplyTo(ctx context.Context, mgr ctrl.Manager, s
 	return nil
 }
 
-// secretDataSource reads a CA bundle from a Secret resource named using the
-// 'cert-manager.io/inject-ca-from-secret' annotation in the form
-// 'namespace/name'.
+// secretDataSource reads a CA bundle from the Secret resources named using the
+// 'cert-manager.io/inject-ca-from-secret' annotation, as a comma separated
+// list in the form 'namespace/name'.
 type secretDataSource struct {
 	client client.Reader
 }
@@ -186,9 +199,20 @@ func (c *secretDataSource) Configured(log logr.Logger, metaObj metav1.Object) bo
 }
 
 func (c *secretDataSource) ReadCA(ctx context.Context, log logr.Logger, metaObj metav1.Object) ([]byte, error) {
-	secretNameRaw := metaObj.GetAnnotations()[cmapi.WantInjectFromSecretAnnotation]
-	secretName := splitNamespacedName(secretNameRaw)
-	log = log.WithValues("secret", secretName)
+	secretNames := splitNamespacedNames(metaObj.GetAnnotations()[cmapi.WantInjectFromSecretAnnotation])
+	caBundles := make([][]byte, 0, len(secretNames))
+	for _, secretName := range secretNames {
+		caData, err := c.readSecretCA(ctx, log.WithValues("secret", secretName), secretName)
+		if caData == nil || err != nil {
+			return nil, err
+		}
+		caBundles = append(caBundles, caData)
+	}
+	return mergeCABundles(caBundles), nil
+}
+
+// readSecretCA reads the CA of a single Secret.
+func (c *secretDataSource) readSecretCA(ctx context.Context, log logr.Logger, secretName types.NamespacedName) ([]byte, error) {
 	if secretName.Namespace == "" {
 		log.Error(nil, "invalid certificate name")
 		// don't return an error, requeuing won't help till this is changed
@@ -235,3 +259,37 @@ func (c *secretDataSource) ApplyTo(ctx context.Context, mgr ctrl.Manager, setup
 	}
 	return nil
 }
+
+// mergeCABundles concatenates the given PEM encoded CA bundles, dropping any
+// certificates that appear more than once. A single bundle is returned as is.
+// nil is returned if none of the bundles contain any PEM encoded data.
+func mergeCABundles(caBundles [][]byte) []byte {
+	if len(caBundles) == 1 {
+		return caBundles[0]
+	}
+
+	var merged bytes.Buffer
+	seen := make(map[string]bool)
+	for _, caBundle := range caBundles {
+		for {
+			var block *pem.Block
+			block, caBundle = pem.Decode(caBundle)
+			if block == nil {
+				break
+			}
+			if seen[string(block.Bytes)] {
+				continue
+			}
+			seen[string(block.Bytes)] = true
+			if err := pem.Encode(&merged, block); err != nil {
+				// encoding to a bytes.Buffer only fails on invalid headers,
+				// which a decoded block cannot have
+				continue
+			}
+		}
+	}
+	if merged.Len() == 0 {
+		return nil
+	}
+	return merged.Bytes()
+}
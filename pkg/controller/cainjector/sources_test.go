@@ -0,0 +1,68 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cainjector
+
+import (
+	"bytes"
+	"encoding/pem"
+	"testing"
+)
+
+func TestMergeCABundles(t *testing.T) {
+	block := func(data string) []byte {
+		return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte(data)})
+	}
+	join := func(bundles ...[]byte) []byte {
+		return bytes.Join(bundles, nil)
+	}
+
+	tests := map[string]struct {
+		caBundles [][]byte
+		expected  []byte
+	}{
+		"return a single bundle as is": {
+			caBundles: [][]byte{[]byte("# old CA\n" + string(block("old")))},
+			expected:  []byte("# old CA\n" + string(block("old"))),
+		},
+		"concatenate bundles in order": {
+			caBundles: [][]byte{block("old"), join(block("new"), block("intermediate"))},
+			expected:  join(block("old"), block("new"), block("intermediate")),
+		},
+		"drop duplicate certificates": {
+			caBundles: [][]byte{join(block("old"), block("new")), join(block("new"), block("old"))},
+			expected:  join(block("old"), block("new")),
+		},
+		"drop data that is not PEM encoded": {
+			caBundles: [][]byte{join([]byte("# old CA\n"), block("old")), []byte("not PEM")},
+			expected:  block("old"),
+		},
+		"return nil if no bundle contains PEM data": {
+			caBundles: [][]byte{[]byte("not PEM"), []byte("# old CA\n")},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			merged := mergeCABundles(test.caBundles)
+			if test.expected == nil && merged != nil {
+				t.Errorf("expected nil, got:\n%s", merged)
+			}
+			if !bytes.Equal(test.expected, merged) {
+				t.Errorf("expected:\n%s\ngot:\n%s", test.expected, merged)
+			}
+		})
+	}
+}
@@ -29,6 +29,7 @@ import (
 	cmclient "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
 	cmlisters "github.com/jetstack/cert-manager/pkg/client/listers/certmanager/v1"
 	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
+	"github.com/jetstack/cert-manager/pkg/issuer"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
 )
 
@@ -38,7 +39,9 @@ const (
 
 // Controller is a CertificateRequest controller which manages the "Approved"
 // condition. In the absence of any automated policy engine, this controller
-// will _always_ set the "Approved" condition to True. All CertificateRequest
+// will _always_ set the "Approved" condition to True, unless the issuer
+// requires approval by a number of distinct users, in which case the condition
+// is set once that many approvals have been recorded. All CertificateRequest
 // signing controllers should wait until the "Approved" condition is set to
 // True before processing.
 type Controller struct {
@@ -48,6 +51,9 @@ type Controller struct {
 	certificateRequestLister cmlisters.CertificateRequestLister
 	cmClient                 cmclient.Interface
 
+	// helper is used to read the issuer referenced by a CertificateRequest
+	helper issuer.Helper
+
 	recorder record.EventRecorder
 
 	queue workqueue.RateLimitingInterface
@@ -69,10 +75,24 @@ func (c *Controller) Register(ctx *controllerpkg.Context) (workqueue.RateLimitin
 	c.queue = workqueue.NewNamedRateLimitingQueue(controllerpkg.DefaultItemBasedRateLimiter(), ControllerName)
 
 	certificateRequestInformer := ctx.SharedInformerFactory.Certmanager().V1().CertificateRequests()
-	mustSync := []cache.InformerSynced{certificateRequestInformer.Informer().HasSynced}
+	issuerInformer := ctx.SharedInformerFactory.Certmanager().V1().Issuers()
+	mustSync := []cache.InformerSynced{
+		certificateRequestInformer.Informer().HasSynced,
+		issuerInformer.Informer().HasSynced,
+	}
 	certificateRequestInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: c.queue})
 
+	// if we are running in non-namespaced mode (i.e. --namespace=""), we also
+	// obtain a lister for clusterissuers.
+	var clusterIssuerLister cmlisters.ClusterIssuerLister
+	if ctx.Namespace == "" {
+		clusterIssuerInformer := ctx.SharedInformerFactory.Certmanager().V1().ClusterIssuers()
+		clusterIssuerLister = clusterIssuerInformer.Lister()
+		mustSync = append(mustSync, clusterIssuerInformer.Informer().HasSynced)
+	}
+
 	c.certificateRequestLister = certificateRequestInformer.Lister()
+	c.helper = issuer.NewHelper(issuerInformer.Lister(), clusterIssuerLister)
 	c.cmClient = ctx.CMClient
 	c.recorder = ctx.Recorder
 
@@ -35,6 +35,13 @@ func TestProcessItem(t *testing.T) {
 	// now time is the current time at the start of the test (the clock is fixed)
 	now := time.Now()
 	metaNow := metav1.NewTime(now)
+	quorumIssuer := &cmapi.Issuer{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "ca-issuer"},
+		Spec: cmapi.IssuerSpec{
+			IssuerConfig: cmapi.IssuerConfig{CA: &cmapi.CAIssuer{SecretName: "ca"}},
+			Approval:     &cmapi.IssuerApproval{RequiredApprovals: 2},
+		},
+	}
 	tests := map[string]struct {
 		// key that should be passed to ProcessItem.
 		// if not set, the 'namespace/name' of the 'CertificateRequest' field will be used.
@@ -45,6 +52,9 @@ func TestProcessItem(t *testing.T) {
 		// if not set, the 'key' will be passed to ProcessItem instead.
 		request *cmapi.CertificateRequest
 
+		// issuer referenced by the CertificateRequest, if any.
+		issuer *cmapi.Issuer
+
 		// expectedEvent, if set, is an 'event string' that is expected to be fired.
 		expectedEvent string
 
@@ -165,6 +175,40 @@ func TestProcessItem(t *testing.T) {
 			},
 			expectedEvent: "Normal cert-manager.io Certificate request has been approved by cert-manager.io",
 		},
+		"do nothing if the issuer requires more approvals than have been recorded": {
+			issuer: quorumIssuer,
+			request: &cmapi.CertificateRequest{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test"},
+				Spec: cmapi.CertificateRequestSpec{
+					IssuerRef: cmmeta.ObjectReference{Name: "ca-issuer"},
+				},
+				Status: cmapi.CertificateRequestStatus{
+					Approvals: []cmapi.CertificateRequestApproval{{Username: "user-1"}},
+				},
+			},
+		},
+		"approve CertificateRequest once the approvals required by the issuer have been recorded": {
+			issuer: quorumIssuer,
+			request: &cmapi.CertificateRequest{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test"},
+				Spec: cmapi.CertificateRequestSpec{
+					IssuerRef: cmmeta.ObjectReference{Name: "ca-issuer"},
+				},
+				Status: cmapi.CertificateRequestStatus{
+					Approvals: []cmapi.CertificateRequestApproval{{Username: "user-1"}, {Username: "user-2"}},
+				},
+			},
+			expectedConditions: []cmapi.CertificateRequestCondition{
+				{
+					Type:               cmapi.CertificateRequestConditionApproved,
+					Status:             cmmeta.ConditionTrue,
+					Reason:             "cert-manager.io",
+					Message:            "Certificate request has been approved by 2 users",
+					LastTransitionTime: &metaNow,
+				},
+			},
+			expectedEvent: "Normal cert-manager.io Certificate request has been approved by 2 users",
+		},
 	}
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -176,6 +220,9 @@ func TestProcessItem(t *testing.T) {
 			if test.request != nil {
 				builder.CertManagerObjects = append(builder.CertManagerObjects, test.request)
 			}
+			if test.issuer != nil {
+				builder.CertManagerObjects = append(builder.CertManagerObjects, test.issuer)
+			}
 			builder.Init()
 
 			c := new(Controller)
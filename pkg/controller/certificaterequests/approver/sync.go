@@ -18,23 +18,29 @@ package approver
 
 import (
 	"context"
+	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager"
 	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
 )
 
 const (
-	ApprovedMessage = "Certificate request has been approved by cert-manager.io"
+	ApprovedMessage       = "Certificate request has been approved by cert-manager.io"
+	QuorumApprovedMessage = "Certificate request has been approved by %d users"
 )
 
 // Sync will set the "Approved" condition to True on synced
 // CertificateRequests. If the "Denied", "Approved" or "Ready" condition
-// already exists, exit early.
+// already exists, exit early. If the issuer requires approval by a number of
+// distinct users, the condition is only set once that many users have
+// recorded an approval.
 func (c *Controller) Sync(ctx context.Context, cr *cmapi.CertificateRequest) (err error) {
 	log := logf.FromContext(ctx, "approver")
 
@@ -52,12 +58,27 @@ func (c *Controller) Sync(ctx context.Context, cr *cmapi.CertificateRequest) (er
 		return nil
 	}
 
+	required, err := c.requiredApprovals(cr)
+	if err != nil {
+		return err
+	}
+
+	message := ApprovedMessage
+	if required > 0 {
+		approvers := countApprovers(cr)
+		if approvers < required {
+			log.V(logf.DebugLevel).Info("waiting for the approvals required by the issuer", "required", required, "approvers", approvers)
+			return nil
+		}
+		message = fmt.Sprintf(QuorumApprovedMessage, approvers)
+	}
+
 	// Update the CertificateRequest approved condition to true.
 	apiutil.SetCertificateRequestCondition(cr,
 		cmapi.CertificateRequestConditionApproved,
 		cmmeta.ConditionTrue,
 		"cert-manager.io",
-		ApprovedMessage,
+		message,
 	)
 
 	// Update CertificateRequest with
@@ -65,9 +86,46 @@ func (c *Controller) Sync(ctx context.Context, cr *cmapi.CertificateRequest) (er
 	if err != nil {
 		return err
 	}
-	c.recorder.Event(cr, corev1.EventTypeNormal, "cert-manager.io", ApprovedMessage)
+	c.recorder.Event(cr, corev1.EventTypeNormal, "cert-manager.io", message)
 
 	log.V(logf.DebugLevel).Info("approved certificate request")
 
 	return nil
 }
+
+// requiredApprovals returns the number of distinct users that must approve the
+// CertificateRequest, as configured on the referenced cert-manager Issuer or
+// ClusterIssuer. Requests for any other signer, or for an issuer that does not
+// exist, do not require any approvals.
+func (c *Controller) requiredApprovals(cr *cmapi.CertificateRequest) (int, error) {
+	ref := cr.Spec.IssuerRef
+	if len(ref.Group) > 0 && ref.Group != certmanager.GroupName {
+		return 0, nil
+	}
+	if ref.Kind != "" && ref.Kind != cmapi.IssuerKind && ref.Kind != cmapi.ClusterIssuerKind {
+		return 0, nil
+	}
+
+	iss, err := c.helper.GetGenericIssuer(ref, cr.Namespace)
+	if apierrors.IsNotFound(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	if approval := iss.GetSpec().Approval; approval != nil {
+		return approval.RequiredApprovals, nil
+	}
+	return 0, nil
+}
+
+// countApprovers returns the number of distinct users that have recorded an
+// approval of the CertificateRequest.
+func countApprovers(cr *cmapi.CertificateRequest) int {
+	usernames := make(map[string]bool)
+	for _, approval := range cr.Status.Approvals {
+		usernames[approval.Username] = true
+	}
+	return len(usernames)
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificaterequests
+
+import (
+	"fmt"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+// checkIssuerConstraints returns an error if the CertificateRequest violates
+// the constraints of the given issuer.
+func checkIssuerConstraints(cr *cmapi.CertificateRequest, iss cmapi.GenericIssuer) error {
+	constraints := iss.GetSpec().Constraints
+	if constraints == nil {
+		return nil
+	}
+
+	csr, err := pki.DecodeX509CertificateRequestBytes(cr.Spec.Request)
+	if err != nil {
+		return err
+	}
+
+	req, err := pki.ConstrainedRequestForCSR(csr, apiutil.DefaultCertDuration(cr.Spec.Duration), cr.Spec.IsCA)
+	if err != nil {
+		return err
+	}
+
+	return pki.CheckIssuerConstraints(constraints, req)
+}
+
+// issuerSelectsNamespace returns true if the CertificateRequest's namespace
+// may use the given issuer. Only ClusterIssuers restrict the namespaces that
+// may reference them.
+func (c *Controller) issuerSelectsNamespace(cr *cmapi.CertificateRequest, iss cmapi.GenericIssuer) (bool, error) {
+	if _, ok := iss.(*cmapi.ClusterIssuer); !ok || iss.GetSpec().NamespaceSelector == nil {
+		return true, nil
+	}
+
+	if c.namespaceLister == nil {
+		return false, fmt.Errorf("cannot check the namespaceSelector of ClusterIssuer %q without a namespace lister", iss.GetObjectMeta().Name)
+	}
+
+	ns, err := c.namespaceLister.Get(cr.Namespace)
+	if err != nil {
+		return false, err
+	}
+
+	return apiutil.IssuerSelectsNamespace(iss.GetSpec(), ns.Labels)
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalapprover
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	utilcache "k8s.io/apimachinery/pkg/util/cache"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	cmclient "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
+	cmlisters "github.com/jetstack/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+)
+
+const (
+	ControllerName = "certificaterequests-external-approver"
+
+	// FailurePolicyRetry leaves a CertificateRequest pending and retries the
+	// review with a backoff if the external approver cannot be called.
+	FailurePolicyRetry = "Retry"
+
+	// FailurePolicyDeny denies a CertificateRequest if the external approver
+	// cannot be called.
+	FailurePolicyDeny = "Deny"
+
+	// cacheSize is the maximum number of verdicts that are cached.
+	cacheSize = 4096
+)
+
+// Controller is a CertificateRequest controller which manages the "Approved"
+// and "Denied" conditions of CertificateRequests according to the verdict of
+// an external approver, which is called over HTTPS. It is an alternative to
+// the default approver controller, which always approves
+// CertificateRequests, and so is not enabled by default.
+type Controller struct {
+	// logger to be used by this controller
+	log logr.Logger
+
+	certificateRequestLister cmlisters.CertificateRequestLister
+	cmClient                 cmclient.Interface
+
+	recorder record.EventRecorder
+
+	queue workqueue.RateLimitingInterface
+
+	// reviewer sends CertificateRequests to the external approver
+	reviewer reviewer
+
+	failurePolicy string
+
+	// verdicts caches the responses of the external approver, keyed by the
+	// UID of the CertificateRequest, so that the external approver is not
+	// called again if updating the CertificateRequest fails
+	verdicts *utilcache.LRUExpireCache
+	cacheTTL time.Duration
+}
+
+func init() {
+	// create certificate request external approver controller
+	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.Context) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, ControllerName).
+			For(new(Controller)).Complete()
+	})
+}
+
+// Register registers and constructs the controller using the provided context.
+// It returns the workqueue to be used to enqueue items, a list of
+// InformerSynced functions that must be synced, or an error.
+func (c *Controller) Register(ctx *controllerpkg.Context) (workqueue.RateLimitingInterface, []cache.InformerSynced, error) {
+	c.log = logf.FromContext(ctx.RootContext, ControllerName)
+
+	u, err := url.Parse(ctx.ExternalApproverURL)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid external approver URL: %w", err)
+	}
+	if u.Scheme != "https" {
+		return nil, nil, errors.New("the external approver URL must be set, and use the https scheme")
+	}
+
+	c.queue = workqueue.NewNamedRateLimitingQueue(controllerpkg.DefaultItemBasedRateLimiter(), ControllerName)
+
+	certificateRequestInformer := ctx.SharedInformerFactory.Certmanager().V1().CertificateRequests()
+	mustSync := []cache.InformerSynced{certificateRequestInformer.Informer().HasSynced}
+	certificateRequestInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: c.queue})
+
+	c.certificateRequestLister = certificateRequestInformer.Lister()
+	c.cmClient = ctx.CMClient
+	c.recorder = ctx.Recorder
+	c.reviewer = &httpReviewer{
+		url: u.String(),
+		client: &http.Client{
+			Timeout:   ctx.ExternalApproverTimeout,
+			Transport: &http.Transport{TLSClientConfig: ctx.ExternalApproverTLSConfig},
+		},
+	}
+	c.failurePolicy = ctx.ExternalApproverFailurePolicy
+	c.verdicts = utilcache.NewLRUExpireCacheWithClock(cacheSize, ctx.Clock)
+	c.cacheTTL = ctx.ExternalApproverCacheTTL
+
+	c.log.V(logf.DebugLevel).Info("certificate request external approver controller registered")
+
+	return c.queue, mustSync, nil
+}
+
+func (c *Controller) ProcessItem(ctx context.Context, key string) error {
+	log := logf.FromContext(ctx)
+	dbg := log.V(logf.DebugLevel)
+
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		log.Error(err, "invalid resource key")
+		return nil
+	}
+
+	cr, err := c.certificateRequestLister.CertificateRequests(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		dbg.Info(fmt.Sprintf("certificate request in work queue no longer exists: %s", err))
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	ctx = logf.NewContext(ctx, logf.WithResource(log, cr))
+	return c.Sync(ctx, cr.DeepCopy())
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalapprover
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	approvalapi "github.com/jetstack/cert-manager/pkg/apis/approval/v1alpha1"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+// maxResponseSize is the maximum size of a response from the external
+// approver that is read.
+const maxResponseSize = 1 << 20
+
+// reviewer sends CertificateRequestReviews to an external approver, and
+// returns the reviews that it responds with.
+type reviewer interface {
+	Review(ctx context.Context, review *approvalapi.CertificateRequestReview) (*approvalapi.CertificateRequestReview, error)
+}
+
+// httpReviewer is a reviewer that POSTs reviews as JSON to an HTTPS endpoint.
+type httpReviewer struct {
+	url    string
+	client *http.Client
+}
+
+func (r *httpReviewer) Review(ctx context.Context, review *approvalapi.CertificateRequestReview) (*approvalapi.CertificateRequestReview, error) {
+	body, err := json.Marshal(review)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected response status %q: %s", resp.Status, respBody)
+	}
+
+	result := new(approvalapi.CertificateRequestReview)
+	if err := json.Unmarshal(respBody, result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return result, nil
+}
+
+// buildReview returns the review request sent to the external approver for
+// the CertificateRequest with the given decoded CSR.
+func buildReview(cr *cmapi.CertificateRequest, csr *x509.CertificateRequest) *approvalapi.CertificateRequestReview {
+	ipAddresses := make([]string, len(csr.IPAddresses))
+	for i, ip := range csr.IPAddresses {
+		ipAddresses[i] = ip.String()
+	}
+	uris := make([]string, len(csr.URIs))
+	for i, uri := range csr.URIs {
+		uris[i] = uri.String()
+	}
+	keyAlgorithm, keySize, _ := pki.PublicKeyAlgorithmAndSize(csr.PublicKey)
+
+	return &approvalapi.CertificateRequestReview{
+		TypeMeta: approvalTypeMeta,
+		Request: &approvalapi.CertificateRequestReviewRequest{
+			UID:                cr.UID,
+			CertificateRequest: *cr,
+			CSR: approvalapi.CertificateSigningRequestFields{
+				CommonName:          csr.Subject.CommonName,
+				Organizations:       csr.Subject.Organization,
+				Countries:           csr.Subject.Country,
+				OrganizationalUnits: csr.Subject.OrganizationalUnit,
+				Localities:          csr.Subject.Locality,
+				Provinces:           csr.Subject.Province,
+				StreetAddresses:     csr.Subject.StreetAddress,
+				PostalCodes:         csr.Subject.PostalCode,
+				SerialNumber:        csr.Subject.SerialNumber,
+				DNSNames:            csr.DNSNames,
+				IPAddresses:         ipAddresses,
+				URIs:                uris,
+				EmailAddresses:      csr.EmailAddresses,
+				PublicKeyAlgorithm:  keyAlgorithm,
+				PublicKeySize:       keySize,
+			},
+			Username: cr.Spec.Username,
+			Groups:   cr.Spec.Groups,
+		},
+	}
+}
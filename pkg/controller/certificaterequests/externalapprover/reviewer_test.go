@@ -0,0 +1,123 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalapprover
+
+import (
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+
+	approvalapi "github.com/jetstack/cert-manager/pkg/apis/approval/v1alpha1"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+	"github.com/jetstack/cert-manager/test/unit/gen"
+)
+
+func TestBuildReview(t *testing.T) {
+	spiffeID, _ := url.Parse("spiffe://cluster.local/ns/testns/sa/app")
+	csrPEM := mustGenerateCSR(t, &x509.CertificateRequest{
+		Subject:     pkix.Name{CommonName: "app", Organization: []string{"Example"}},
+		DNSNames:    []string{"app.testns.svc"},
+		IPAddresses: []net.IP{net.ParseIP("10.0.0.1")},
+		URIs:        []*url.URL{spiffeID},
+	})
+	csr, err := pki.DecodeX509CertificateRequestBytes(csrPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cr := gen.CertificateRequest("test",
+		gen.SetCertificateRequestCSR(csrPEM),
+		gen.SetCertificateRequestUsername("user-1"),
+		gen.SetCertificateRequestGroups([]string{"group-1"}),
+	)
+	cr.UID = "cr-uid"
+
+	review := buildReview(cr, csr)
+	if review.APIVersion != approvalapi.SchemeGroupVersion || review.Kind != approvalapi.CertificateRequestReviewKind {
+		t.Errorf("unexpected type meta %v", review.TypeMeta)
+	}
+	expectedCSR := approvalapi.CertificateSigningRequestFields{
+		CommonName:         "app",
+		Organizations:      []string{"Example"},
+		DNSNames:           []string{"app.testns.svc"},
+		IPAddresses:        []string{"10.0.0.1"},
+		URIs:               []string{"spiffe://cluster.local/ns/testns/sa/app"},
+		PublicKeyAlgorithm: cmapi.ECDSAKeyAlgorithm,
+		PublicKeySize:      256,
+	}
+	if !reflect.DeepEqual(review.Request.CSR, expectedCSR) {
+		t.Errorf("expected CSR fields %+v, got %+v", expectedCSR, review.Request.CSR)
+	}
+	if review.Request.UID != cr.UID || review.Request.Username != "user-1" || !reflect.DeepEqual(review.Request.Groups, []string{"group-1"}) {
+		t.Errorf("unexpected requester in review request %+v", review.Request)
+	}
+}
+
+func TestHTTPReviewer(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		review := new(approvalapi.CertificateRequestReview)
+		if err := json.NewDecoder(r.Body).Decode(review); err != nil || review.Request == nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if review.Request.Username == "error" {
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+		review.Response = &approvalapi.CertificateRequestReviewResponse{
+			UID:      review.Request.UID,
+			Approved: review.Request.Username == "user-1",
+		}
+		review.Request = nil
+		json.NewEncoder(w).Encode(review)
+	}))
+	defer server.Close()
+
+	r := &httpReviewer{url: server.URL, client: server.Client()}
+	review := &approvalapi.CertificateRequestReview{
+		TypeMeta: approvalTypeMeta,
+		Request:  &approvalapi.CertificateRequestReviewRequest{UID: "cr-uid", Username: "user-1"},
+	}
+
+	result, err := r.Review(context.Background(), review)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := &approvalapi.CertificateRequestReview{
+		TypeMeta: approvalTypeMeta,
+		Response: &approvalapi.CertificateRequestReviewResponse{UID: "cr-uid", Approved: true},
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %+v, got %+v", expected, result)
+	}
+
+	review.Request.Username = "error"
+	if _, err := r.Review(context.Background(), review); err == nil {
+		t.Errorf("expected an error for a non-200 response")
+	}
+}
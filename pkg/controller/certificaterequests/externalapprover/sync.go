@@ -0,0 +1,150 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalapprover
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	approvalapi "github.com/jetstack/cert-manager/pkg/apis/approval/v1alpha1"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+const (
+	// Reason is the reason set on the "Approved" and "Denied" conditions,
+	// and on the events fired, by this controller.
+	Reason = "external-approver.cert-manager.io"
+
+	// ReasonExternalApproverError is the reason of the event fired when the
+	// external approver cannot be called.
+	ReasonExternalApproverError = "ExternalApproverError"
+
+	ApprovedMessage = "Certificate request has been approved by the external approver"
+	DeniedMessage   = "Certificate request has been denied by the external approver"
+)
+
+var approvalTypeMeta = metav1.TypeMeta{
+	APIVersion: approvalapi.SchemeGroupVersion,
+	Kind:       approvalapi.CertificateRequestReviewKind,
+}
+
+// Sync will set the "Approved" or "Denied" condition to True on synced
+// CertificateRequests according to the verdict of the external approver. If
+// the "Denied", "Approved" or "Ready" condition already exists, exit early.
+func (c *Controller) Sync(ctx context.Context, cr *cmapi.CertificateRequest) (err error) {
+	log := logf.FromContext(ctx, "external-approver")
+
+	switch {
+	case
+		// If the CertificateRequest has already been approved, exit early.
+		apiutil.CertificateRequestIsApproved(cr),
+
+		// If the CertificateRequest has already been denied, exit early.
+		apiutil.CertificateRequestIsDenied(cr),
+
+		// If the CertificateRequest is "Issued" or "Failed", exit early.
+		apiutil.CertificateRequestReadyReason(cr) == cmapi.CertificateRequestReasonFailed,
+		apiutil.CertificateRequestReadyReason(cr) == cmapi.CertificateRequestReasonIssued:
+		return nil
+	}
+
+	csr, err := pki.DecodeX509CertificateRequestBytes(cr.Spec.Request)
+	if err != nil {
+		return c.setCondition(ctx, cr, cmapi.CertificateRequestConditionDenied,
+			fmt.Sprintf("Failed to decode the certificate signing request: %s", err))
+	}
+
+	response, err := c.verdict(ctx, cr, csr)
+	if err != nil {
+		log.Error(err, "failed to review certificate request with the external approver")
+		message := fmt.Sprintf("Failed to review certificate request with the external approver: %s", err)
+		if c.failurePolicy == FailurePolicyDeny {
+			return c.setCondition(ctx, cr, cmapi.CertificateRequestConditionDenied, message)
+		}
+		c.recorder.Event(cr, corev1.EventTypeWarning, ReasonExternalApproverError, message)
+		return err
+	}
+
+	if response.Approved {
+		message := ApprovedMessage
+		if len(response.Message) > 0 {
+			message = fmt.Sprintf("%s: %s", ApprovedMessage, response.Message)
+		}
+		return c.setCondition(ctx, cr, cmapi.CertificateRequestConditionApproved, message)
+	}
+
+	message := DeniedMessage
+	if len(response.Message) > 0 {
+		message = fmt.Sprintf("%s: %s", DeniedMessage, response.Message)
+	}
+	return c.setCondition(ctx, cr, cmapi.CertificateRequestConditionDenied, message)
+}
+
+// verdict returns the response of the external approver on the
+// CertificateRequest, from the cache if it has been reviewed recently.
+func (c *Controller) verdict(ctx context.Context, cr *cmapi.CertificateRequest, csr *x509.CertificateRequest) (*approvalapi.CertificateRequestReviewResponse, error) {
+	if cached, ok := c.verdicts.Get(cr.UID); ok {
+		return cached.(*approvalapi.CertificateRequestReviewResponse), nil
+	}
+
+	result, err := c.reviewer.Review(ctx, buildReview(cr, csr))
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case result.APIVersion != approvalapi.SchemeGroupVersion || result.Kind != approvalapi.CertificateRequestReviewKind:
+		return nil, fmt.Errorf("expected a response of kind %s/%s, got %s/%s",
+			approvalapi.SchemeGroupVersion, approvalapi.CertificateRequestReviewKind, result.APIVersion, result.Kind)
+	case result.Response == nil:
+		return nil, errors.New("response is missing")
+	case result.Response.UID != cr.UID:
+		return nil, fmt.Errorf("expected a response for uid %q, got %q", cr.UID, result.Response.UID)
+	}
+
+	if c.cacheTTL > 0 {
+		c.verdicts.Add(cr.UID, result.Response, c.cacheTTL)
+	}
+	return result.Response, nil
+}
+
+// setCondition sets the given condition to True on the CertificateRequest,
+// updates its status and fires an event with the given message.
+func (c *Controller) setCondition(ctx context.Context, cr *cmapi.CertificateRequest, conditionType cmapi.CertificateRequestConditionType, message string) error {
+	apiutil.SetCertificateRequestCondition(cr, conditionType, cmmeta.ConditionTrue, Reason, message)
+
+	_, err := c.cmClient.CertmanagerV1().CertificateRequests(cr.Namespace).UpdateStatus(ctx, cr, metav1.UpdateOptions{})
+	if err != nil {
+		return err
+	}
+
+	eventType := corev1.EventTypeNormal
+	if conditionType == cmapi.CertificateRequestConditionDenied {
+		eventType = corev1.EventTypeWarning
+	}
+	c.recorder.Event(cr, eventType, Reason, message)
+
+	return nil
+}
@@ -0,0 +1,217 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalapprover
+
+import (
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilcache "k8s.io/apimachinery/pkg/util/cache"
+	coretesting "k8s.io/client-go/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	approvalapi "github.com/jetstack/cert-manager/pkg/apis/approval/v1alpha1"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	testpkg "github.com/jetstack/cert-manager/pkg/controller/test"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+	"github.com/jetstack/cert-manager/test/unit/gen"
+)
+
+type fakeReviewer func(review *approvalapi.CertificateRequestReview) (*approvalapi.CertificateRequestReview, error)
+
+func (f fakeReviewer) Review(_ context.Context, review *approvalapi.CertificateRequestReview) (*approvalapi.CertificateRequestReview, error) {
+	return f(review)
+}
+
+func mustGenerateCSR(t *testing.T, template *x509.CertificateRequest) []byte {
+	key, err := pki.GenerateECPrivateKey(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := pki.EncodeCSR(template, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der})
+}
+
+func TestSync(t *testing.T) {
+	// now time is the current time at the start of the test (the clock is fixed)
+	now := time.Now()
+	metaNow := metav1.NewTime(now)
+
+	baseCR := gen.CertificateRequest("test",
+		gen.SetCertificateRequestNamespace("testns"),
+		gen.SetCertificateRequestUsername("user-1"),
+		gen.SetCertificateRequestGroups([]string{"group-1"}),
+		gen.SetCertificateRequestCSR(mustGenerateCSR(t, &x509.CertificateRequest{
+			Subject:  pkix.Name{CommonName: "www.example.com"},
+			DNSNames: []string{"www.example.com"},
+		})),
+	)
+	baseCR.UID = "cr-uid"
+
+	verdict := func(approved bool, message string) fakeReviewer {
+		return func(review *approvalapi.CertificateRequestReview) (*approvalapi.CertificateRequestReview, error) {
+			return &approvalapi.CertificateRequestReview{
+				TypeMeta: approvalTypeMeta,
+				Response: &approvalapi.CertificateRequestReviewResponse{
+					UID:      review.Request.UID,
+					Approved: approved,
+					Message:  message,
+				},
+			}, nil
+		}
+	}
+
+	tests := map[string]struct {
+		request       *cmapi.CertificateRequest
+		reviewer      fakeReviewer
+		failurePolicy string
+		// cached is a response cached for the request before it is synced
+		cached *approvalapi.CertificateRequestReviewResponse
+
+		expectedCondition *cmapi.CertificateRequestCondition
+		expectedEvent     string
+		expectedErr       bool
+	}{
+		"do nothing if CertificateRequest already has 'Approved' True condition": {
+			request: gen.CertificateRequestFrom(baseCR, gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+				Type:   cmapi.CertificateRequestConditionApproved,
+				Status: cmmeta.ConditionTrue,
+			})),
+		},
+		"approve if the external approver approves the request": {
+			request:  baseCR,
+			reviewer: verdict(true, "owned by team-a"),
+			expectedCondition: &cmapi.CertificateRequestCondition{
+				Type:    cmapi.CertificateRequestConditionApproved,
+				Message: ApprovedMessage + ": owned by team-a",
+			},
+			expectedEvent: "Normal external-approver.cert-manager.io " + ApprovedMessage + ": owned by team-a",
+		},
+		"deny if the external approver denies the request": {
+			request:  baseCR,
+			reviewer: verdict(false, ""),
+			expectedCondition: &cmapi.CertificateRequestCondition{
+				Type:    cmapi.CertificateRequestConditionDenied,
+				Message: DeniedMessage,
+			},
+			expectedEvent: "Warning external-approver.cert-manager.io " + DeniedMessage,
+		},
+		"use a cached verdict instead of calling the external approver": {
+			request: baseCR,
+			cached:  &approvalapi.CertificateRequestReviewResponse{UID: baseCR.UID, Approved: true},
+			expectedCondition: &cmapi.CertificateRequestCondition{
+				Type:    cmapi.CertificateRequestConditionApproved,
+				Message: ApprovedMessage,
+			},
+			expectedEvent: "Normal external-approver.cert-manager.io " + ApprovedMessage,
+		},
+		"retry if the external approver fails with the Retry failure policy": {
+			request: baseCR,
+			reviewer: func(*approvalapi.CertificateRequestReview) (*approvalapi.CertificateRequestReview, error) {
+				return nil, errors.New("connection refused")
+			},
+			failurePolicy: FailurePolicyRetry,
+			expectedEvent: "Warning ExternalApproverError Failed to review certificate request with the external approver: connection refused",
+			expectedErr:   true,
+		},
+		"deny if the external approver fails with the Deny failure policy": {
+			request: baseCR,
+			reviewer: func(*approvalapi.CertificateRequestReview) (*approvalapi.CertificateRequestReview, error) {
+				return nil, errors.New("connection refused")
+			},
+			failurePolicy: FailurePolicyDeny,
+			expectedCondition: &cmapi.CertificateRequestCondition{
+				Type:    cmapi.CertificateRequestConditionDenied,
+				Message: "Failed to review certificate request with the external approver: connection refused",
+			},
+			expectedEvent: "Warning external-approver.cert-manager.io Failed to review certificate request with the external approver: connection refused",
+		},
+		"retry if the external approver responds for another request": {
+			request: baseCR,
+			reviewer: func(*approvalapi.CertificateRequestReview) (*approvalapi.CertificateRequestReview, error) {
+				return &approvalapi.CertificateRequestReview{
+					TypeMeta: approvalTypeMeta,
+					Response: &approvalapi.CertificateRequestReviewResponse{UID: "other", Approved: true},
+				}, nil
+			},
+			failurePolicy: FailurePolicyRetry,
+			expectedEvent: `Warning ExternalApproverError Failed to review certificate request with the external approver: expected a response for uid "cr-uid", got "other"`,
+			expectedErr:   true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			fakeClock := fakeclock.NewFakeClock(now)
+			builder := &testpkg.Builder{
+				T:                  t,
+				Clock:              fakeClock,
+				CertManagerObjects: []runtime.Object{test.request},
+			}
+			if test.expectedCondition != nil {
+				condition := *test.expectedCondition
+				condition.Status = cmmeta.ConditionTrue
+				condition.Reason = Reason
+				condition.LastTransitionTime = &metaNow
+				expectedRequest := test.request.DeepCopy()
+				expectedRequest.Status.Conditions = append(expectedRequest.Status.Conditions, condition)
+				builder.ExpectedActions = append(builder.ExpectedActions,
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						test.request.Namespace,
+						expectedRequest,
+					)),
+				)
+			}
+			if test.expectedEvent != "" {
+				builder.ExpectedEvents = []string{test.expectedEvent}
+			}
+			builder.Init()
+			builder.Start()
+			defer builder.Stop()
+
+			c := &Controller{
+				cmClient:      builder.CMClient,
+				recorder:      builder.Recorder,
+				reviewer:      test.reviewer,
+				failurePolicy: test.failurePolicy,
+				verdicts:      utilcache.NewLRUExpireCacheWithClock(cacheSize, fakeClock),
+				cacheTTL:      time.Minute,
+			}
+			if test.cached != nil {
+				c.verdicts.Add(test.request.UID, test.cached, time.Minute)
+			}
+
+			err := c.Sync(context.Background(), test.request.DeepCopy())
+			if test.expectedErr != (err != nil) {
+				t.Errorf("expected error %t, got %v", test.expectedErr, err)
+			}
+			builder.CheckAndFinish()
+		})
+	}
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policyapprover
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	authzclient "k8s.io/client-go/kubernetes/typed/authorization/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	cmclient "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
+	cmlisters "github.com/jetstack/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/util/expression"
+)
+
+const (
+	ControllerName = "certificaterequests-policy-approver"
+)
+
+// Controller is a CertificateRequest controller which manages the "Approved"
+// and "Denied" conditions of CertificateRequests according to the
+// CertificateRequestPolicies that apply to them. It is an alternative to the
+// default approver controller, which always approves CertificateRequests, and
+// so is not enabled by default.
+type Controller struct {
+	// logger to be used by this controller
+	log logr.Logger
+
+	certificateRequestLister       cmlisters.CertificateRequestLister
+	certificateRequestPolicyLister cmlisters.CertificateRequestPolicyLister
+	cmClient                       cmclient.Interface
+	sarClient                      authzclient.SubjectAccessReviewInterface
+
+	// expressions caches the compiled CEL expressions of policies
+	expressions *expression.Cache
+
+	recorder record.EventRecorder
+
+	queue workqueue.RateLimitingInterface
+}
+
+func init() {
+	// create certificate request policy approver controller
+	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.Context) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, ControllerName).
+			For(new(Controller)).Complete()
+	})
+}
+
+// Register registers and constructs the controller using the provided context.
+// It returns the workqueue to be used to enqueue items, a list of
+// InformerSynced functions that must be synced, or an error.
+func (c *Controller) Register(ctx *controllerpkg.Context) (workqueue.RateLimitingInterface, []cache.InformerSynced, error) {
+	c.log = logf.FromContext(ctx.RootContext, ControllerName)
+	c.queue = workqueue.NewNamedRateLimitingQueue(controllerpkg.DefaultItemBasedRateLimiter(), ControllerName)
+
+	certificateRequestInformer := ctx.SharedInformerFactory.Certmanager().V1().CertificateRequests()
+	certificateRequestPolicyInformer := ctx.SharedInformerFactory.Certmanager().V1().CertificateRequestPolicies()
+	mustSync := []cache.InformerSynced{
+		certificateRequestInformer.Informer().HasSynced,
+		certificateRequestPolicyInformer.Informer().HasSynced,
+	}
+	certificateRequestInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: c.queue})
+	// re-evaluate pending CertificateRequests when a policy changes, as they
+	// may now be allowed
+	certificateRequestPolicyInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{WorkFunc: c.enqueuePendingCertificateRequests})
+
+	c.certificateRequestLister = certificateRequestInformer.Lister()
+	c.certificateRequestPolicyLister = certificateRequestPolicyInformer.Lister()
+	c.cmClient = ctx.CMClient
+	c.sarClient = ctx.Client.AuthorizationV1().SubjectAccessReviews()
+	c.recorder = ctx.Recorder
+	c.expressions = expression.NewCache()
+
+	c.log.V(logf.DebugLevel).Info("certificate request policy approver controller registered")
+
+	return c.queue, mustSync, nil
+}
+
+// enqueuePendingCertificateRequests enqueues all CertificateRequests that
+// have been neither approved nor denied.
+func (c *Controller) enqueuePendingCertificateRequests(_ interface{}) {
+	requests, err := c.certificateRequestLister.List(labels.Everything())
+	if err != nil {
+		c.log.Error(err, "failed listing CertificateRequest resources")
+		return
+	}
+	for _, cr := range requests {
+		if apiutil.CertificateRequestIsApproved(cr) || apiutil.CertificateRequestIsDenied(cr) {
+			continue
+		}
+		key, err := controllerpkg.KeyFunc(cr)
+		if err != nil {
+			c.log.Error(err, "error determining 'key' for resource")
+			continue
+		}
+		c.queue.Add(key)
+	}
+}
+
+func (c *Controller) ProcessItem(ctx context.Context, key string) error {
+	log := logf.FromContext(ctx)
+	dbg := log.V(logf.DebugLevel)
+
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		log.Error(err, "invalid resource key")
+		return nil
+	}
+
+	cr, err := c.certificateRequestLister.CertificateRequests(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		dbg.Info(fmt.Sprintf("certificate request in work queue no longer exists: %s", err))
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	ctx = logf.NewContext(ctx, logf.WithResource(log, cr))
+	return c.Sync(ctx, cr.DeepCopy())
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policyapprover
+
+import (
+	"crypto/x509"
+	"fmt"
+	"strings"
+
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	"github.com/jetstack/cert-manager/pkg/util/expression"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+// selectsIssuer returns true if the policy applies to CertificateRequests
+// referencing the given issuer.
+func selectsIssuer(spec *cmapi.CertificateRequestPolicySpec, ref cmmeta.ObjectReference) bool {
+	if len(spec.IssuerRefs) == 0 {
+		return true
+	}
+
+	kind, group := ref.Kind, ref.Group
+	if kind == "" {
+		kind = cmapi.IssuerKind
+	}
+	if group == "" {
+		group = certmanager.GroupName
+	}
+	for _, issuerRef := range spec.IssuerRefs {
+		if matchesOptionalPattern(issuerRef.Name, ref.Name) &&
+			matchesOptionalPattern(issuerRef.Kind, kind) &&
+			matchesOptionalPattern(issuerRef.Group, group) {
+			return true
+		}
+	}
+	return false
+}
+
+// evaluate returns the reasons why the policy does not allow the
+// CertificateRequest with the given decoded CSR. An empty list is returned if
+// the policy allows the request. The CEL expressions of the policy are
+// compiled using the given cache.
+func evaluate(expressions *expression.Cache, spec *cmapi.CertificateRequestPolicySpec, cr *cmapi.CertificateRequest, csr *x509.CertificateRequest) []string {
+	var errs []string
+	checkValues := func(field string, values, allowed []string) {
+		for _, v := range values {
+			if !matchesAnyPattern(allowed, v) {
+				errs = append(errs, fmt.Sprintf("%s %q is not allowed", field, v))
+			}
+		}
+	}
+
+	if csr.Subject.CommonName != "" {
+		checkValues("common name", []string{csr.Subject.CommonName}, spec.AllowedCommonNames)
+	}
+	checkValues("DNS name", csr.DNSNames, spec.AllowedDNSNames)
+	for _, ip := range csr.IPAddresses {
+		checkValues("IP address", []string{ip.String()}, spec.AllowedIPAddresses)
+	}
+	for _, uri := range csr.URIs {
+		checkValues("URI", []string{uri.String()}, spec.AllowedURIs)
+	}
+	checkValues("email address", csr.EmailAddresses, spec.AllowedEmailAddresses)
+
+	subject := spec.AllowedSubject
+	if subject == nil {
+		subject = &cmapi.CertificateRequestPolicySubject{}
+	}
+	checkValues("organization", csr.Subject.Organization, subject.Organizations)
+	checkValues("country", csr.Subject.Country, subject.Countries)
+	checkValues("organizational unit", csr.Subject.OrganizationalUnit, subject.OrganizationalUnits)
+	checkValues("locality", csr.Subject.Locality, subject.Localities)
+	checkValues("province", csr.Subject.Province, subject.Provinces)
+	checkValues("street address", csr.Subject.StreetAddress, subject.StreetAddresses)
+	checkValues("postal code", csr.Subject.PostalCode, subject.PostalCodes)
+	if csr.Subject.SerialNumber != "" {
+		checkValues("serial number", []string{csr.Subject.SerialNumber}, subject.SerialNumbers)
+	}
+
+	usages := cr.Spec.Usages
+	if len(usages) == 0 {
+		usages = cmapi.DefaultKeyUsages()
+	}
+	for _, usage := range usages {
+		if !containsUsage(spec.AllowedUsages, usage) {
+			errs = append(errs, fmt.Sprintf("usage %q is not allowed", usage))
+		}
+	}
+
+	if cr.Spec.IsCA && !spec.AllowIsCA {
+		errs = append(errs, "CA certificates are not allowed")
+	}
+
+	duration := cmapi.DefaultCertificateDuration
+	if cr.Spec.Duration != nil {
+		duration = cr.Spec.Duration.Duration
+	}
+	if spec.MinDuration != nil && duration < spec.MinDuration.Duration {
+		errs = append(errs, fmt.Sprintf("duration %s is less than the minimum of %s", duration, spec.MinDuration.Duration))
+	}
+	if spec.MaxDuration != nil && duration > spec.MaxDuration.Duration {
+		errs = append(errs, fmt.Sprintf("duration %s is greater than the maximum of %s", duration, spec.MaxDuration.Duration))
+	}
+
+	if len(spec.AllowedPrivateKeys) > 0 {
+		algorithm, size, err := pki.PublicKeyAlgorithmAndSize(csr.PublicKey)
+		switch {
+		case err != nil:
+			errs = append(errs, err.Error())
+		case !allowsPrivateKey(spec.AllowedPrivateKeys, algorithm, size):
+			errs = append(errs, fmt.Sprintf("private key %s of size %d is not allowed", algorithm, size))
+		}
+	}
+
+	errs = append(errs, evaluateExpressions(expressions, spec, cr, csr)...)
+
+	return errs
+}
+
+func containsUsage(usages []cmapi.KeyUsage, usage cmapi.KeyUsage) bool {
+	for _, u := range usages {
+		if u == usage {
+			return true
+		}
+	}
+	return false
+}
+
+func allowsPrivateKey(allowed []cmapi.CertificateRequestPolicyPrivateKey, algorithm cmapi.PrivateKeyAlgorithm, size int) bool {
+	for _, pk := range allowed {
+		if pk.Algorithm != algorithm {
+			continue
+		}
+		if pk.MinSize > 0 && size < pk.MinSize {
+			continue
+		}
+		if pk.MaxSize > 0 && size > pk.MaxSize {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+// matchesOptionalPattern returns true if the pattern is empty, or if the
+// value matches the pattern.
+func matchesOptionalPattern(pattern, value string) bool {
+	return pattern == "" || matchesPattern(pattern, value)
+}
+
+func matchesAnyPattern(patterns []string, value string) bool {
+	for _, pattern := range patterns {
+		if matchesPattern(pattern, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesPattern returns true if the value matches the pattern, in which '*'
+// matches any sequence of characters, including the empty sequence.
+func matchesPattern(pattern, value string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == value
+	}
+
+	// the value must start with the first part and end with the last part,
+	// and contain the parts in between in order
+	if !strings.HasPrefix(value, parts[0]) {
+		return false
+	}
+	value = value[len(parts[0]):]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(value, part)
+		if i < 0 {
+			return false
+		}
+		value = value[i+len(part):]
+	}
+	return strings.HasSuffix(value, parts[len(parts)-1])
+}
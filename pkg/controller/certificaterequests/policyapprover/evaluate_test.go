@@ -0,0 +1,215 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policyapprover
+
+import (
+	"crypto"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	"github.com/jetstack/cert-manager/pkg/util/expression"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+func mustGenerateCSR(t *testing.T, key crypto.Signer, template *x509.CertificateRequest) []byte {
+	der, err := pki.EncodeCSR(template, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der})
+}
+
+func TestMatchesPattern(t *testing.T) {
+	tests := []struct {
+		pattern, value string
+		expected       bool
+	}{
+		{"example.com", "example.com", true},
+		{"example.com", "www.example.com", false},
+		{"*.example.com", "www.example.com", true},
+		{"*.example.com", "example.com", false},
+		{"*", "anything", true},
+		{"*", "", true},
+		{"a*b*c", "abbc", true},
+		{"a*b*c", "acb", false},
+		{"ab*ba", "aba", false},
+		{"spiffe://cluster.local/ns/*/sa/*", "spiffe://cluster.local/ns/default/sa/app", true},
+	}
+	for _, test := range tests {
+		if actual := matchesPattern(test.pattern, test.value); actual != test.expected {
+			t.Errorf("matchesPattern(%q, %q): expected %t, got %t", test.pattern, test.value, test.expected, actual)
+		}
+	}
+}
+
+func TestSelectsIssuer(t *testing.T) {
+	spec := &cmapi.CertificateRequestPolicySpec{
+		IssuerRefs: []cmapi.CertificateRequestPolicyIssuerRef{
+			{Name: "ca-*", Kind: cmapi.IssuerKind},
+			{Name: "vault", Kind: cmapi.ClusterIssuerKind},
+			{Group: "*.example.com"},
+		},
+	}
+	tests := map[string]struct {
+		ref      cmmeta.ObjectReference
+		expected bool
+	}{
+		"issuer with default kind": {
+			ref:      cmmeta.ObjectReference{Name: "ca-issuer"},
+			expected: true,
+		},
+		"issuer with a non-matching name": {
+			ref: cmmeta.ObjectReference{Name: "acme", Kind: cmapi.IssuerKind},
+		},
+		"cluster issuer": {
+			ref:      cmmeta.ObjectReference{Name: "vault", Kind: cmapi.ClusterIssuerKind, Group: "cert-manager.io"},
+			expected: true,
+		},
+		"cluster issuer with the name of an issuer": {
+			ref: cmmeta.ObjectReference{Name: "ca-issuer", Kind: cmapi.ClusterIssuerKind},
+		},
+		"external issuer": {
+			ref:      cmmeta.ObjectReference{Name: "anything", Kind: "AWSPCAIssuer", Group: "awspca.example.com"},
+			expected: true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if actual := selectsIssuer(spec, test.ref); actual != test.expected {
+				t.Errorf("expected %t, got %t", test.expected, actual)
+			}
+		})
+	}
+
+	if !selectsIssuer(&cmapi.CertificateRequestPolicySpec{}, cmmeta.ObjectReference{Name: "any"}) {
+		t.Errorf("expected a policy without issuerRefs to select any issuer")
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	rsaKey, err := pki.GenerateRSAPrivateKey(2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := pki.GenerateECPrivateKey(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	spec := cmapi.CertificateRequestPolicySpec{
+		AllowedCommonNames: []string{"*.example.com"},
+		AllowedDNSNames:    []string{"*.example.com"},
+		AllowedIPAddresses: []string{"10.0.0.*"},
+		AllowedSubject:     &cmapi.CertificateRequestPolicySubject{Organizations: []string{"Example"}},
+		AllowedUsages:      cmapi.DefaultKeyUsages(),
+		MaxDuration:        &metav1.Duration{Duration: cmapi.DefaultCertificateDuration},
+		AllowedPrivateKeys: []cmapi.CertificateRequestPolicyPrivateKey{{Algorithm: cmapi.ECDSAKeyAlgorithm, MinSize: 256}},
+	}
+	allowedCSR := &x509.CertificateRequest{
+		Subject:     pkix.Name{CommonName: "www.example.com", Organization: []string{"Example"}},
+		DNSNames:    []string{"www.example.com", "api.example.com"},
+		IPAddresses: []net.IP{net.ParseIP("10.0.0.1")},
+	}
+
+	tests := map[string]struct {
+		spec     cmapi.CertificateRequestPolicySpec
+		key      crypto.Signer
+		csr      *x509.CertificateRequest
+		cr       cmapi.CertificateRequestSpec
+		expected []string
+	}{
+		"allowed request": {
+			spec: spec,
+			key:  ecKey,
+			csr:  allowedCSR,
+		},
+		"disallowed names and subject": {
+			spec: spec,
+			key:  ecKey,
+			csr: &x509.CertificateRequest{
+				Subject:        pkix.Name{CommonName: "example.org", Organization: []string{"Other"}, Country: []string{"GB"}},
+				DNSNames:       []string{"www.example.org"},
+				IPAddresses:    []net.IP{net.ParseIP("192.168.0.1")},
+				EmailAddresses: []string{"admin@example.com"},
+			},
+			expected: []string{
+				`common name "example.org" is not allowed`,
+				`DNS name "www.example.org" is not allowed`,
+				`IP address "192.168.0.1" is not allowed`,
+				`email address "admin@example.com" is not allowed`,
+				`organization "Other" is not allowed`,
+				`country "GB" is not allowed`,
+			},
+		},
+		"disallowed usages, CA and duration": {
+			spec: spec,
+			key:  ecKey,
+			csr:  allowedCSR,
+			cr: cmapi.CertificateRequestSpec{
+				Usages:   []cmapi.KeyUsage{cmapi.UsageCertSign},
+				IsCA:     true,
+				Duration: &metav1.Duration{Duration: cmapi.DefaultCertificateDuration * 2},
+			},
+			expected: []string{
+				`usage "cert sign" is not allowed`,
+				"CA certificates are not allowed",
+				"duration 4320h0m0s is greater than the maximum of 2160h0m0s",
+			},
+		},
+		"request shorter than the minimum duration": {
+			spec: cmapi.CertificateRequestPolicySpec{
+				AllowedUsages: cmapi.DefaultKeyUsages(),
+				MinDuration:   &metav1.Duration{Duration: time.Hour * 24 * 365},
+			},
+			key:      ecKey,
+			csr:      &x509.CertificateRequest{},
+			expected: []string{"duration 2160h0m0s is less than the minimum of 8760h0m0s"},
+		},
+		"disallowed private key": {
+			spec:     spec,
+			key:      rsaKey,
+			csr:      allowedCSR,
+			expected: []string{"private key RSA of size 2048 is not allowed"},
+		},
+		"any private key allowed if none are listed": {
+			spec: cmapi.CertificateRequestPolicySpec{AllowedUsages: cmapi.DefaultKeyUsages()},
+			key:  rsaKey,
+			csr:  &x509.CertificateRequest{},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			csr, err := pki.DecodeX509CertificateRequestBytes(mustGenerateCSR(t, test.key, test.csr))
+			if err != nil {
+				t.Fatal(err)
+			}
+			cr := &cmapi.CertificateRequest{Spec: test.cr}
+			if errs := evaluate(expression.NewCache(), &test.spec, cr, csr); !reflect.DeepEqual(errs, test.expected) {
+				t.Errorf("expected violations %q, got %q", test.expected, errs)
+			}
+		})
+	}
+}
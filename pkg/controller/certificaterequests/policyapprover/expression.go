@@ -0,0 +1,127 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policyapprover
+
+import (
+	"crypto/x509"
+	"fmt"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/jetstack/cert-manager/pkg/util/expression"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+// evaluateExpressions returns the reasons why the CEL expressions of the
+// policy do not allow the CertificateRequest with the given decoded CSR.
+func evaluateExpressions(expressions *expression.Cache, spec *cmapi.CertificateRequestPolicySpec, cr *cmapi.CertificateRequest, csr *x509.CertificateRequest) []string {
+	if len(spec.Expressions) == 0 {
+		return nil
+	}
+
+	var errs []string
+	request := requestVariable(cr, csr)
+	for _, expr := range spec.Expressions {
+		program, err := expressions.Compile(expr)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("expression %q is invalid: %s", expr, err))
+			continue
+		}
+		allowed, err := expression.Evaluate(program, request)
+		switch {
+		case err != nil:
+			errs = append(errs, fmt.Sprintf("expression %q failed to evaluate: %s", expr, err))
+		case !allowed:
+			errs = append(errs, fmt.Sprintf("expression %q evaluated to false", expr))
+		}
+	}
+	return errs
+}
+
+// requestVariable returns the value of the variable that CEL expressions are
+// evaluated against for the CertificateRequest with the given decoded CSR.
+func requestVariable(cr *cmapi.CertificateRequest, csr *x509.CertificateRequest) map[string]interface{} {
+	ipAddresses := make([]string, len(csr.IPAddresses))
+	for i, ip := range csr.IPAddresses {
+		ipAddresses[i] = ip.String()
+	}
+	uris := make([]string, len(csr.URIs))
+	for i, uri := range csr.URIs {
+		uris[i] = uri.String()
+	}
+	usages := cr.Spec.Usages
+	if len(usages) == 0 {
+		usages = cmapi.DefaultKeyUsages()
+	}
+	usageStrings := make([]string, len(usages))
+	for i, usage := range usages {
+		usageStrings[i] = string(usage)
+	}
+	duration := cmapi.DefaultCertificateDuration
+	if cr.Spec.Duration != nil {
+		duration = cr.Spec.Duration.Duration
+	}
+	extra := make(map[string][]string, len(cr.Spec.Extra))
+	for k, v := range cr.Spec.Extra {
+		extra[k] = v
+	}
+	// the key algorithm and size are left empty if the public key is not
+	// supported, so that expressions checking them evaluate to false
+	keyAlgorithm, keySize, _ := pki.PublicKeyAlgorithmAndSize(csr.PublicKey)
+
+	return map[string]interface{}{
+		"namespace": cr.Namespace,
+		"name":      cr.Name,
+		"username":  cr.Spec.Username,
+		"uid":       cr.Spec.UID,
+		"groups":    nonNilStrings(cr.Spec.Groups),
+		"extra":     extra,
+		"issuerRef": map[string]interface{}{
+			"name":  cr.Spec.IssuerRef.Name,
+			"kind":  cr.Spec.IssuerRef.Kind,
+			"group": cr.Spec.IssuerRef.Group,
+		},
+		"commonName":     csr.Subject.CommonName,
+		"dnsNames":       nonNilStrings(csr.DNSNames),
+		"ipAddresses":    ipAddresses,
+		"uris":           uris,
+		"emailAddresses": nonNilStrings(csr.EmailAddresses),
+		"subject": map[string]interface{}{
+			"organizations":       nonNilStrings(csr.Subject.Organization),
+			"countries":           nonNilStrings(csr.Subject.Country),
+			"organizationalUnits": nonNilStrings(csr.Subject.OrganizationalUnit),
+			"localities":          nonNilStrings(csr.Subject.Locality),
+			"provinces":           nonNilStrings(csr.Subject.Province),
+			"streetAddresses":     nonNilStrings(csr.Subject.StreetAddress),
+			"postalCodes":         nonNilStrings(csr.Subject.PostalCode),
+			"serialNumber":        csr.Subject.SerialNumber,
+		},
+		"usages":       usageStrings,
+		"isCA":         cr.Spec.IsCA,
+		"duration":     duration,
+		"keyAlgorithm": string(keyAlgorithm),
+		"keySize":      keySize,
+	}
+}
+
+// nonNilStrings returns an empty slice in place of nil, so that expressions
+// always see a list.
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
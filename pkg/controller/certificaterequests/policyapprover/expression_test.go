@@ -0,0 +1,99 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policyapprover
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	"github.com/jetstack/cert-manager/pkg/util/expression"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+func TestEvaluateExpressions(t *testing.T) {
+	key, err := pki.GenerateECPrivateKey(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	csr, err := pki.DecodeX509CertificateRequestBytes(mustGenerateCSR(t, key, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: "app.sandbox.svc"},
+		DNSNames: []string{"app.sandbox.svc", "app.sandbox.svc.cluster.local"},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cr := &cmapi.CertificateRequest{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "sandbox", Name: "app"},
+		Spec: cmapi.CertificateRequestSpec{
+			IssuerRef: cmmeta.ObjectReference{Name: "ca-issuer"},
+			Username:  "system:serviceaccount:sandbox:app",
+			Groups:    []string{"system:serviceaccounts", "system:serviceaccounts:sandbox"},
+			Extra:     map[string][]string{"team": {"payments"}},
+			Duration:  &metav1.Duration{Duration: cmapi.DefaultCertificateDuration / 3},
+		},
+	}
+
+	tests := map[string]struct {
+		expressions []string
+		expected    []string
+	}{
+		"no expressions": {},
+		"expressions evaluating to true": {
+			expressions: []string{
+				"request.dnsNames.all(n, n.startsWith(request.name + '.' + request.namespace + '.svc'))",
+				"'system:serviceaccounts:' + request.namespace in request.groups",
+				"request.extra.team == ['payments']",
+				"request.issuerRef.name == 'ca-issuer' && request.issuerRef.kind == ''",
+				"request.duration <= duration('720h')",
+				"request.keyAlgorithm == 'ECDSA' && request.keySize == 256",
+				"size(request.uris) == 0 && size(request.subject.organizations) == 0",
+				"request.usages == ['digital signature', 'key encipherment'] && !request.isCA",
+			},
+		},
+		"expressions evaluating to false": {
+			expressions: []string{
+				"'admins' in request.groups",
+				"request.commonName == ''",
+			},
+			expected: []string{
+				`expression "'admins' in request.groups" evaluated to false`,
+				`expression "request.commonName == ''" evaluated to false`,
+			},
+		},
+		"invalid expressions": {
+			expressions: []string{"size(request.dnsNames)", "request.missing"},
+			expected: []string{
+				`expression "size(request.dnsNames)" is invalid: expression must evaluate to a bool, not int`,
+				`expression "request.missing" failed to evaluate: no such key: missing`,
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			spec := &cmapi.CertificateRequestPolicySpec{Expressions: test.expressions}
+			if errs := evaluateExpressions(expression.NewCache(), spec, cr, csr); !reflect.DeepEqual(errs, test.expected) {
+				t.Errorf("expected violations %q, got %q", test.expected, errs)
+			}
+		})
+	}
+}
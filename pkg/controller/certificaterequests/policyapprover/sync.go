@@ -0,0 +1,182 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policyapprover
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	authzv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+const (
+	// Reason is the reason set on the "Approved" and "Denied" conditions,
+	// and on the events fired, by this controller.
+	Reason = "policy.cert-manager.io"
+
+	NoApplicablePolicyMessage = "No CertificateRequestPolicy applies to the certificate request"
+)
+
+// Sync will set the "Approved" condition to True on synced
+// CertificateRequests that are allowed by at least one of the
+// CertificateRequestPolicies that apply to them, and the "Denied" condition
+// to True otherwise. If the "Denied", "Approved" or "Ready" condition already
+// exists, exit early.
+func (c *Controller) Sync(ctx context.Context, cr *cmapi.CertificateRequest) (err error) {
+	log := logf.FromContext(ctx, "policy-approver")
+
+	switch {
+	case
+		// If the CertificateRequest has already been approved, exit early.
+		apiutil.CertificateRequestIsApproved(cr),
+
+		// If the CertificateRequest has already been denied, exit early.
+		apiutil.CertificateRequestIsDenied(cr),
+
+		// If the CertificateRequest is "Issued" or "Failed", exit early.
+		apiutil.CertificateRequestReadyReason(cr) == cmapi.CertificateRequestReasonFailed,
+		apiutil.CertificateRequestReadyReason(cr) == cmapi.CertificateRequestReasonIssued:
+		return nil
+	}
+
+	policies, err := c.applicablePolicies(ctx, cr)
+	if err != nil {
+		return err
+	}
+	if len(policies) == 0 {
+		log.V(logf.DebugLevel).Info("no certificate request policy applies to the request")
+		return c.setCondition(ctx, cr, cmapi.CertificateRequestConditionDenied, NoApplicablePolicyMessage)
+	}
+
+	csr, err := pki.DecodeX509CertificateRequestBytes(cr.Spec.Request)
+	if err != nil {
+		return c.setCondition(ctx, cr, cmapi.CertificateRequestConditionDenied,
+			fmt.Sprintf("Failed to decode the certificate signing request: %s", err))
+	}
+
+	var violations []string
+	for _, policy := range policies {
+		errs := evaluate(c.expressions, &policy.Spec, cr, csr)
+		if len(errs) == 0 {
+			log.V(logf.DebugLevel).Info("certificate request allowed by policy", "policy", policy.Name)
+			return c.setCondition(ctx, cr, cmapi.CertificateRequestConditionApproved,
+				fmt.Sprintf("Certificate request has been approved by CertificateRequestPolicy %q", policy.Name))
+		}
+		violations = append(violations, fmt.Sprintf("%s: %s", policy.Name, strings.Join(errs, ", ")))
+	}
+
+	log.V(logf.DebugLevel).Info("certificate request not allowed by any policy", "violations", violations)
+	return c.setCondition(ctx, cr, cmapi.CertificateRequestConditionDenied,
+		fmt.Sprintf("Certificate request is not allowed by any CertificateRequestPolicy: [%s]", strings.Join(violations, "; ")))
+}
+
+// setCondition sets the given condition to True on the CertificateRequest,
+// updates its status and fires an event with the given message.
+func (c *Controller) setCondition(ctx context.Context, cr *cmapi.CertificateRequest, conditionType cmapi.CertificateRequestConditionType, message string) error {
+	apiutil.SetCertificateRequestCondition(cr, conditionType, cmmeta.ConditionTrue, Reason, message)
+
+	_, err := c.cmClient.CertmanagerV1().CertificateRequests(cr.Namespace).UpdateStatus(ctx, cr, metav1.UpdateOptions{})
+	if err != nil {
+		return err
+	}
+
+	eventType := corev1.EventTypeNormal
+	if conditionType == cmapi.CertificateRequestConditionDenied {
+		eventType = corev1.EventTypeWarning
+	}
+	c.recorder.Event(cr, eventType, Reason, message)
+
+	return nil
+}
+
+// applicablePolicies returns the CertificateRequestPolicies, sorted by name,
+// that select the issuer of the CertificateRequest and that the requester of
+// the CertificateRequest is bound to.
+func (c *Controller) applicablePolicies(ctx context.Context, cr *cmapi.CertificateRequest) ([]*cmapi.CertificateRequestPolicy, error) {
+	policies, err := c.certificateRequestPolicyLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(policies, func(i, j int) bool {
+		return policies[i].Name < policies[j].Name
+	})
+
+	var applicable []*cmapi.CertificateRequestPolicy
+	for _, policy := range policies {
+		if !selectsIssuer(&policy.Spec, cr.Spec.IssuerRef) {
+			continue
+		}
+		ok, err := c.requesterCanUsePolicy(ctx, cr, policy.Name)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			applicable = append(applicable, policy)
+		}
+	}
+	return applicable, nil
+}
+
+// requesterCanUsePolicy will return true if the requester of the
+// CertificateRequest has a bound role that allows them to use the given
+// policy. The user must have the permissions:
+// group: cert-manager.io
+// resource: certificaterequestpolicies
+// verb: use
+// namespace: <namespace of the CertificateRequest, or cluster wide>
+// name: <name of the policy>
+func (c *Controller) requesterCanUsePolicy(ctx context.Context, cr *cmapi.CertificateRequest, policyName string) (bool, error) {
+	extra := make(map[string]authzv1.ExtraValue)
+	for k, v := range cr.Spec.Extra {
+		extra[k] = authzv1.ExtraValue(v)
+	}
+
+	resp, err := c.sarClient.Create(ctx, &authzv1.SubjectAccessReview{
+		Spec: authzv1.SubjectAccessReviewSpec{
+			User:   cr.Spec.Username,
+			Groups: cr.Spec.Groups,
+			Extra:  extra,
+			UID:    cr.Spec.UID,
+
+			ResourceAttributes: &authzv1.ResourceAttributes{
+				Group:     certmanager.GroupName,
+				Resource:  "certificaterequestpolicies",
+				Verb:      "use",
+				Namespace: cr.Namespace,
+				Name:      policyName,
+				Version:   "*",
+			},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	return resp.Status.Allowed, nil
+}
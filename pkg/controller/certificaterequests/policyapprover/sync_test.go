@@ -0,0 +1,205 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policyapprover
+
+import (
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+	"time"
+
+	authzv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	testpkg "github.com/jetstack/cert-manager/pkg/controller/test"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+	"github.com/jetstack/cert-manager/test/unit/gen"
+)
+
+func TestSync(t *testing.T) {
+	// now time is the current time at the start of the test (the clock is fixed)
+	now := time.Now()
+	metaNow := metav1.NewTime(now)
+
+	key, err := pki.GenerateECPrivateKey(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	baseCR := gen.CertificateRequest("test",
+		gen.SetCertificateRequestNamespace("testns"),
+		gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{Name: "ca-issuer"}),
+		gen.SetCertificateRequestUsername("user-1"),
+		gen.SetCertificateRequestCSR(mustGenerateCSR(t, key, &x509.CertificateRequest{
+			Subject:  pkix.Name{CommonName: "www.example.com"},
+			DNSNames: []string{"www.example.com"},
+		})),
+	)
+	policy := func(name string, spec cmapi.CertificateRequestPolicySpec) *cmapi.CertificateRequestPolicy {
+		return &cmapi.CertificateRequestPolicy{ObjectMeta: metav1.ObjectMeta{Name: name}, Spec: spec}
+	}
+	allowingSpec := cmapi.CertificateRequestPolicySpec{
+		AllowedCommonNames: []string{"*.example.com"},
+		AllowedDNSNames:    []string{"*.example.com"},
+		AllowedUsages:      cmapi.DefaultKeyUsages(),
+	}
+	restrictiveSpec := cmapi.CertificateRequestPolicySpec{
+		AllowedDNSNames: []string{"*.internal"},
+		AllowedUsages:   cmapi.DefaultKeyUsages(),
+	}
+	sarAction := func(policyName string) testpkg.Action {
+		return testpkg.NewAction(coretesting.NewCreateAction(
+			authzv1.SchemeGroupVersion.WithResource("subjectaccessreviews"),
+			"",
+			&authzv1.SubjectAccessReview{
+				Spec: authzv1.SubjectAccessReviewSpec{
+					User:  "user-1",
+					Extra: map[string]authzv1.ExtraValue{},
+					ResourceAttributes: &authzv1.ResourceAttributes{
+						Group:     certmanager.GroupName,
+						Resource:  "certificaterequestpolicies",
+						Verb:      "use",
+						Namespace: "testns",
+						Name:      policyName,
+						Version:   "*",
+					},
+				},
+			},
+		))
+	}
+
+	tests := map[string]struct {
+		request  *cmapi.CertificateRequest
+		policies []runtime.Object
+		// boundPolicies are the names of the policies the requester may use
+		boundPolicies []string
+
+		expectedSARs      []string
+		expectedCondition *cmapi.CertificateRequestCondition
+		expectedEvent     string
+	}{
+		"do nothing if CertificateRequest already has 'Denied' True condition": {
+			request: gen.CertificateRequestFrom(baseCR, gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+				Type:   cmapi.CertificateRequestConditionDenied,
+				Status: cmmeta.ConditionTrue,
+			})),
+			policies:      []runtime.Object{policy("allowing", allowingSpec)},
+			boundPolicies: []string{"allowing"},
+		},
+		"deny if the requester is not bound to any policy": {
+			request:      baseCR,
+			policies:     []runtime.Object{policy("allowing", allowingSpec)},
+			expectedSARs: []string{"allowing"},
+			expectedCondition: &cmapi.CertificateRequestCondition{
+				Type:    cmapi.CertificateRequestConditionDenied,
+				Message: NoApplicablePolicyMessage,
+			},
+			expectedEvent: "Warning policy.cert-manager.io " + NoApplicablePolicyMessage,
+		},
+		"deny if no policy selects the issuer": {
+			request: baseCR,
+			policies: []runtime.Object{policy("other-issuer", cmapi.CertificateRequestPolicySpec{
+				IssuerRefs: []cmapi.CertificateRequestPolicyIssuerRef{{Name: "vault"}},
+			})},
+			boundPolicies: []string{"other-issuer"},
+			expectedCondition: &cmapi.CertificateRequestCondition{
+				Type:    cmapi.CertificateRequestConditionDenied,
+				Message: NoApplicablePolicyMessage,
+			},
+			expectedEvent: "Warning policy.cert-manager.io " + NoApplicablePolicyMessage,
+		},
+		"approve if any applicable policy allows the request": {
+			request:       baseCR,
+			policies:      []runtime.Object{policy("allowing", allowingSpec), policy("restrictive", restrictiveSpec)},
+			boundPolicies: []string{"allowing", "restrictive"},
+			expectedSARs:  []string{"allowing", "restrictive"},
+			expectedCondition: &cmapi.CertificateRequestCondition{
+				Type:    cmapi.CertificateRequestConditionApproved,
+				Message: `Certificate request has been approved by CertificateRequestPolicy "allowing"`,
+			},
+			expectedEvent: `Normal policy.cert-manager.io Certificate request has been approved by CertificateRequestPolicy "allowing"`,
+		},
+		"deny if no applicable policy allows the request": {
+			request:       baseCR,
+			policies:      []runtime.Object{policy("allowing", allowingSpec), policy("restrictive", restrictiveSpec)},
+			boundPolicies: []string{"restrictive"},
+			expectedSARs:  []string{"allowing", "restrictive"},
+			expectedCondition: &cmapi.CertificateRequestCondition{
+				Type:    cmapi.CertificateRequestConditionDenied,
+				Message: `Certificate request is not allowed by any CertificateRequestPolicy: [restrictive: common name "www.example.com" is not allowed, DNS name "www.example.com" is not allowed]`,
+			},
+			expectedEvent: `Warning policy.cert-manager.io Certificate request is not allowed by any CertificateRequestPolicy: [restrictive: common name "www.example.com" is not allowed, DNS name "www.example.com" is not allowed]`,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{
+				T:                  t,
+				Clock:              fakeclock.NewFakeClock(now),
+				CertManagerObjects: append([]runtime.Object{test.request}, test.policies...),
+			}
+			for _, policyName := range test.expectedSARs {
+				builder.ExpectedActions = append(builder.ExpectedActions, sarAction(policyName))
+			}
+			if test.expectedCondition != nil {
+				condition := *test.expectedCondition
+				condition.Status = cmmeta.ConditionTrue
+				condition.Reason = Reason
+				condition.LastTransitionTime = &metaNow
+				expectedRequest := test.request.DeepCopy()
+				expectedRequest.Status.Conditions = append(expectedRequest.Status.Conditions, condition)
+				builder.ExpectedActions = append(builder.ExpectedActions,
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						test.request.Namespace,
+						expectedRequest,
+					)),
+				)
+			}
+			if test.expectedEvent != "" {
+				builder.ExpectedEvents = []string{test.expectedEvent}
+			}
+			builder.Init()
+
+			builder.FakeKubeClient().PrependReactor("create", "subjectaccessreviews", func(action coretesting.Action) (bool, runtime.Object, error) {
+				sar := action.(coretesting.CreateAction).GetObject().(*authzv1.SubjectAccessReview)
+				allowed := false
+				for _, name := range test.boundPolicies {
+					allowed = allowed || sar.Spec.ResourceAttributes.Name == name
+				}
+				return true, &authzv1.SubjectAccessReview{Status: authzv1.SubjectAccessReviewStatus{Allowed: allowed}}, nil
+			})
+
+			c := new(Controller)
+			if _, _, err := c.Register(builder.Context); err != nil {
+				t.Fatal(err)
+			}
+			builder.Start()
+			defer builder.Stop()
+
+			err := c.Sync(context.Background(), test.request.DeepCopy())
+			builder.CheckAndFinish(err)
+		})
+	}
+}
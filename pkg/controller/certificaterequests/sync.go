@@ -128,6 +128,26 @@ func (c *Controller) Sync(ctx context.Context, cr *cmapi.CertificateRequest) (er
 		return nil
 	}
 
+	// Never sign requests from namespaces that the ClusterIssuer does not
+	// select, even if they were admitted before the namespaceSelector was set.
+	selected, err := c.issuerSelectsNamespace(crCopy, issuerObj)
+	if err != nil {
+		log.Error(err, "failed to check whether the issuer selects the namespace of the certificate request")
+		return err
+	}
+	if !selected {
+		c.reporter.Failed(crCopy, fmt.Errorf("namespace %q is not selected by the namespaceSelector of the ClusterIssuer", crCopy.Namespace),
+			"NamespaceNotSelected", "Referenced ClusterIssuer may not be used from this namespace")
+		return nil
+	}
+
+	// Never sign requests that violate the constraints of the issuer, even if
+	// they were admitted before the constraints were set.
+	if err := checkIssuerConstraints(crCopy, issuerObj); err != nil {
+		c.reporter.Failed(crCopy, err, "ConstraintViolation", "Certificate request violates the constraints of the issuer")
+		return nil
+	}
+
 	dbg.Info("invoking sign function as existing certificate does not exist")
 
 	// Attempt to call the Sign function on our issuer
@@ -29,6 +29,7 @@ import (
 	"testing"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	coretesting "k8s.io/client-go/testing"
@@ -80,6 +81,9 @@ func generateSelfSignedCert(t *testing.T, cr *cmapi.CertificateRequest, key cryp
 
 	template.NotAfter = notAfter
 	template.NotBefore = notBefore
+	// The template is also the parent, whose public key must match the
+	// signing key, rather than the public key of the CSR.
+	template.PublicKey = key.Public()
 
 	derBytes, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
 	if err != nil {
@@ -141,6 +145,11 @@ func TestSync(t *testing.T) {
 		}),
 	)
 
+	clusterIssuerRef := cmmeta.ObjectReference{
+		Kind: cmapi.ClusterIssuerKind,
+		Name: "test-cluster-issuer",
+	}
+
 	certRSAPEM := generateSelfSignedCert(t, baseCR, skRSA, fixedClockStart, fixedClockStart.Add(time.Hour*12))
 	certRSAPEMExpired := generateSelfSignedCert(t, baseCR, skRSA, fixedClockStart.Add(-time.Hour*13), fixedClockStart.Add(-time.Hour*12))
 
@@ -512,6 +521,92 @@ func TestSync(t *testing.T) {
 				ExpectedActions:    []testpkg.Action{},
 			},
 		},
+		"if the request violates the constraints of the issuer then fail without calling sign": {
+			certificateRequest: gen.CertificateRequestFrom(baseCR, gen.SetCertificateRequestIsCA(true)),
+			issuerImpl: &fake.Issuer{
+				FakeSign: func(context.Context, *cmapi.CertificateRequest, cmapi.GenericIssuer) (*issuer.IssueResponse, error) {
+					t.Error("unexpected call to sign")
+					return nil, nil
+				},
+			},
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{baseCR, gen.IssuerFrom(baseIssuer,
+					gen.SetIssuerConstraints(cmapi.IssuerConstraints{}),
+				)},
+				ExpectedEvents: []string{
+					"Warning ConstraintViolation Certificate request violates the constraints of the issuer: CA certificates are not allowed",
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestIsCA(true),
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             "Failed",
+								Message:            "Certificate request violates the constraints of the issuer: CA certificates are not allowed",
+								LastTransitionTime: &nowMetaTime,
+							}),
+							gen.SetCertificateRequestFailureTime(nowMetaTime),
+						),
+					)),
+				},
+			},
+		},
+		"if the namespace of the request is not selected by the ClusterIssuer then fail without calling sign": {
+			certificateRequest: gen.CertificateRequestFrom(baseCR, gen.SetCertificateRequestIssuer(clusterIssuerRef)),
+			issuerImpl: &fake.Issuer{
+				FakeSign: func(context.Context, *cmapi.CertificateRequest, cmapi.GenericIssuer) (*issuer.IssueResponse, error) {
+					t.Error("unexpected call to sign")
+					return nil, nil
+				},
+			},
+			builder: &testpkg.Builder{
+				KubeObjects: []runtime.Object{
+					&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+						Name:   gen.DefaultTestNamespace,
+						Labels: map[string]string{"env": "sandbox"},
+					}},
+				},
+				CertManagerObjects: []runtime.Object{
+					gen.CertificateRequestFrom(baseCR, gen.SetCertificateRequestIssuer(clusterIssuerRef)),
+					gen.ClusterIssuer("test-cluster-issuer",
+						gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{}),
+						gen.SetIssuerNamespaceSelector(metav1.LabelSelector{
+							MatchLabels: map[string]string{"env": "prod"},
+						}),
+						gen.AddIssuerCondition(cmapi.IssuerCondition{
+							Type:   cmapi.IssuerConditionReady,
+							Status: cmmeta.ConditionTrue,
+						}),
+					),
+				},
+				ExpectedEvents: []string{
+					`Warning NamespaceNotSelected Referenced ClusterIssuer may not be used from this namespace: namespace "default-unit-test-ns" is not selected by the namespaceSelector of the ClusterIssuer`,
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestIssuer(clusterIssuerRef),
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             "Failed",
+								Message:            `Referenced ClusterIssuer may not be used from this namespace: namespace "default-unit-test-ns" is not selected by the namespaceSelector of the ClusterIssuer`,
+								LastTransitionTime: &nowMetaTime,
+							}),
+							gen.SetCertificateRequestFailureTime(nowMetaTime),
+						),
+					)),
+				},
+			},
+		},
 		"if calling sign errors, we should not update condition and return error to retry": {
 			certificateRequest: gen.CertificateRequestFrom(baseCR),
 			issuerImpl: &fake.Issuer{
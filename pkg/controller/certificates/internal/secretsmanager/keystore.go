@@ -32,6 +32,7 @@ import (
 	"software.sslmate.com/src/go-pkcs12"
 
 	"github.com/jetstack/cert-manager/pkg/util/pki"
+	"github.com/jetstack/cert-manager/pkg/util/truststore"
 )
 
 const (
@@ -83,8 +84,7 @@ func encodePKCS12Truststore(password string, caPem []byte) ([]byte, error) {
 		return nil, err
 	}
 
-	var cas = []*x509.Certificate{ca}
-	return pkcs12.EncodeTrustStore(rand.Reader, cas, password)
+	return truststore.EncodePKCS12(password, []*x509.Certificate{ca})
 }
 
 func encodeJKSKeystore(password []byte, rawKey []byte, certPem []byte, caPem []byte) ([]byte, error) {
@@ -151,21 +151,5 @@ func encodeJKSTruststore(password []byte, caPem []byte) ([]byte, error) {
 		return nil, err
 	}
 
-	ks := jks.KeyStore{
-		"ca": &jks.TrustedCertificateEntry{
-			Entry: jks.Entry{
-				CreationDate: time.Now(),
-			},
-			Certificate: jks.Certificate{
-				Type:    "X509",
-				Content: ca.Raw,
-			},
-		},
-	}
-
-	buf := &bytes.Buffer{}
-	if err := jks.Encode(buf, ks, password); err != nil {
-		return nil, err
-	}
-	return buf.Bytes(), nil
+	return truststore.EncodeJKS(password, []*x509.Certificate{ca})
 }
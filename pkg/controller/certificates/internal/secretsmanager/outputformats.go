@@ -0,0 +1,113 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretsmanager
+
+import (
+	"bytes"
+	"encoding/pem"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+)
+
+const (
+	// combinedPEMSecretKey is the name of the data entry in the Secret
+	// resource used to store the private key followed by the certificate
+	// chain.
+	combinedPEMSecretKey = "tls-combined.pem"
+
+	// derKeySecretKey is the name of the data entry in the Secret resource
+	// used to store the DER encoded private key.
+	derKeySecretKey = "key.der"
+	// derCertSecretKey is the name of the data entry in the Secret resource
+	// used to store the DER encoded leaf certificate.
+	derCertSecretKey = "tls.der"
+
+	// fullChainPEMSecretKey is the name of the data entry in the Secret
+	// resource used to store the certificate chain followed by the CA.
+	fullChainPEMSecretKey = "fullchain.pem"
+)
+
+// setAdditionalOutputFormats writes the additional output formats requested
+// by the Certificate to the Secret, using the private key, certificate and CA
+// data already stored in it. Entries for formats that are not requested are
+// removed. The Secret's Data must be non-nil.
+func setAdditionalOutputFormats(crt *cmapi.Certificate, secret *corev1.Secret) error {
+	requested := make(map[cmapi.CertificateOutputFormatType]bool)
+	for _, f := range crt.Spec.AdditionalOutputFormats {
+		requested[f.Type] = true
+	}
+
+	pk := secret.Data[corev1.TLSPrivateKeyKey]
+	cert := secret.Data[corev1.TLSCertKey]
+	ca := secret.Data[cmmeta.TLSCAKey]
+	// Nothing can be written until both the private key and certificate exist
+	if len(pk) == 0 || len(cert) == 0 {
+		requested = nil
+	}
+
+	if requested[cmapi.CertificateOutputFormatCombinedPEM] {
+		secret.Data[combinedPEMSecretKey] = concatPEM(pk, cert)
+	} else {
+		delete(secret.Data, combinedPEMSecretKey)
+	}
+
+	if requested[cmapi.CertificateOutputFormatDER] {
+		keyBlock, _ := pem.Decode(pk)
+		if keyBlock == nil {
+			return fmt.Errorf("error encoding DER private key: failed to decode private key PEM")
+		}
+		certBlock, _ := pem.Decode(cert)
+		if certBlock == nil {
+			return fmt.Errorf("error encoding DER certificate: failed to decode certificate PEM")
+		}
+		secret.Data[derKeySecretKey] = keyBlock.Bytes
+		secret.Data[derCertSecretKey] = certBlock.Bytes
+	} else {
+		delete(secret.Data, derKeySecretKey)
+		delete(secret.Data, derCertSecretKey)
+	}
+
+	if requested[cmapi.CertificateOutputFormatFullChainPEM] {
+		// Some issuers already include the CA in the certificate chain, in
+		// which case it is not appended again.
+		if len(ca) > 0 && !bytes.Contains(cert, bytes.TrimSpace(ca)) {
+			secret.Data[fullChainPEMSecretKey] = concatPEM(cert, ca)
+		} else {
+			secret.Data[fullChainPEMSecretKey] = cert
+		}
+	} else {
+		delete(secret.Data, fullChainPEMSecretKey)
+	}
+
+	return nil
+}
+
+// concatPEM joins the given PEM data, ensuring each is separated by a newline.
+func concatPEM(pems ...[]byte) []byte {
+	var out []byte
+	for _, p := range pems {
+		if len(out) > 0 && out[len(out)-1] != '\n' {
+			out = append(out, '\n')
+		}
+		out = append(out, p...)
+	}
+	return out
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretsmanager
+
+import (
+	"bytes"
+	"crypto/x509"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	internaltest "github.com/jetstack/cert-manager/pkg/controller/certificates/internal/test"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+	"github.com/jetstack/cert-manager/test/unit/gen"
+)
+
+func TestSetAdditionalOutputFormats(t *testing.T) {
+	bundle := internaltest.MustCreateCryptoBundle(t, gen.Certificate("test",
+		gen.SetCertificateDNSNames("example.com"),
+		gen.SetCertificateSecretName("output"),
+	), fixedClock)
+	caBundle := internaltest.MustCreateCryptoBundle(t, gen.Certificate("ca",
+		gen.SetCertificateCommonName("ca"),
+		gen.SetCertificateIsCA(true),
+	), fixedClock)
+
+	withFormats := func(formats ...cmapi.CertificateOutputFormatType) *cmapi.Certificate {
+		crt := bundle.Certificate.DeepCopy()
+		for _, f := range formats {
+			crt.Spec.AdditionalOutputFormats = append(crt.Spec.AdditionalOutputFormats, cmapi.CertificateAdditionalOutputFormat{Type: f})
+		}
+		return crt
+	}
+	newSecret := func(ca []byte) *corev1.Secret {
+		return &corev1.Secret{Data: map[string][]byte{
+			corev1.TLSPrivateKeyKey: bundle.PrivateKeyBytes,
+			corev1.TLSCertKey:       bundle.CertBytes,
+			cmmeta.TLSCAKey:         ca,
+		}}
+	}
+
+	t.Run("all formats are written", func(t *testing.T) {
+		secret := newSecret(caBundle.CertBytes)
+		err := setAdditionalOutputFormats(withFormats(
+			cmapi.CertificateOutputFormatCombinedPEM,
+			cmapi.CertificateOutputFormatDER,
+			cmapi.CertificateOutputFormatFullChainPEM,
+		), secret)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		combined := secret.Data[combinedPEMSecretKey]
+		if _, err := pki.DecodePrivateKeyBytes(combined); err != nil {
+			t.Errorf("expected combined PEM to contain the private key: %v", err)
+		}
+		if !bytes.HasSuffix(combined, bundle.CertBytes) {
+			t.Errorf("expected combined PEM to end with the certificate chain")
+		}
+
+		if _, err := x509.ParsePKCS8PrivateKey(secret.Data[derKeySecretKey]); err != nil {
+			if _, err := x509.ParsePKCS1PrivateKey(secret.Data[derKeySecretKey]); err != nil {
+				t.Errorf("expected DER private key to be parsable: %v", err)
+			}
+		}
+		derCert, err := x509.ParseCertificate(secret.Data[derCertSecretKey])
+		if err != nil {
+			t.Fatalf("expected DER certificate to be parsable: %v", err)
+		}
+		if !derCert.Equal(bundle.Cert) {
+			t.Errorf("expected DER certificate to be the leaf certificate")
+		}
+
+		chain, err := pki.DecodeX509CertificateChainBytes(secret.Data[fullChainPEMSecretKey])
+		if err != nil {
+			t.Fatalf("expected full chain PEM to be parsable: %v", err)
+		}
+		if len(chain) != 2 || !chain[0].Equal(bundle.Cert) || !chain[1].Equal(caBundle.Cert) {
+			t.Errorf("expected full chain PEM to contain the certificate followed by the CA")
+		}
+	})
+
+	t.Run("CA already in chain is not appended again", func(t *testing.T) {
+		secret := newSecret(bundle.CertBytes)
+		if err := setAdditionalOutputFormats(withFormats(cmapi.CertificateOutputFormatFullChainPEM), secret); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(secret.Data[fullChainPEMSecretKey], bundle.CertBytes) {
+			t.Errorf("expected full chain PEM to equal the certificate chain")
+		}
+	})
+
+	t.Run("formats that are no longer requested are removed", func(t *testing.T) {
+		secret := newSecret(caBundle.CertBytes)
+		for _, k := range []string{combinedPEMSecretKey, derKeySecretKey, derCertSecretKey, fullChainPEMSecretKey} {
+			secret.Data[k] = []byte("old")
+		}
+		if err := setAdditionalOutputFormats(withFormats(cmapi.CertificateOutputFormatDER), secret); err != nil {
+			t.Fatal(err)
+		}
+		for _, k := range []string{combinedPEMSecretKey, fullChainPEMSecretKey} {
+			if _, ok := secret.Data[k]; ok {
+				t.Errorf("expected %q to be removed", k)
+			}
+		}
+		for _, k := range []string{derKeySecretKey, derCertSecretKey} {
+			if bytes.Equal(secret.Data[k], []byte("old")) {
+				t.Errorf("expected %q to be updated", k)
+			}
+		}
+	})
+
+	t.Run("nothing is written without a private key and certificate", func(t *testing.T) {
+		secret := &corev1.Secret{Data: map[string][]byte{}}
+		if err := setAdditionalOutputFormats(withFormats(cmapi.CertificateOutputFormatCombinedPEM), secret); err != nil {
+			t.Fatal(err)
+		}
+		if len(secret.Data) != 0 {
+			t.Errorf("expected no data to be written, got %v", secret.Data)
+		}
+	})
+}
@@ -23,14 +23,18 @@ import (
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes"
 	corelisters "k8s.io/client-go/listers/core/v1"
 
 	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
 	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	"github.com/jetstack/cert-manager/pkg/controller/certificates"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
 	utilpki "github.com/jetstack/cert-manager/pkg/util/pki"
 )
 
@@ -53,6 +57,17 @@ type SecretsManager struct {
 // SecretData is a structure wrapping private key, Certificate and CA data
 type SecretData struct {
 	PrivateKey, Certificate, CA []byte
+
+	// PrivateKeyProfiles is the private key and Certificate data issued for
+	// each of the Certificate's private key profiles.
+	PrivateKeyProfiles []PrivateKeyProfileData
+}
+
+// PrivateKeyProfileData is a structure wrapping the private key and
+// Certificate data issued for a single private key profile.
+type PrivateKeyProfileData struct {
+	Name                    string
+	PrivateKey, Certificate []byte
 }
 
 // New returns a new SecretsManager. Setting enableSecretOwnerReferences to
@@ -119,6 +134,112 @@ func (s *SecretsManager) UpdateData(ctx context.Context, crt *cmapi.Certificate,
 	return err
 }
 
+// ReconcileSecret ensures the existing Secret resource has the labels and
+// annotations in the Certificate's secretTemplate, and the additional output
+// formats requested by the Certificate, without changing the issued
+// certificate or private key. The Secret is not updated if it does not exist,
+// if it was not written by cert-manager for this Certificate, or if it is
+// already up to date.
+func (s *SecretsManager) ReconcileSecret(ctx context.Context, crt *cmapi.Certificate) error {
+	log := logf.FromContext(ctx)
+
+	existing, err := s.secretLister.Secrets(crt.Namespace).Get(crt.Spec.SecretName)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	log = logf.WithRelatedResource(log, existing)
+
+	// Never modify a Secret that cert-manager has not written for this
+	// Certificate, such as one created by a user or for another Certificate.
+	if existing.Annotations[cmapi.CertificateNameKey] != crt.Name {
+		log.V(logf.DebugLevel).Info("not reconciling secret as it was not issued for this certificate")
+		return nil
+	}
+
+	secret := existing.DeepCopy()
+	if secret.Annotations == nil {
+		secret.Annotations = make(map[string]string)
+	}
+	if secret.Data == nil {
+		secret.Data = make(map[string][]byte)
+	}
+	applySecretTemplate(crt.Spec.SecretTemplate, secret)
+	if err := setAdditionalOutputFormats(crt, secret); err != nil {
+		// The stored data is invalid, which the issuing path handles by
+		// re-issuing the certificate, so there is no point in retrying here.
+		log.Error(err, "not reconciling secret as its data could not be decoded")
+		return nil
+	}
+
+	if apiequality.Semantic.DeepEqual(existing.Labels, secret.Labels) &&
+		apiequality.Semantic.DeepEqual(existing.Annotations, secret.Annotations) &&
+		apiequality.Semantic.DeepEqual(existing.Data, secret.Data) {
+		return nil
+	}
+
+	_, err = s.kubeClient.CoreV1().Secrets(secret.Namespace).Update(ctx, secret, metav1.UpdateOptions{})
+	return err
+}
+
+// AdoptSecret takes over an existing Secret that was not created by
+// cert-manager, adding the metadata cert-manager sets on the Secrets it
+// manages without changing the certificate or private key it contains.
+func (s *SecretsManager) AdoptSecret(ctx context.Context, crt *cmapi.Certificate) error {
+	existing, err := s.secretLister.Secrets(crt.Namespace).Get(crt.Spec.SecretName)
+	if err != nil {
+		return err
+	}
+
+	secret := existing.DeepCopy()
+	if s.enableSecretOwnerReferences {
+		secret.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(crt, certificateGvk)}
+	}
+	if secret.Annotations == nil {
+		secret.Annotations = make(map[string]string)
+	}
+	if secret.Data == nil {
+		secret.Data = make(map[string][]byte)
+	}
+	applySecretTemplate(crt.Spec.SecretTemplate, secret)
+	if err := setAdditionalOutputFormats(crt, secret); err != nil {
+		return err
+	}
+	if err := setCertificateAnnotations(crt, secret, secret.Data[corev1.TLSCertKey]); err != nil {
+		return err
+	}
+
+	_, err = s.kubeClient.CoreV1().Secrets(secret.Namespace).Update(ctx, secret, metav1.UpdateOptions{})
+	return err
+}
+
+// applySecretTemplate copies the labels and annotations in the template to
+// the Secret. Annotations using the cert-manager.io prefix are never copied.
+// The Secret's annotations must be non-nil.
+func applySecretTemplate(tmpl *cmapi.CertificateSecretTemplate, secret *corev1.Secret) {
+	if tmpl == nil {
+		return
+	}
+	if len(tmpl.Labels) > 0 && secret.Labels == nil {
+		secret.Labels = make(map[string]string)
+	}
+	for k, v := range tmpl.Labels {
+		secret.Labels[k] = v
+	}
+	for k, v := range tmpl.Annotations {
+		if isCertManagerAnnotation(k) {
+			continue
+		}
+		secret.Annotations[k] = v
+	}
+}
+
+func isCertManagerAnnotation(key string) bool {
+	return strings.HasPrefix(key, "cert-manager.io/")
+}
+
 // setValues will update the Secret resource 'secret' with the data contained
 // in the given secretData.
 // It will update labels and annotations on the Secret resource appropriately.
@@ -211,24 +332,69 @@ func (s *SecretsManager) setValues(crt *cmapi.Certificate, secret *corev1.Secret
 	} else {
 		delete(secret.Data, cmmeta.TLSCAKey)
 	}
+	setPrivateKeyProfiles(secret, data.PrivateKeyProfiles)
+
+	if err := setAdditionalOutputFormats(crt, secret); err != nil {
+		return err
+	}
+
+	if secret.Annotations == nil {
+		secret.Annotations = make(map[string]string)
+	}
 
+	// Apply the secret template first, so that cert-manager's own annotations
+	// always take precedence.
+	applySecretTemplate(crt.Spec.SecretTemplate, secret)
+
+	return setCertificateAnnotations(crt, secret, data.Certificate)
+}
+
+// setPrivateKeyProfiles stores the private key and certificate of each of the
+// given private key profiles in the Secret, and removes those of any profiles
+// that are no longer present. The names of the stored profiles are recorded
+// in an annotation, so that only data written by cert-manager is removed.
+func setPrivateKeyProfiles(secret *corev1.Secret, profiles []PrivateKeyProfileData) {
+	names := sets.NewString()
+	for _, profile := range profiles {
+		names.Insert(profile.Name)
+		secret.Data[certificates.PrivateKeyProfilePrivateKeyKey(profile.Name)] = profile.PrivateKey
+		secret.Data[certificates.PrivateKeyProfileCertificateKey(profile.Name)] = profile.Certificate
+	}
+	for _, name := range strings.Split(secret.Annotations[cmapi.PrivateKeyProfilesAnnotationKey], ",") {
+		if len(name) == 0 || names.Has(name) {
+			continue
+		}
+		delete(secret.Data, certificates.PrivateKeyProfilePrivateKeyKey(name))
+		delete(secret.Data, certificates.PrivateKeyProfileCertificateKey(name))
+	}
+
+	if names.Len() == 0 {
+		delete(secret.Annotations, cmapi.PrivateKeyProfilesAnnotationKey)
+		return
+	}
 	if secret.Annotations == nil {
 		secret.Annotations = make(map[string]string)
 	}
+	secret.Annotations[cmapi.PrivateKeyProfilesAnnotationKey] = strings.Join(names.List(), ",")
+}
 
+// setCertificateAnnotations sets the annotations cert-manager adds to the
+// Secrets it manages, describing the Certificate and issuer the given
+// certificate data was issued for.
+func setCertificateAnnotations(crt *cmapi.Certificate, secret *corev1.Secret, certificate []byte) error {
 	secret.Annotations[cmapi.CertificateNameKey] = crt.Name
 	secret.Annotations[cmapi.IssuerNameAnnotationKey] = crt.Spec.IssuerRef.Name
 	secret.Annotations[cmapi.IssuerKindAnnotationKey] = apiutil.IssuerKind(crt.Spec.IssuerRef)
 	secret.Annotations[cmapi.IssuerGroupAnnotationKey] = crt.Spec.IssuerRef.Group
 
 	// if the certificate data is empty, clear the subject related annotations
-	if len(data.Certificate) == 0 {
+	if len(certificate) == 0 {
 		delete(secret.Annotations, cmapi.CommonNameAnnotationKey)
 		delete(secret.Annotations, cmapi.AltNamesAnnotationKey)
 		delete(secret.Annotations, cmapi.IPSANAnnotationKey)
 		delete(secret.Annotations, cmapi.URISANAnnotationKey)
 	} else {
-		x509Cert, err := utilpki.DecodeX509CertificateBytes(data.Certificate)
+		x509Cert, err := utilpki.DecodeX509CertificateBytes(certificate)
 		// TODO: handle InvalidData here?
 		if err != nil {
 			return err
@@ -18,6 +18,7 @@ package secretsmanager
 
 import (
 	"context"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -116,6 +117,56 @@ func TestSecretsManager(t *testing.T) {
 			expectedErr: false,
 		},
 
+		"if secret does not exist, create new Secret with secretTemplate labels and annotations": {
+			certificate: gen.CertificateFrom(exampleBundle.Certificate,
+				gen.SetCertificateSecretTemplate(map[string]string{
+					"example.com/backup":          "true",
+					cmapi.IssuerNameAnnotationKey: "not-the-issuer",
+				}, map[string]string{
+					"app": "example",
+				}),
+			),
+			SecretData: SecretData{Certificate: exampleBundle.CertBytes, CA: []byte("test-ca"), PrivateKey: []byte("test-key")},
+			builder: &testpkg.Builder{
+				KubeObjects: []runtime.Object{},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewCreateAction(
+						corev1.SchemeGroupVersion.WithResource("secrets"),
+						gen.DefaultTestNamespace,
+						&corev1.Secret{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: gen.DefaultTestNamespace,
+								Name:      "output",
+								Labels: map[string]string{
+									"app": "example",
+								},
+								Annotations: map[string]string{
+									"example.com/backup": "true",
+
+									cmapi.CertificateNameKey:       "test",
+									cmapi.IssuerGroupAnnotationKey: "foo.io",
+									cmapi.IssuerKindAnnotationKey:  "Issuer",
+									cmapi.IssuerNameAnnotationKey:  "ca-issuer",
+
+									cmapi.CommonNameAnnotationKey: exampleBundle.Cert.Subject.CommonName,
+									cmapi.AltNamesAnnotationKey:   strings.Join(exampleBundle.Cert.DNSNames, ","),
+									cmapi.IPSANAnnotationKey:      strings.Join(utilpki.IPAddressesToString(exampleBundle.Cert.IPAddresses), ","),
+									cmapi.URISANAnnotationKey:     strings.Join(utilpki.URLsToString(exampleBundle.Cert.URIs), ","),
+								},
+							},
+							Data: map[string][]byte{
+								corev1.TLSCertKey:       exampleBundle.CertBytes,
+								corev1.TLSPrivateKeyKey: []byte("test-key"),
+								cmmeta.TLSCAKey:         []byte("test-ca"),
+							},
+							Type: corev1.SecretTypeTLS,
+						},
+					)),
+				},
+			},
+			expectedErr: false,
+		},
+
 		"if secret does exist, update existing Secret and leave custom annotations, with owner enabled": {
 			certificate: exampleBundle.Certificate,
 			certificateOptions: controllerpkg.CertificateOptions{
@@ -309,3 +360,257 @@ func TestSecretsManager(t *testing.T) {
 		})
 	}
 }
+
+func TestSecretsManagerReconcileSecret(t *testing.T) {
+	baseCert := gen.Certificate("test",
+		gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "ca-issuer", Kind: "Issuer", Group: "foo.io"}),
+		gen.SetCertificateSecretName("output"),
+		gen.SetCertificateDNSNames("example.com"),
+	)
+	existingSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   gen.DefaultTestNamespace,
+			Name:        "output",
+			Labels:      map[string]string{"existing": "label"},
+			Annotations: map[string]string{cmapi.CertificateNameKey: "test"},
+		},
+		Data: map[string][]byte{corev1.TLSCertKey: []byte("foo")},
+		Type: corev1.SecretTypeTLS,
+	}
+
+	derCert := baseCert.DeepCopy()
+	derCert.Spec.AdditionalOutputFormats = []cmapi.CertificateAdditionalOutputFormat{{Type: cmapi.CertificateOutputFormatDER}}
+
+	tests := map[string]struct {
+		certificate     *cmapi.Certificate
+		existing        []runtime.Object
+		expectedActions []testpkg.Action
+	}{
+		"if no secretTemplate is set, do nothing": {
+			certificate: baseCert,
+			existing:    []runtime.Object{existingSecret},
+		},
+		"if the secret does not exist, do nothing": {
+			certificate: gen.CertificateFrom(baseCert, gen.SetCertificateSecretTemplate(nil, map[string]string{"app": "example"})),
+		},
+		"if the secret already matches the secretTemplate, do nothing": {
+			certificate: gen.CertificateFrom(baseCert, gen.SetCertificateSecretTemplate(nil, map[string]string{"existing": "label"})),
+			existing:    []runtime.Object{existingSecret},
+		},
+		"if additional output formats are no longer requested, remove them from the secret": {
+			certificate: baseCert,
+			existing: []runtime.Object{gen.SecretFrom(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: gen.DefaultTestNamespace, Name: "output", Annotations: map[string]string{cmapi.CertificateNameKey: "test"}},
+				Data: map[string][]byte{
+					corev1.TLSCertKey:     []byte("foo"),
+					combinedPEMSecretKey:  []byte("foo"),
+					fullChainPEMSecretKey: []byte("foo"),
+				},
+			})},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewUpdateAction(
+					corev1.SchemeGroupVersion.WithResource("secrets"),
+					gen.DefaultTestNamespace,
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{Namespace: gen.DefaultTestNamespace, Name: "output", Annotations: map[string]string{cmapi.CertificateNameKey: "test"}},
+						Data:       map[string][]byte{corev1.TLSCertKey: []byte("foo")},
+					},
+				)),
+			},
+		},
+		"if the secret was not written by cert-manager, do nothing": {
+			certificate: gen.CertificateFrom(baseCert, gen.SetCertificateSecretTemplate(nil, map[string]string{"app": "example"})),
+			existing: []runtime.Object{gen.SecretFrom(existingSecret,
+				gen.SetSecretAnnotations(nil),
+			)},
+		},
+		"if the secret was written for another certificate, do nothing": {
+			certificate: gen.CertificateFrom(baseCert, gen.SetCertificateSecretTemplate(nil, map[string]string{"app": "example"})),
+			existing: []runtime.Object{gen.SecretFrom(existingSecret,
+				gen.SetSecretAnnotations(map[string]string{cmapi.CertificateNameKey: "other"}),
+			)},
+		},
+		"if the secret data cannot be decoded for an additional output format, do nothing": {
+			certificate: derCert,
+			existing: []runtime.Object{gen.SecretFrom(existingSecret,
+				gen.SetSecretData(map[string][]byte{corev1.TLSCertKey: []byte("foo"), corev1.TLSPrivateKeyKey: []byte("bar")}),
+			)},
+		},
+		"if the secret does not match the secretTemplate, update only its metadata": {
+			certificate: gen.CertificateFrom(baseCert, gen.SetCertificateSecretTemplate(
+				map[string]string{"example.com/backup": "true"},
+				map[string]string{"existing": "changed", "app": "example"},
+			)),
+			existing: []runtime.Object{existingSecret},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewUpdateAction(
+					corev1.SchemeGroupVersion.WithResource("secrets"),
+					gen.DefaultTestNamespace,
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: gen.DefaultTestNamespace,
+							Name:      "output",
+							Labels:    map[string]string{"existing": "changed", "app": "example"},
+							Annotations: map[string]string{
+								cmapi.CertificateNameKey: "test",
+								"example.com/backup":     "true",
+							},
+						},
+						Data: map[string][]byte{corev1.TLSCertKey: []byte("foo")},
+						Type: corev1.SecretTypeTLS,
+					},
+				)),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{
+				T:               t,
+				Clock:           fixedClock,
+				KubeObjects:     test.existing,
+				ExpectedActions: test.expectedActions,
+			}
+			builder.Init()
+			defer builder.Stop()
+
+			testManager := New(
+				builder.Client,
+				builder.KubeSharedInformerFactory.Core().V1().Secrets().Lister(),
+				false,
+			)
+
+			builder.Start()
+
+			err := testManager.ReconcileSecret(context.Background(), test.certificate)
+			if err != nil {
+				t.Errorf("expected to not get an error, but got: %v", err)
+			}
+			builder.CheckAndFinish(err)
+		})
+	}
+}
+
+func TestSecretsManagerAdoptSecret(t *testing.T) {
+	baseCert := gen.Certificate("test",
+		gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "ca-issuer", Kind: "Issuer", Group: "foo.io"}),
+		gen.SetCertificateSecretName("output"),
+		gen.SetCertificateDNSNames("example.com"),
+	)
+	exampleBundle := internaltest.MustCreateCryptoBundle(t, baseCert, fixedClock)
+	existingSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   gen.DefaultTestNamespace,
+			Name:        "output",
+			Labels:      map[string]string{"existing": "label"},
+			Annotations: map[string]string{"existing": "annotation"},
+		},
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       exampleBundle.CertBytes,
+			corev1.TLSPrivateKeyKey: exampleBundle.PrivateKeyBytes,
+		},
+		Type: corev1.SecretTypeTLS,
+	}
+
+	builder := &testpkg.Builder{
+		T:           t,
+		Clock:       fixedClock,
+		KubeObjects: []runtime.Object{existingSecret},
+		ExpectedActions: []testpkg.Action{
+			testpkg.NewAction(coretesting.NewUpdateAction(
+				corev1.SchemeGroupVersion.WithResource("secrets"),
+				gen.DefaultTestNamespace,
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: gen.DefaultTestNamespace,
+						Name:      "output",
+						Labels:    map[string]string{"existing": "label"},
+						Annotations: map[string]string{
+							"existing":                     "annotation",
+							cmapi.CertificateNameKey:       "test",
+							cmapi.IssuerGroupAnnotationKey: "foo.io",
+							cmapi.IssuerKindAnnotationKey:  "Issuer",
+							cmapi.IssuerNameAnnotationKey:  "ca-issuer",
+
+							cmapi.CommonNameAnnotationKey: exampleBundle.Cert.Subject.CommonName,
+							cmapi.AltNamesAnnotationKey:   strings.Join(exampleBundle.Cert.DNSNames, ","),
+							cmapi.IPSANAnnotationKey:      strings.Join(utilpki.IPAddressesToString(exampleBundle.Cert.IPAddresses), ","),
+							cmapi.URISANAnnotationKey:     strings.Join(utilpki.URLsToString(exampleBundle.Cert.URIs), ","),
+						},
+						OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(baseCert, certificateGvk)},
+					},
+					Data: map[string][]byte{
+						corev1.TLSCertKey:       exampleBundle.CertBytes,
+						corev1.TLSPrivateKeyKey: exampleBundle.PrivateKeyBytes,
+					},
+					Type: corev1.SecretTypeTLS,
+				},
+			)),
+		},
+	}
+	builder.Init()
+	defer builder.Stop()
+
+	testManager := New(
+		builder.Client,
+		builder.KubeSharedInformerFactory.Core().V1().Secrets().Lister(),
+		true,
+	)
+
+	builder.Start()
+
+	err := testManager.AdoptSecret(context.Background(), baseCert)
+	if err != nil {
+		t.Errorf("expected to not get an error, but got: %v", err)
+	}
+	builder.CheckAndFinish(err)
+}
+
+func TestSetPrivateKeyProfiles(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+			cmapi.PrivateKeyProfilesAnnotationKey: "ecdsa,removed",
+		}},
+		Data: map[string][]byte{
+			corev1.TLSCertKey:  []byte("cert"),
+			"tls-ecdsa.crt":    []byte("old-ecdsa-cert"),
+			"tls-ecdsa.key":    []byte("old-ecdsa-key"),
+			"tls-removed.crt":  []byte("removed-cert"),
+			"tls-removed.key":  []byte("removed-key"),
+			"tls-user.crt":     []byte("user-cert"),
+			"tls-user.key":     []byte("user-key"),
+			"tls-combined.pem": []byte("combined"),
+		},
+	}
+
+	setPrivateKeyProfiles(secret, []PrivateKeyProfileData{
+		{Name: "rsa", PrivateKey: []byte("rsa-key"), Certificate: []byte("rsa-cert")},
+		{Name: "ecdsa", PrivateKey: []byte("ecdsa-key"), Certificate: []byte("ecdsa-cert")},
+	})
+
+	expected := map[string][]byte{
+		corev1.TLSCertKey:  []byte("cert"),
+		"tls-ecdsa.crt":    []byte("ecdsa-cert"),
+		"tls-ecdsa.key":    []byte("ecdsa-key"),
+		"tls-rsa.crt":      []byte("rsa-cert"),
+		"tls-rsa.key":      []byte("rsa-key"),
+		"tls-user.crt":     []byte("user-cert"),
+		"tls-user.key":     []byte("user-key"),
+		"tls-combined.pem": []byte("combined"),
+	}
+	if !reflect.DeepEqual(expected, secret.Data) {
+		t.Errorf("unexpected Secret data, exp=%v got=%v", expected, secret.Data)
+	}
+	if got := secret.Annotations[cmapi.PrivateKeyProfilesAnnotationKey]; got != "ecdsa,rsa" {
+		t.Errorf("unexpected %s annotation, exp=%q got=%q", cmapi.PrivateKeyProfilesAnnotationKey, "ecdsa,rsa", got)
+	}
+
+	setPrivateKeyProfiles(secret, nil)
+	if _, ok := secret.Data["tls-ecdsa.crt"]; ok {
+		t.Errorf("expected the data of removed private key profiles to be deleted")
+	}
+	if _, ok := secret.Annotations[cmapi.PrivateKeyProfilesAnnotationKey]; ok {
+		t.Errorf("expected the %s annotation to be removed", cmapi.PrivateKeyProfilesAnnotationKey)
+	}
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"crypto/x509"
+
+	corev1 "k8s.io/api/core/v1"
+
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+// IssuerCAFingerprint returns the fingerprint of the CA certificate that
+// signed the certificate stored in the given Secret, looking for it in the
+// certificate chain and the CA stored in the Secret. An empty string is
+// returned if the CA certificate cannot be found.
+func IssuerCAFingerprint(secret *corev1.Secret) string {
+	chain, err := pki.DecodeX509CertificateChainBytes(secret.Data[corev1.TLSCertKey])
+	if err != nil || len(chain) == 0 {
+		return ""
+	}
+
+	var candidates []*x509.Certificate
+	candidates = append(candidates, chain[1:]...)
+	if ca, err := pki.DecodeX509CertificateChainBytes(secret.Data[cmmeta.TLSCAKey]); err == nil {
+		candidates = append(candidates, ca...)
+	}
+
+	signer := pki.FindSigner(chain[0], candidates)
+	if signer == nil {
+		return ""
+	}
+	return pki.CertificateFingerprint(signer)
+}
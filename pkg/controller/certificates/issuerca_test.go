@@ -0,0 +1,96 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"crypto"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+func TestIssuerCAFingerprint(t *testing.T) {
+	sign := func(cn string, isCA bool, parent *x509.Certificate, parentKey crypto.Signer) ([]byte, *x509.Certificate, crypto.Signer) {
+		key, err := pki.GenerateECPrivateKey(256)
+		if err != nil {
+			t.Fatal(err)
+		}
+		template := &x509.Certificate{
+			SerialNumber:          big.NewInt(time.Now().UnixNano()),
+			Subject:               pkix.Name{CommonName: cn},
+			NotBefore:             time.Now(),
+			NotAfter:              time.Now().Add(time.Hour),
+			BasicConstraintsValid: true,
+			IsCA:                  isCA,
+		}
+		if parent == nil {
+			parent, parentKey = template, key
+		}
+		pem, cert, err := pki.SignCertificate(template, parent, key.Public(), parentKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return pem, cert, key
+	}
+
+	rootPEM, root, rootKey := sign("root", true, nil, nil)
+	intermediatePEM, intermediate, intermediateKey := sign("intermediate", true, root, rootKey)
+	leafPEM, _, _ := sign("leaf", false, intermediate, intermediateKey)
+
+	tests := map[string]struct {
+		data     map[string][]byte
+		expected string
+	}{
+		"signer in the certificate chain": {
+			data: map[string][]byte{
+				corev1.TLSCertKey: append(append([]byte{}, leafPEM...), intermediatePEM...),
+				cmmeta.TLSCAKey:   rootPEM,
+			},
+			expected: pki.CertificateFingerprint(intermediate),
+		},
+		"signer in the CA": {
+			data: map[string][]byte{
+				corev1.TLSCertKey: leafPEM,
+				cmmeta.TLSCAKey:   intermediatePEM,
+			},
+			expected: pki.CertificateFingerprint(intermediate),
+		},
+		"signer not in the Secret": {
+			data: map[string][]byte{
+				corev1.TLSCertKey: leafPEM,
+				cmmeta.TLSCAKey:   rootPEM,
+			},
+		},
+		"invalid certificate": {
+			data: map[string][]byte{corev1.TLSCertKey: []byte("invalid")},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if fp := IssuerCAFingerprint(&corev1.Secret{Data: test.data}); fp != test.expected {
+				t.Errorf("expected fingerprint %q, got %q", test.expected, fp)
+			}
+		})
+	}
+}
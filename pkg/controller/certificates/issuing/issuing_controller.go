@@ -66,12 +66,19 @@ type controller struct {
 	recorder                 record.EventRecorder
 	clock                    clock.Clock
 
-	client cmclient.Interface
+	client     cmclient.Interface
+	kubeClient kubernetes.Interface
 
 	// secretManager is used to create and update Secrets with certificate and key data
 	secretsManager *secretsmanager.SecretsManager
 	// localTemporarySigner signs a certificate that is stored temporarily
 	localTemporarySigner localTemporarySignerFn
+
+	// additionalSecretTargetNamespaceSelector selects the namespaces, other
+	// than their own, that Certificates may copy their Secret to. If nil,
+	// copies to other namespaces are disabled and namespaceLister is not set.
+	additionalSecretTargetNamespaceSelector labels.Selector
+	namespaceLister                         corelisters.NamespaceLister
 }
 
 func NewController(
@@ -108,6 +115,11 @@ func NewController(
 		WorkFunc: certificates.EnqueueCertificatesForResourceUsingPredicates(log, queue, certificateInformer.Lister(), labels.Everything(),
 			predicate.ExtractResourceName(predicate.CertificateSecretName)),
 	})
+	secretsInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		// Issuer reconciles on changes to copies of the Secret written to
+		// `spec.additionalSecretTargets`
+		WorkFunc: enqueueCertificateForAdditionalSecretTarget(log, queue),
+	})
 
 	// build a list of InformerSynced functions that will be returned by the Register method.
 	// the controller will only begin processing items once all of these informers have synced.
@@ -117,6 +129,13 @@ func NewController(
 		certificateInformer.Informer().HasSynced,
 	}
 
+	var namespaceLister corelisters.NamespaceLister
+	if certificateControllerOptions.AdditionalSecretTargetNamespaceSelector != nil {
+		namespaceInformer := factory.Core().V1().Namespaces()
+		namespaceLister = namespaceInformer.Lister()
+		mustSync = append(mustSync, namespaceInformer.Informer().HasSynced)
+	}
+
 	secretsManager := secretsmanager.New(
 		kubeClient,
 		secretsInformer.Lister(),
@@ -127,14 +146,39 @@ func NewController(
 		certificateLister:        certificateInformer.Lister(),
 		certificateRequestLister: certificateRequestInformer.Lister(),
 		secretLister:             secretsInformer.Lister(),
+		namespaceLister:          namespaceLister,
 		client:                   client,
+		kubeClient:               kubeClient,
 		recorder:                 recorder,
 		clock:                    clock,
 		secretsManager:           secretsManager,
 		localTemporarySigner:     certificates.GenerateLocallySignedTemporaryCertificate,
+
+		additionalSecretTargetNamespaceSelector: certificateControllerOptions.AdditionalSecretTargetNamespaceSelector,
 	}, queue, mustSync
 }
 
+// secretConflict returns true if the Certificate's spec.secretName is
+// managed by another Certificate, either because the Certificate has been
+// marked as conflicting with another Certificate for the same secretName, or
+// because the Secret was written for another Certificate.
+func (c *controller) secretConflict(crt *cmapi.Certificate) (bool, error) {
+	if cond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionIssuing); cond != nil &&
+		cond.Status == cmmeta.ConditionFalse && cond.Reason == certificates.SecretConflictReason {
+		return true, nil
+	}
+
+	secret, err := c.secretLister.Secrets(crt.Namespace).Get(crt.Spec.SecretName)
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	name, ok := secret.Annotations[cmapi.CertificateNameKey]
+	return ok && name != crt.Name, nil
+}
+
 func (c *controller) ProcessItem(ctx context.Context, key string) error {
 	// Set context deadline for full sync in 10 seconds
 	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
@@ -149,8 +193,10 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 
 	crt, err := c.certificateLister.Certificates(namespace).Get(name)
 	if apierrors.IsNotFound(err) {
-		log.Error(err, "certificate not found for key")
-		return nil
+		log.V(logf.DebugLevel).Info("certificate not found for key, deleting copies of its Secret")
+		// The copies of the Secret of a deleted Certificate are not owned by
+		// it, so are not garbage collected and must be deleted here.
+		return c.deleteAdditionalSecretTargets(ctx, key, nil)
 	}
 	if err != nil {
 		return err
@@ -163,8 +209,20 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 		Type:   cmapi.CertificateConditionIssuing,
 		Status: cmmeta.ConditionTrue,
 	}) {
-		// Do nothing if an issuance is not in progress.
-		return nil
+		// If an issuance is not in progress, only ensure the Secret has the
+		// labels and annotations from the secretTemplate and the additional
+		// output formats, so that changes to these are applied without
+		// waiting for the next issuance, and that its copies are up to date.
+		// Nothing is done if the Secret belongs to another Certificate, so
+		// that Certificates sharing a secretName do not fight over it.
+		conflict, err := c.secretConflict(crt)
+		if err != nil || conflict {
+			return err
+		}
+		if err := c.secretsManager.ReconcileSecret(ctx, crt); err != nil {
+			return err
+		}
+		return c.syncAdditionalSecretTargets(ctx, crt)
 	}
 
 	if crt.Status.NextPrivateKeySecretName == nil ||
@@ -213,6 +271,7 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 		labels.Everything(),
 		predicate.CertificateRequestRevision(nextRevision),
 		predicate.ResourceOwnedBy(crt),
+		predicate.CertificateRequestPrivateKeyProfile(""),
 	)
 	if err != nil || len(reqs) != 1 {
 		// If error return.
@@ -262,6 +321,16 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 		return c.failIssueCertificate(ctx, log, crt, apiutil.GetCertificateRequestCondition(req, cmapi.CertificateRequestConditionReady))
 	}
 
+	// The Certificate cannot be issued if the CertificateRequest of any of its
+	// private key profiles has failed.
+	profileReqs, err := c.privateKeyProfileRequests(crt, nextRevision)
+	if err != nil {
+		return err
+	}
+	if failedCond := failedPrivateKeyProfileCondition(crt, profileReqs); failedCond != nil {
+		return c.failIssueCertificate(ctx, log, crt, failedCond)
+	}
+
 	// If public key does not match, do nothing (requestmanager will handle this).
 	csr, err := utilpki.DecodeX509CertificateRequestBytes(req.Spec.Request)
 	if err != nil {
@@ -279,7 +348,11 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 	// If the CertificateRequest is valid and ready, verify its status and issue
 	// accordingly.
 	if cond.Reason == cmapi.CertificateRequestReasonIssued {
-		return c.issueCertificate(ctx, nextRevision, crt, req, pk)
+		profilesData, ok, err := privateKeyProfilesData(log, crt, nextPrivateKeySecret, profileReqs)
+		if err != nil || !ok {
+			return err
+		}
+		return c.issueCertificate(ctx, nextRevision, crt, req, pk, profilesData)
 	}
 
 	// Issue temporary certificate if needed. If a certificate was issued, then
@@ -298,17 +371,23 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 // failed, and log an appropriate event. The reason and message of the
 // condition will be that of the CertificateRequest condition passed.
 func (c *controller) failIssueCertificate(ctx context.Context, log logr.Logger, crt *cmapi.Certificate, condition *cmapi.CertificateRequestCondition) error {
+	crt = crt.DeepCopy()
 	nowTime := metav1.NewTime(c.clock.Now())
 	crt.Status.LastFailureTime = &nowTime
 
-	log.V(logf.DebugLevel).Info("CertificateRequest in failed state so retrying issuance later")
+	failedIssuanceAttempts := 1
+	if crt.Status.FailedIssuanceAttempts != nil {
+		failedIssuanceAttempts = *crt.Status.FailedIssuanceAttempts + 1
+	}
+	crt.Status.FailedIssuanceAttempts = &failedIssuanceAttempts
+
+	log.V(logf.DebugLevel).Info("CertificateRequest in failed state so retrying issuance later", "failed_issuance_attempts", failedIssuanceAttempts)
 
 	var reason, message string
 	reason = condition.Reason
 	message = fmt.Sprintf("The certificate request has failed to complete and will be retried: %s",
 		condition.Message)
 
-	crt = crt.DeepCopy()
 	apiutil.SetCertificateCondition(crt, crt.Generation, cmapi.CertificateConditionIssuing, cmmeta.ConditionFalse, reason, message)
 
 	_, err := c.client.CertmanagerV1().Certificates(crt.Namespace).UpdateStatus(ctx, crt, metav1.UpdateOptions{})
@@ -324,7 +403,7 @@ func (c *controller) failIssueCertificate(ctx context.Context, log logr.Logger,
 // issueCertificate will ensure the public key of the CSR matches the signed
 // certificate, and then store the certificate, CA and private key into the
 // Secret in the appropriate format type.
-func (c *controller) issueCertificate(ctx context.Context, nextRevision int, crt *cmapi.Certificate, req *cmapi.CertificateRequest, pk crypto.Signer) error {
+func (c *controller) issueCertificate(ctx context.Context, nextRevision int, crt *cmapi.Certificate, req *cmapi.CertificateRequest, pk crypto.Signer, profilesData []secretsmanager.PrivateKeyProfileData) error {
 	crt = crt.DeepCopy()
 	if crt.Spec.PrivateKey == nil {
 		crt.Spec.PrivateKey = &cmapi.CertificatePrivateKey{}
@@ -338,6 +417,8 @@ func (c *controller) issueCertificate(ctx context.Context, nextRevision int, crt
 		PrivateKey:  pkData,
 		Certificate: req.Status.Certificate,
 		CA:          req.Status.CA,
+
+		PrivateKeyProfiles: profilesData,
 	}
 
 	err = c.secretsManager.UpdateData(ctx, crt, secretData)
@@ -353,6 +434,7 @@ func (c *controller) issueCertificate(ctx context.Context, nextRevision int, crt
 
 	//Clear status.lastFailureTime (if set)
 	crt.Status.LastFailureTime = nil
+	crt.Status.FailedIssuanceAttempts = nil
 
 	_, err = c.client.CertmanagerV1().Certificates(crt.Namespace).UpdateStatus(ctx, crt, metav1.UpdateOptions{})
 	if err != nil {
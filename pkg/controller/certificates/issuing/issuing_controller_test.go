@@ -82,6 +82,14 @@ func TestIssuingController(t *testing.T) {
 
 	metaFixedClockStart := metav1.NewTime(fixedClockStart)
 
+	// sharingCert shares its secretName with another Certificate, and would
+	// update the Secret and copy it to another namespace if it owned it.
+	sharingCert := gen.CertificateFrom(baseCert.DeepCopy(),
+		gen.SetCertificateSecretTemplate(map[string]string{"example.com/backup": "true"}, map[string]string{"app": "example"}),
+	)
+	sharingCert.Spec.AdditionalOutputFormats = []cmapi.CertificateAdditionalOutputFormat{{Type: cmapi.CertificateOutputFormatCombinedPEM}}
+	sharingCert.Spec.AdditionalSecretTargets = []cmapi.CertificateSecretTarget{{Namespace: "shared", Name: "output"}}
+
 	tests := map[string]testT{
 		"if certificate is not in Issuing state, then do nothing": {
 			certificate: exampleBundle.Certificate,
@@ -95,6 +103,97 @@ func TestIssuingController(t *testing.T) {
 			expectedErr: false,
 		},
 
+		"if certificate is not in Issuing state, but the Secret does not match the secretTemplate, update the Secret metadata": {
+			certificate: exampleBundle.Certificate,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{
+					gen.CertificateFrom(baseCert.DeepCopy(),
+						gen.SetCertificateSecretTemplate(map[string]string{"example.com/backup": "true"}, map[string]string{"app": "example"}),
+					),
+				},
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: gen.DefaultTestNamespace, Name: "output",
+							Annotations: map[string]string{"my-custom": "annotation", cmapi.CertificateNameKey: baseCert.Name},
+						},
+						Data: map[string][]byte{corev1.TLSCertKey: exampleBundle.CertBytes},
+						Type: corev1.SecretTypeTLS,
+					},
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateAction(
+						corev1.SchemeGroupVersion.WithResource("secrets"),
+						gen.DefaultTestNamespace,
+						&corev1.Secret{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: gen.DefaultTestNamespace, Name: "output",
+								Labels: map[string]string{"app": "example"},
+								Annotations: map[string]string{
+									"my-custom":              "annotation",
+									cmapi.CertificateNameKey: baseCert.Name,
+									"example.com/backup":     "true",
+								},
+							},
+							Data: map[string][]byte{corev1.TLSCertKey: exampleBundle.CertBytes},
+							Type: corev1.SecretTypeTLS,
+						},
+					)),
+				},
+			},
+			expectedErr: false,
+		},
+
+		"if certificate shares its secretName with another Certificate that the Secret was written for, do not modify the Secret or its copies": {
+			certificate: exampleBundle.Certificate,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{
+					sharingCert.DeepCopy(),
+					gen.Certificate("other", gen.SetCertificateSecretName("output")),
+				},
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: gen.DefaultTestNamespace, Name: "output",
+							Annotations: map[string]string{cmapi.CertificateNameKey: "other"},
+						},
+						Data: map[string][]byte{corev1.TLSCertKey: exampleBundle.CertBytes, corev1.TLSPrivateKeyKey: exampleBundle.PrivateKeyBytes},
+						Type: corev1.SecretTypeTLS,
+					},
+				},
+				ExpectedActions: []testpkg.Action{},
+			},
+			expectedErr: false,
+		},
+
+		"if certificate is not issuing because its secretName conflicts with another Certificate, do not modify the Secret or its copies": {
+			certificate: exampleBundle.Certificate,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{
+					gen.CertificateFrom(sharingCert.DeepCopy(),
+						gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+							Type:   cmapi.CertificateConditionIssuing,
+							Status: cmmeta.ConditionFalse,
+							Reason: "SecretConflict",
+						}),
+					),
+					gen.Certificate("other", gen.SetCertificateSecretName("output")),
+				},
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: gen.DefaultTestNamespace, Name: "output",
+							Annotations: map[string]string{cmapi.CertificateNameKey: baseCert.Name},
+						},
+						Data: map[string][]byte{corev1.TLSCertKey: exampleBundle.CertBytes, corev1.TLSPrivateKeyKey: exampleBundle.PrivateKeyBytes},
+						Type: corev1.SecretTypeTLS,
+					},
+				},
+				ExpectedActions: []testpkg.Action{},
+			},
+			expectedErr: false,
+		},
+
 		"if certificate is an Issuing state but is set to False, then do nothing": {
 			certificate: exampleBundle.Certificate,
 			builder: &testpkg.Builder{
@@ -256,6 +355,7 @@ func TestIssuingController(t *testing.T) {
 								ObservedGeneration: 3,
 							}),
 							gen.SetCertificateLastFailureTime(metaFixedClockStart),
+							gen.SetCertificateFailedIssuanceAttempts(1),
 						),
 					)),
 				},
@@ -930,6 +1030,7 @@ func TestIssuingController(t *testing.T) {
 								ObservedGeneration: 3,
 							}),
 							gen.SetCertificateLastFailureTime(metaFixedClockStart),
+							gen.SetCertificateFailedIssuanceAttempts(1),
 						),
 					)),
 				},
@@ -981,6 +1082,7 @@ func TestIssuingController(t *testing.T) {
 								ObservedGeneration: 3,
 							}),
 							gen.SetCertificateLastFailureTime(metaFixedClockStart),
+							gen.SetCertificateFailedIssuanceAttempts(1),
 						),
 					)),
 				},
@@ -0,0 +1,156 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuing
+
+import (
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/jetstack/cert-manager/pkg/controller/certificates"
+	"github.com/jetstack/cert-manager/pkg/controller/certificates/internal/secretsmanager"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+	utilpki "github.com/jetstack/cert-manager/pkg/util/pki"
+	"github.com/jetstack/cert-manager/pkg/util/predicate"
+)
+
+// privateKeyProfileRequests returns the CertificateRequest for the next
+// revision of each of the Certificate's private key profiles, keyed by the
+// name of the profile. Profiles that do not have exactly one
+// CertificateRequest matching the profile are omitted, as the requestmanager
+// controller will handle these.
+func (c *controller) privateKeyProfileRequests(crt *cmapi.Certificate, nextRevision int) (map[string]*cmapi.CertificateRequest, error) {
+	if len(crt.Spec.PrivateKeyProfiles) == 0 {
+		return nil, nil
+	}
+
+	reqs := make(map[string]*cmapi.CertificateRequest)
+	for _, profile := range crt.Spec.PrivateKeyProfiles {
+		profileReqs, err := certificates.ListCertificateRequestsMatchingPredicates(c.certificateRequestLister.CertificateRequests(crt.Namespace),
+			labels.Everything(),
+			predicate.CertificateRequestRevision(nextRevision),
+			predicate.ResourceOwnedBy(crt),
+			predicate.CertificateRequestPrivateKeyProfile(profile.Name),
+		)
+		if err != nil {
+			return nil, err
+		}
+		if len(profileReqs) != 1 {
+			continue
+		}
+
+		profileCrt := certificates.CertificateForPrivateKeyProfile(crt, profile)
+		violations, err := certificates.RequestMatchesSpec(profileReqs[0], profileCrt.Spec)
+		if err != nil {
+			return nil, err
+		}
+		if len(violations) > 0 {
+			continue
+		}
+		reqs[profile.Name] = profileReqs[0]
+	}
+
+	return reqs, nil
+}
+
+// failedPrivateKeyProfileCondition returns the condition of the first of the
+// given private key profile CertificateRequests that has failed or been
+// denied, with its message prefixed by the name of the profile. It returns
+// nil if none of them have failed.
+func failedPrivateKeyProfileCondition(crt *cmapi.Certificate, reqs map[string]*cmapi.CertificateRequest) *cmapi.CertificateRequestCondition {
+	for _, profile := range crt.Spec.PrivateKeyProfiles {
+		req, ok := reqs[profile.Name]
+		if !ok {
+			continue
+		}
+
+		var failedCond *cmapi.CertificateRequestCondition
+		cond := apiutil.GetCertificateRequestCondition(req, cmapi.CertificateRequestConditionReady)
+		switch {
+		case cond == nil && apiutil.CertificateRequestIsDenied(req):
+			failedCond = apiutil.GetCertificateRequestCondition(req, cmapi.CertificateRequestConditionDenied)
+		case cond != nil && cond.Reason == cmapi.CertificateRequestReasonFailed:
+			failedCond = cond
+		default:
+			continue
+		}
+
+		failedCond = failedCond.DeepCopy()
+		failedCond.Message = fmt.Sprintf("Private key profile %q: %s", profile.Name, failedCond.Message)
+		return failedCond
+	}
+
+	return nil
+}
+
+// privateKeyProfilesData returns the encoded private key and signed
+// certificate of each of the Certificate's private key profiles. The second
+// return argument is false if the certificate of any of the profiles has not
+// yet been issued for its next private key, in which case the Certificate
+// should not be issued yet.
+func privateKeyProfilesData(log logr.Logger, crt *cmapi.Certificate, nextPrivateKeySecret *corev1.Secret, reqs map[string]*cmapi.CertificateRequest) ([]secretsmanager.PrivateKeyProfileData, bool, error) {
+	var data []secretsmanager.PrivateKeyProfileData
+	for _, profile := range crt.Spec.PrivateKeyProfiles {
+		log := log.WithValues("private_key_profile", profile.Name)
+
+		req, ok := reqs[profile.Name]
+		if !ok {
+			log.V(logf.DebugLevel).Info("No up to date CertificateRequest exists for private key profile, waiting for requestmanager controller")
+			return nil, false, nil
+		}
+		log = logf.WithRelatedResource(log, req)
+		cond := apiutil.GetCertificateRequestCondition(req, cmapi.CertificateRequestConditionReady)
+		if cond == nil || cond.Reason != cmapi.CertificateRequestReasonIssued {
+			log.V(logf.DebugLevel).Info("CertificateRequest for private key profile not in final state, waiting...")
+			return nil, false, nil
+		}
+
+		pk, err := utilpki.DecodePrivateKeyBytes(nextPrivateKeySecret.Data[certificates.PrivateKeyProfilePrivateKeyKey(profile.Name)])
+		if err != nil {
+			log.V(logf.DebugLevel).Info("Failed to decode next private key of private key profile, waiting for keymanager controller", "error", err.Error())
+			return nil, false, nil
+		}
+		csr, err := utilpki.DecodeX509CertificateRequestBytes(req.Spec.Request)
+		if err != nil {
+			return nil, false, err
+		}
+		publicKeyMatchesCSR, err := utilpki.PublicKeyMatchesCSR(pk.Public(), csr)
+		if err != nil {
+			return nil, false, err
+		}
+		if !publicKeyMatchesCSR {
+			log.Info("next private key of private key profile does not match CSR public key, waiting for requestmanager controller")
+			return nil, false, nil
+		}
+
+		pkData, err := utilpki.EncodePrivateKey(pk, profile.PrivateKey.Encoding)
+		if err != nil {
+			return nil, false, err
+		}
+		data = append(data, secretsmanager.PrivateKeyProfileData{
+			Name:        profile.Name,
+			PrivateKey:  pkData,
+			Certificate: req.Status.Certificate,
+		})
+	}
+
+	return data, true, nil
+}
@@ -0,0 +1,220 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuing
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/util/workqueue"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+)
+
+const (
+	reasonSecretTargetNotAllowed = "SecretTargetNotAllowed"
+	reasonSecretTargetConflict   = "SecretTargetConflict"
+)
+
+// syncAdditionalSecretTargets ensures that each of the Certificate's
+// additional secret targets is a copy of the Certificate's Secret, and
+// deletes the copies of targets that have been removed from the Certificate
+// or whose namespace is no longer allowed. Nothing is copied until the
+// Certificate's Secret exists.
+func (c *controller) syncAdditionalSecretTargets(ctx context.Context, crt *cmapi.Certificate) error {
+	log := logf.FromContext(ctx)
+
+	crtKey, err := controllerpkg.KeyFunc(crt)
+	if err != nil {
+		return err
+	}
+	var secret *corev1.Secret
+	if len(crt.Spec.AdditionalSecretTargets) > 0 {
+		secret, err = c.secretLister.Secrets(crt.Namespace).Get(crt.Spec.SecretName)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	var errs []error
+	targets := make(map[types.NamespacedName]bool)
+	for _, target := range crt.Spec.AdditionalSecretTargets {
+		targetKey := types.NamespacedName{Namespace: target.Namespace, Name: target.Name}
+		if target.Namespace == crt.Namespace && target.Name == crt.Spec.SecretName {
+			log.V(logf.DebugLevel).Info("Skipping additional secret target as it is the Certificate's Secret", "target", targetKey.String())
+			continue
+		}
+
+		allowed, err := c.additionalSecretTargetAllowed(crt, target.Namespace)
+		if err != nil {
+			return err
+		}
+		if !allowed {
+			c.recorder.Eventf(crt, corev1.EventTypeWarning, reasonSecretTargetNotAllowed,
+				"Not copying Secret to %q as namespace %q is not selected by the controller's additional secret target namespace selector", targetKey.String(), target.Namespace)
+			continue
+		}
+		targets[targetKey] = true
+
+		if secret == nil {
+			continue
+		}
+		if err := c.syncAdditionalSecretTarget(ctx, crt, crtKey, secret, target); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if err := c.deleteAdditionalSecretTargets(ctx, crtKey, targets); err != nil {
+		errs = append(errs, err)
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// deleteAdditionalSecretTargets deletes the copies of the Secret of the
+// Certificate with the given key, except for those of the given targets. It
+// is called with no targets once the Certificate has been deleted, so that
+// its copies are not left behind.
+func (c *controller) deleteAdditionalSecretTargets(ctx context.Context, crtKey string, targets map[types.NamespacedName]bool) error {
+	log := logf.FromContext(ctx)
+
+	copies, err := c.secretLister.List(labels.SelectorFromSet(labels.Set{cmapi.IsAdditionalSecretTargetLabelKey: "true"}))
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, s := range copies {
+		if s.Annotations[cmapi.AdditionalSecretTargetOfAnnotationKey] != crtKey ||
+			targets[types.NamespacedName{Namespace: s.Namespace, Name: s.Name}] {
+			continue
+		}
+		logf.WithRelatedResource(log, s).V(logf.DebugLevel).Info("Deleting copy of Secret as it is no longer an additional secret target of the Certificate")
+		err := c.kubeClient.CoreV1().Secrets(s.Namespace).Delete(ctx, s.Name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, err)
+		}
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// additionalSecretTargetAllowed returns true if the Certificate may copy its
+// Secret to the given namespace. Copies to the Certificate's own namespace are
+// always allowed.
+func (c *controller) additionalSecretTargetAllowed(crt *cmapi.Certificate, namespace string) (bool, error) {
+	if namespace == crt.Namespace {
+		return true, nil
+	}
+	if c.additionalSecretTargetNamespaceSelector == nil {
+		return false, nil
+	}
+	ns, err := c.namespaceLister.Get(namespace)
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return c.additionalSecretTargetNamespaceSelector.Matches(labels.Set(ns.Labels)), nil
+}
+
+// syncAdditionalSecretTarget creates or updates the copy of the Certificate's
+// Secret for a single target. Existing Secrets that are not a copy of this
+// Certificate's Secret are never overwritten.
+func (c *controller) syncAdditionalSecretTarget(ctx context.Context, crt *cmapi.Certificate, crtKey string, secret *corev1.Secret, target cmapi.CertificateSecretTarget) error {
+	existing, err := c.secretLister.Secrets(target.Namespace).Get(target.Name)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	secretLabels := make(map[string]string, len(secret.Labels)+1)
+	for k, v := range secret.Labels {
+		secretLabels[k] = v
+	}
+	secretLabels[cmapi.IsAdditionalSecretTargetLabelKey] = "true"
+	secretAnnotations := make(map[string]string, len(secret.Annotations)+1)
+	for k, v := range secret.Annotations {
+		secretAnnotations[k] = v
+	}
+	secretAnnotations[cmapi.AdditionalSecretTargetOfAnnotationKey] = crtKey
+
+	if existing == nil {
+		targetSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   target.Namespace,
+				Name:        target.Name,
+				Labels:      secretLabels,
+				Annotations: secretAnnotations,
+			},
+			Data: secret.Data,
+			Type: secret.Type,
+		}
+		_, err := c.kubeClient.CoreV1().Secrets(target.Namespace).Create(ctx, targetSecret, metav1.CreateOptions{})
+		return err
+	}
+
+	if existing.Annotations[cmapi.AdditionalSecretTargetOfAnnotationKey] != crtKey {
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, reasonSecretTargetConflict,
+			"Not copying Secret to %s/%s as a Secret that is not a copy of this Certificate's Secret already exists", target.Namespace, target.Name)
+		return nil
+	}
+
+	if apiequality.Semantic.DeepEqual(existing.Labels, secretLabels) &&
+		apiequality.Semantic.DeepEqual(existing.Annotations, secretAnnotations) &&
+		apiequality.Semantic.DeepEqual(existing.Data, secret.Data) {
+		return nil
+	}
+
+	existing = existing.DeepCopy()
+	existing.Labels = secretLabels
+	existing.Annotations = secretAnnotations
+	existing.Data = secret.Data
+	_, err = c.kubeClient.CoreV1().Secrets(target.Namespace).Update(ctx, existing, metav1.UpdateOptions{})
+	return err
+}
+
+// enqueueCertificateForAdditionalSecretTarget returns a function that, when
+// given a Secret that is a copy of a Certificate's Secret, enqueues that
+// Certificate so that changes to the copy are reverted.
+func enqueueCertificateForAdditionalSecretTarget(log logr.Logger, queue workqueue.Interface) func(obj interface{}) {
+	return func(obj interface{}) {
+		secret, ok := obj.(metav1.Object)
+		if !ok {
+			log.V(logf.ErrorLevel).Info("Non-Object type resource passed to enqueueCertificateForAdditionalSecretTarget")
+			return
+		}
+		if secret.GetLabels()[cmapi.IsAdditionalSecretTargetLabelKey] != "true" {
+			return
+		}
+		key := secret.GetAnnotations()[cmapi.AdditionalSecretTargetOfAnnotationKey]
+		if len(key) == 0 {
+			logf.WithResource(log, secret).V(logf.DebugLevel).Info("copy of Secret is missing the annotation naming its Certificate")
+			return
+		}
+		queue.Add(key)
+	}
+}
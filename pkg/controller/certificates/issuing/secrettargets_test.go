@@ -0,0 +1,135 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	testpkg "github.com/jetstack/cert-manager/pkg/controller/test"
+	"github.com/jetstack/cert-manager/test/unit/gen"
+)
+
+func TestSyncAdditionalSecretTargets(t *testing.T) {
+	crt := gen.Certificate("test",
+		gen.SetCertificateNamespace(gen.DefaultTestNamespace),
+		gen.SetCertificateSecretName("output"),
+	)
+	crt.Spec.AdditionalSecretTargets = []cmapi.CertificateSecretTarget{
+		{Namespace: "shared", Name: "output"},
+		{Namespace: "private", Name: "output"},
+		{Namespace: "shared", Name: "conflict"},
+	}
+	copyOf := func(namespace, name string, data map[string][]byte) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   namespace,
+				Name:        name,
+				Labels:      map[string]string{cmapi.IsAdditionalSecretTargetLabelKey: "true"},
+				Annotations: map[string]string{"existing": "annotation", cmapi.AdditionalSecretTargetOfAnnotationKey: gen.DefaultTestNamespace + "/test"},
+			},
+			Data: data,
+			Type: corev1.SecretTypeTLS,
+		}
+	}
+	data := map[string][]byte{corev1.TLSCertKey: []byte("cert"), corev1.TLSPrivateKeyKey: []byte("key")}
+
+	builder := &testpkg.Builder{
+		T: t,
+		KubeObjects: []runtime.Object{
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "shared", Labels: map[string]string{"shared": "true"}}},
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "private"}},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: gen.DefaultTestNamespace, Name: "output", Annotations: map[string]string{"existing": "annotation"}},
+				Data:       data,
+				Type:       corev1.SecretTypeTLS,
+			},
+			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "shared", Name: "conflict"}},
+			copyOf("shared", "stale", data),
+		},
+		CertManagerObjects: []runtime.Object{crt},
+		ExpectedActions: []testpkg.Action{
+			testpkg.NewAction(coretesting.NewCreateAction(corev1.SchemeGroupVersion.WithResource("secrets"), "shared", copyOf("shared", "output", data))),
+			testpkg.NewAction(coretesting.NewDeleteAction(corev1.SchemeGroupVersion.WithResource("secrets"), "shared", "stale")),
+		},
+		ExpectedEvents: []string{
+			`Warning SecretTargetNotAllowed Not copying Secret to "private/output" as namespace "private" is not selected by the controller's additional secret target namespace selector`,
+			`Warning SecretTargetConflict Not copying Secret to shared/conflict as a Secret that is not a copy of this Certificate's Secret already exists`,
+		},
+	}
+	builder.Init()
+	defer builder.Stop()
+	builder.Context.CertificateOptions.AdditionalSecretTargetNamespaceSelector = labels.SelectorFromSet(labels.Set{"shared": "true"})
+
+	w := controllerWrapper{}
+	_, _, err := w.Register(builder.Context)
+	require.NoError(t, err)
+	builder.Start()
+
+	err = w.controller.syncAdditionalSecretTargets(context.Background(), crt)
+	if err != nil {
+		t.Errorf("expected to not get an error, but got: %v", err)
+	}
+	builder.CheckAndFinish(err)
+}
+
+func TestProcessItemDeletesAdditionalSecretTargetsOfDeletedCertificate(t *testing.T) {
+	copyOf := func(namespace, name, crtKey string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   namespace,
+				Name:        name,
+				Labels:      map[string]string{cmapi.IsAdditionalSecretTargetLabelKey: "true"},
+				Annotations: map[string]string{cmapi.AdditionalSecretTargetOfAnnotationKey: crtKey},
+			},
+		}
+	}
+
+	builder := &testpkg.Builder{
+		T: t,
+		KubeObjects: []runtime.Object{
+			copyOf("shared", "output", gen.DefaultTestNamespace+"/test"),
+			copyOf(gen.DefaultTestNamespace, "output-copy", gen.DefaultTestNamespace+"/test"),
+			copyOf("shared", "other", gen.DefaultTestNamespace+"/other"),
+		},
+		ExpectedActions: []testpkg.Action{
+			testpkg.NewAction(coretesting.NewDeleteAction(corev1.SchemeGroupVersion.WithResource("secrets"), "shared", "output")),
+			testpkg.NewAction(coretesting.NewDeleteAction(corev1.SchemeGroupVersion.WithResource("secrets"), gen.DefaultTestNamespace, "output-copy")),
+		},
+	}
+	builder.Init()
+	defer builder.Stop()
+
+	w := controllerWrapper{}
+	_, _, err := w.Register(builder.Context)
+	require.NoError(t, err)
+	builder.Start()
+
+	err = w.controller.ProcessItem(context.Background(), gen.DefaultTestNamespace+"/test")
+	if err != nil {
+		t.Errorf("expected to not get an error, but got: %v", err)
+	}
+	builder.CheckAndFinish(err)
+}
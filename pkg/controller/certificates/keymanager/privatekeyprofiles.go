@@ -0,0 +1,144 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keymanager
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/jetstack/cert-manager/pkg/controller/certificates"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+// ensurePrivateKeyProfiles ensures that the 'next private key' Secret contains
+// a private key matching the spec of each of the Certificate's private key
+// profiles, and no private keys for profiles that have since been removed.
+func (c *controller) ensurePrivateKeyProfiles(ctx context.Context, crt *cmapi.Certificate, secret *corev1.Secret) error {
+	log := logf.FromContext(ctx)
+
+	data := make(map[string][]byte, len(secret.Data))
+	for k, v := range secret.Data {
+		data[k] = v
+	}
+	changed := false
+
+	profileNames := sets.NewString()
+	for _, profile := range crt.Spec.PrivateKeyProfiles {
+		profileNames.Insert(profile.Name)
+		log := log.WithValues("private_key_profile", profile.Name)
+		key := certificates.PrivateKeyProfilePrivateKeyKey(profile.Name)
+		profileCrt := certificates.CertificateForPrivateKeyProfile(crt, profile)
+
+		if pkData := data[key]; len(pkData) > 0 {
+			violations, err := privateKeyViolations(pkData, profileCrt)
+			if err == nil && len(violations) == 0 {
+				continue
+			}
+			log.V(logf.DebugLevel).Info("Regenerating private key of private key profile as it is invalid or does not match the spec", "violations", violations, "error", err)
+		}
+
+		pkData, err := c.nextPrivateKeyForProfile(ctx, crt, profile.Name, profileCrt)
+		if err != nil {
+			return err
+		}
+		if pkData == nil {
+			if _, ok := data[key]; ok {
+				delete(data, key)
+				changed = true
+			}
+			continue
+		}
+		data[key] = pkData
+		changed = true
+	}
+
+	for k := range data {
+		if name, ok := certificates.PrivateKeyProfileFromSecretKey(k); ok && !profileNames.Has(name) {
+			log.V(logf.DebugLevel).Info("Removing private key of private key profile that no longer exists", "private_key_profile", name)
+			delete(data, k)
+			changed = true
+		}
+	}
+
+	if !changed {
+		return nil
+	}
+
+	secret = secret.DeepCopy()
+	secret.Data = data
+	_, err := c.coreClient.CoreV1().Secrets(secret.Namespace).Update(ctx, secret, metav1.UpdateOptions{})
+	return err
+}
+
+// nextPrivateKeyForProfile returns the PKCS#8 encoded private key to use for
+// the next issuance of the named private key profile. If the profile's
+// rotation policy is Never, the private key stored in the Certificate's
+// Secret is re-used. If this private key does not match the profile's spec, a
+// warning event is fired and nil is returned to await user intervention.
+func (c *controller) nextPrivateKeyForProfile(ctx context.Context, crt *cmapi.Certificate, name string, profileCrt *cmapi.Certificate) ([]byte, error) {
+	rotationPolicy := profileCrt.Spec.PrivateKey.RotationPolicy
+	if rotationPolicy == "" {
+		rotationPolicy = cmapi.RotationPolicyNever
+	}
+
+	if rotationPolicy == cmapi.RotationPolicyNever {
+		s, err := c.secretLister.Secrets(crt.Namespace).Get(crt.Spec.SecretName)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		if s != nil && len(s.Data[certificates.PrivateKeyProfilePrivateKeyKey(name)]) > 0 {
+			violations, err := privateKeyViolations(s.Data[certificates.PrivateKeyProfilePrivateKeyKey(name)], profileCrt)
+			switch {
+			case err != nil:
+				c.recorder.Eventf(crt, corev1.EventTypeWarning, reasonDecodeFailed, "Failed to decode private key of private key profile %q stored in Secret %q - generating new key", name, crt.Spec.SecretName)
+			case len(violations) > 0:
+				c.recorder.Eventf(crt, corev1.EventTypeWarning, reasonDecodeFailed, "Existing private key of private key profile %q in Secret %q does not match requirements on Certificate resource, mismatching fields: %v", name, crt.Spec.SecretName, violations)
+				return nil, nil
+			default:
+				pk, err := pki.DecodePrivateKeyBytes(s.Data[certificates.PrivateKeyProfilePrivateKeyKey(name)])
+				if err != nil {
+					return nil, err
+				}
+				c.recorder.Eventf(crt, corev1.EventTypeNormal, "Reused", "Reusing private key of private key profile %q stored in existing Secret resource %q", name, s.Name)
+				return pki.EncodePrivateKey(pk, cmapi.PKCS8)
+			}
+		}
+	}
+
+	pk, err := pki.GeneratePrivateKeyForCertificate(profileCrt)
+	if err != nil {
+		return nil, err
+	}
+	c.recorder.Eventf(crt, corev1.EventTypeNormal, "Generated", "Generated new private key for private key profile %q", name)
+	return pki.EncodePrivateKey(pk, cmapi.PKCS8)
+}
+
+// privateKeyViolations decodes the given private key and returns the fields
+// of the Certificate's spec that it does not match.
+func privateKeyViolations(pkData []byte, crt *cmapi.Certificate) ([]string, error) {
+	pk, err := pki.DecodePrivateKeyBytes(pkData)
+	if err != nil {
+		return nil, err
+	}
+	return certificates.PrivateKeyMatchesSpec(pk, crt.Spec)
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"strings"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+)
+
+const (
+	privateKeyProfileSecretKeyPrefix         = "tls-"
+	privateKeyProfileCertificateSecretKeyExt = ".crt"
+	privateKeyProfilePrivateKeySecretKeyExt  = ".key"
+)
+
+// PrivateKeyProfileCertificateKey returns the key of the Secret data that the
+// certificate of the named private key profile is stored under.
+func PrivateKeyProfileCertificateKey(name string) string {
+	return privateKeyProfileSecretKeyPrefix + name + privateKeyProfileCertificateSecretKeyExt
+}
+
+// PrivateKeyProfilePrivateKeyKey returns the key of the Secret data that the
+// private key of the named private key profile is stored under.
+func PrivateKeyProfilePrivateKeyKey(name string) string {
+	return privateKeyProfileSecretKeyPrefix + name + privateKeyProfilePrivateKeySecretKeyExt
+}
+
+// PrivateKeyProfileFromSecretKey returns the name of the private key profile
+// that the given key of a Secret's data stores the certificate or private key
+// of, if any.
+func PrivateKeyProfileFromSecretKey(key string) (string, bool) {
+	if !strings.HasPrefix(key, privateKeyProfileSecretKeyPrefix) {
+		return "", false
+	}
+	name := strings.TrimPrefix(key, privateKeyProfileSecretKeyPrefix)
+	for _, ext := range []string{privateKeyProfileCertificateSecretKeyExt, privateKeyProfilePrivateKeySecretKeyExt} {
+		if strings.HasSuffix(name, ext) && len(name) > len(ext) {
+			return strings.TrimSuffix(name, ext), true
+		}
+	}
+	return "", false
+}
+
+// CertificateForPrivateKeyProfile returns a copy of the Certificate with the
+// private key options of the given private key profile, so that the private
+// key, CSR and CertificateRequest of the profile can be generated and
+// validated in the same way as those of the Certificate's primary private key.
+func CertificateForPrivateKeyProfile(crt *cmapi.Certificate, profile cmapi.CertificatePrivateKeyProfile) *cmapi.Certificate {
+	crt = crt.DeepCopy()
+	crt.Spec.PrivateKey = profile.PrivateKey.DeepCopy()
+	crt.Spec.PrivateKeyProfiles = nil
+	return crt
+}
+
+// PrivateKeyProfileName returns the name of the private key profile that the
+// CertificateRequest was created for, or an empty string if it was created
+// for the Certificate's primary private key.
+func PrivateKeyProfileName(req *cmapi.CertificateRequest) string {
+	return req.Annotations[cmapi.CertificateRequestPrivateKeyProfileAnnotationKey]
+}
@@ -164,24 +164,26 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 			crt.Status.NotAfter = nil
 			crt.Status.NotBefore = nil
 			crt.Status.RenewalTime = nil
+			crt.Status.IssuerCAFingerprint = ""
 			break
 		}
 
 		notBefore := metav1.NewTime(x509cert.NotBefore)
 		notAfter := metav1.NewTime(x509cert.NotAfter)
-		renewBeforeHint := crt.Spec.RenewBefore
-		renewalTime := c.renewalTimeCalculator(x509cert.NotBefore, x509cert.NotAfter, renewBeforeHint)
+		renewalTime := c.renewalTimeCalculator(x509cert.NotBefore, x509cert.NotAfter, crt)
 
 		//update Certificate's Status
 		crt.Status.NotBefore = &notBefore
 		crt.Status.NotAfter = &notAfter
 		crt.Status.RenewalTime = renewalTime
+		crt.Status.IssuerCAFingerprint = certificates.IssuerCAFingerprint(input.Secret)
 
 	default:
 		// clear status fields if the secret does not have any data
 		crt.Status.NotAfter = nil
 		crt.Status.NotBefore = nil
 		crt.Status.RenewalTime = nil
+		crt.Status.IssuerCAFingerprint = ""
 	}
 	if !apiequality.Semantic.DeepEqual(oldCrt.Status, crt.Status) {
 		log.V(logf.DebugLevel).Info("updating status fields", "notAfter",
@@ -222,6 +224,7 @@ func NewReadinessPolicyChain(c clock.Clock) policies.Chain {
 		policies.SecretDoesNotExist,
 		policies.SecretIsMissingData,
 		policies.SecretPublicKeysDiffer,
+		policies.SecretPrivateKeyProfilesNotUpToDate,
 		policies.CurrentCertificateRequestNotValidForSpec,
 		policies.CurrentCertificateHasExpired(c),
 	}
@@ -242,7 +245,10 @@ func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.Rate
 		ctx.KubeSharedInformerFactory,
 		ctx.SharedInformerFactory,
 		NewReadinessPolicyChain(ctx.Clock),
-		certificates.RenewalTime,
+		certificates.NewRenewalTimeFunc(certificates.RenewalOptions{
+			DefaultRenewBeforePercentage: ctx.CertificateOptions.DefaultRenewBeforePercentage,
+			DefaultJitter:                ctx.CertificateOptions.DefaultRenewalJitter,
+		}),
 		policyEvaluator,
 	)
 	c.controller = ctrl
@@ -45,7 +45,7 @@ func policyEvaluatorBuilder(c cmapi.CertificateCondition) policyEvaluatorFunc {
 
 // renewalTimeBuilder returns a fake renewalTimeFunc for ReadinessController.
 func renewalTimeBuilder(rt *metav1.Time) certificates.RenewalTimeFunc {
-	return func(notBefore, notAfter time.Time, cert *metav1.Duration) *metav1.Time {
+	return func(notBefore, notAfter time.Time, crt *cmapi.Certificate) *metav1.Time {
 		return rt
 	}
 }
@@ -330,6 +330,16 @@ func TestProcessItem(t *testing.T) {
 func TestNewReadinessPolicyChain(t *testing.T) {
 	clock := &fakeclock.FakeClock{}
 	privKey := internaltest.MustCreatePEMPrivateKey(t)
+	profileCert := gen.Certificate("something",
+		gen.SetCertificateCommonName("new.example.com"),
+		gen.SetCertificateIssuer(cmmeta.ObjectReference{
+			Name:  "testissuer",
+			Kind:  "IssuerKind",
+			Group: "group.example.com",
+		}))
+	profileCert.Spec.PrivateKeyProfiles = []cmapi.CertificatePrivateKeyProfile{
+		{Name: "rsa", PrivateKey: cmapi.CertificatePrivateKey{Algorithm: cmapi.RSAKeyAlgorithm}},
+	}
 	tests := map[string]struct {
 		// policy inputs
 		cert   *cmapi.Certificate
@@ -472,6 +482,42 @@ func TestNewReadinessPolicyChain(t *testing.T) {
 			message:        "Certificate expired on Sun, 31 Dec 0000 23:00:00 UTC",
 			violationFound: true,
 		},
+		"Certificate is not Ready when the certificate of a private key profile has expired": {
+			cert: profileCert,
+			secret: gen.Secret("something",
+				gen.SetSecretAnnotations(map[string]string{
+					cmapi.IssuerNameAnnotationKey:  "testissuer",
+					cmapi.IssuerKindAnnotationKey:  "IssuerKind",
+					cmapi.IssuerGroupAnnotationKey: "group.example.com",
+				}),
+				gen.SetSecretData(
+					map[string][]byte{
+						corev1.TLSPrivateKeyKey: privKey,
+						corev1.TLSCertKey: internaltest.MustCreateCertWithNotBeforeAfter(t, privKey,
+							gen.Certificate("something", gen.SetCertificateCommonName("new.example.com")),
+							clock.Now(), clock.Now().Add(time.Hour*3),
+						),
+						"tls-rsa.key": privKey,
+						"tls-rsa.crt": internaltest.MustCreateCertWithNotBeforeAfter(t, privKey,
+							gen.Certificate("something", gen.SetCertificateCommonName("new.example.com")),
+							clock.Now().Add(-3*time.Hour), clock.Now().Add(-1*time.Hour),
+						),
+					},
+				)),
+			cr: gen.CertificateRequest("something",
+				gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{
+					Name:  "testissuer",
+					Kind:  "IssuerKind",
+					Group: "group.example.com",
+				}),
+				gen.SetCertificateRequestCSR(internaltest.MustGenerateCSRImpl(t, privKey,
+					gen.Certificate("something",
+						gen.SetCertificateCommonName("new.example.com")))),
+			),
+			reason:         policies.Expired,
+			message:        `Certificate of private key profile "rsa" expired on Sun, 31 Dec 0000 23:00:00 UTC`,
+			violationFound: true,
+		},
 		"Certificate is Ready, no policy violations found": {
 			cert: gen.Certificate("something",
 				gen.SetCertificateCommonName("new.example.com"),
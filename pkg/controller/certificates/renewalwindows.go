@@ -0,0 +1,82 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"fmt"
+	"time"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/jetstack/cert-manager/pkg/util/cron"
+)
+
+// RenewalWindowOpen returns whether any of the given renewal windows is open
+// at now. If none are open, next is the time at which the first of them next
+// opens, or zero if none of them will open in the next five years.
+func RenewalWindowOpen(windows []cmapi.CertificateRenewalWindow, now time.Time) (open bool, next time.Time, err error) {
+	for i, w := range windows {
+		schedule, err := cron.Parse(w.Schedule)
+		if err != nil {
+			return false, time.Time{}, fmt.Errorf("invalid schedule for renewal window %d: %w", i, err)
+		}
+		loc := time.UTC
+		if len(w.TimeZone) > 0 {
+			if loc, err = time.LoadLocation(w.TimeZone); err != nil {
+				return false, time.Time{}, fmt.Errorf("invalid time zone for renewal window %d: %w", i, err)
+			}
+		}
+
+		// The earliest opening of the window that has not yet closed is
+		// either in the past, in which case the window is open, or is the
+		// next time the window opens.
+		start := schedule.Next(now.In(loc).Add(-w.Duration.Duration))
+		if start.IsZero() {
+			continue
+		}
+		if !start.After(now) {
+			return true, time.Time{}, nil
+		}
+		if next.IsZero() || start.Before(next) {
+			next = start
+		}
+	}
+	return false, next, nil
+}
+
+// renewalWindowMinimumMargin is the minimum time that must remain before a
+// certificate expires when a deferred renewal begins, leaving time for the
+// issuance and any retries of it to complete.
+const renewalWindowMinimumMargin = 24 * time.Hour
+
+// DeferRenewalUntil returns the time until which the renewal of the given
+// Certificate, whose current certificate expires at notAfter, should be
+// deferred because none of its renewal windows are open. The zero time is
+// returned if renewal should not be deferred, including if the next window
+// opens less than renewalWindowMinimumMargin before the certificate expires.
+func DeferRenewalUntil(crt *cmapi.Certificate, notAfter, now time.Time) (time.Time, error) {
+	if len(crt.Spec.RenewalWindows) == 0 {
+		return time.Time{}, nil
+	}
+	open, next, err := RenewalWindowOpen(crt.Spec.RenewalWindows, now)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if open || next.IsZero() || next.After(notAfter.Add(-renewalWindowMinimumMargin)) {
+		return time.Time{}, nil
+	}
+	return next, nil
+}
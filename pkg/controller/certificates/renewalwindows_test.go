@@ -0,0 +1,113 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+)
+
+func TestDeferRenewalUntil(t *testing.T) {
+	// A Wednesday
+	now := time.Date(2021, 3, 17, 10, 30, 0, 0, time.UTC)
+	sundays := cmapi.CertificateRenewalWindow{Schedule: "0 3 * * SUN", Duration: metav1.Duration{Duration: time.Hour}}
+	nextSunday := time.Date(2021, 3, 21, 3, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		windows  []cmapi.CertificateRenewalWindow
+		notAfter time.Time
+		expected time.Time
+		err      bool
+	}{
+		"no windows": {
+			notAfter: now.Add(time.Hour * 24 * 30),
+		},
+		"window is closed": {
+			windows:  []cmapi.CertificateRenewalWindow{sundays},
+			notAfter: now.Add(time.Hour * 24 * 30),
+			expected: nextSunday,
+		},
+		"window is open": {
+			windows: []cmapi.CertificateRenewalWindow{
+				sundays,
+				{Schedule: "0 10 * * *", Duration: metav1.Duration{Duration: time.Hour}},
+			},
+			notAfter: now.Add(time.Hour * 24 * 30),
+		},
+		"window opened earlier and has closed": {
+			windows:  []cmapi.CertificateRenewalWindow{{Schedule: "0 10 * * *", Duration: metav1.Duration{Duration: time.Minute * 30}}},
+			notAfter: now.Add(time.Hour * 24 * 30),
+			expected: time.Date(2021, 3, 18, 10, 0, 0, 0, time.UTC),
+		},
+		"earliest of several closed windows is used": {
+			windows: []cmapi.CertificateRenewalWindow{
+				sundays,
+				{Schedule: "0 2 * * SAT", Duration: metav1.Duration{Duration: time.Hour}},
+			},
+			notAfter: now.Add(time.Hour * 24 * 30),
+			expected: time.Date(2021, 3, 20, 2, 0, 0, 0, time.UTC),
+		},
+		"window is evaluated in its time zone": {
+			windows: []cmapi.CertificateRenewalWindow{{
+				Schedule: "0 3 * * SUN",
+				Duration: metav1.Duration{Duration: time.Hour},
+				TimeZone: "America/New_York",
+			}},
+			notAfter: now.Add(time.Hour * 24 * 30),
+			// Eastern Daylight Time began on the 14th of March
+			expected: time.Date(2021, 3, 21, 7, 0, 0, 0, time.UTC),
+		},
+		"certificate expires before the next window opens": {
+			windows:  []cmapi.CertificateRenewalWindow{sundays},
+			notAfter: nextSunday.Add(-time.Minute),
+		},
+		"next window opens too close to expiry": {
+			windows:  []cmapi.CertificateRenewalWindow{sundays},
+			notAfter: nextSunday.Add(renewalWindowMinimumMargin - time.Minute),
+		},
+		"next window opens exactly the minimum margin before expiry": {
+			windows:  []cmapi.CertificateRenewalWindow{sundays},
+			notAfter: nextSunday.Add(renewalWindowMinimumMargin),
+			expected: nextSunday,
+		},
+		"window never opens": {
+			windows:  []cmapi.CertificateRenewalWindow{{Schedule: "0 0 30 2 *", Duration: metav1.Duration{Duration: time.Hour}}},
+			notAfter: now.Add(time.Hour * 24 * 30),
+		},
+		"invalid schedule": {
+			windows:  []cmapi.CertificateRenewalWindow{{Schedule: "every sunday", Duration: metav1.Duration{Duration: time.Hour}}},
+			notAfter: now.Add(time.Hour * 24 * 30),
+			err:      true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			crt := &cmapi.Certificate{Spec: cmapi.CertificateSpec{RenewalWindows: test.windows}}
+			deferUntil, err := DeferRenewalUntil(crt, test.notAfter, now)
+			if (err != nil) != test.err {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !deferUntil.Equal(test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, deferUntil)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requestmanager
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/jetstack/cert-manager/pkg/controller/certificates"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+// requestsByPrivateKeyProfile splits the given CertificateRequests into those
+// for the Certificate's primary private key and those for each of its private
+// key profiles, keyed by the name of the profile.
+func requestsByPrivateKeyProfile(reqs []*cmapi.CertificateRequest) ([]*cmapi.CertificateRequest, map[string][]*cmapi.CertificateRequest) {
+	var primary []*cmapi.CertificateRequest
+	profiles := make(map[string][]*cmapi.CertificateRequest)
+	for _, req := range reqs {
+		name := certificates.PrivateKeyProfileName(req)
+		if len(name) == 0 {
+			primary = append(primary, req)
+			continue
+		}
+		profiles[name] = append(profiles[name], req)
+	}
+	return primary, profiles
+}
+
+// ensurePrivateKeyProfileRequests ensures that a single up to date
+// CertificateRequest exists for the next revision of each of the
+// Certificate's private key profiles, and deletes those for profiles that
+// have since been removed. The given requests must all be for the next
+// revision.
+func (c *controller) ensurePrivateKeyProfileRequests(ctx context.Context, crt *cmapi.Certificate, nextPrivateKeySecret *corev1.Secret, nextRevision int, requests map[string][]*cmapi.CertificateRequest) error {
+	profileNames := sets.NewString()
+	for _, profile := range crt.Spec.PrivateKeyProfiles {
+		profileNames.Insert(profile.Name)
+		log := logf.FromContext(ctx).WithValues("private_key_profile", profile.Name)
+		ctx := logf.NewContext(ctx, log)
+
+		pkData := nextPrivateKeySecret.Data[certificates.PrivateKeyProfilePrivateKeyKey(profile.Name)]
+		if len(pkData) == 0 {
+			log.V(logf.DebugLevel).Info("Next private key secret does not contain a private key for the private key profile, waiting for keymanager before processing certificate")
+			continue
+		}
+		pk, err := pki.DecodePrivateKeyBytes(pkData)
+		if err != nil {
+			log.Error(err, "Failed to decode private key of private key profile in next private key secret, waiting for keymanager before processing certificate")
+			continue
+		}
+
+		profileCrt := certificates.CertificateForPrivateKeyProfile(crt, profile)
+		reqs, err := c.deleteRequestsNotMatchingSpec(ctx, profileCrt, pk.Public(), requests[profile.Name]...)
+		if err != nil {
+			return err
+		}
+		reqs, err = c.deleteCurrentFailedRequests(ctx, crt, reqs...)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case len(reqs) > 1:
+			log.V(logf.ErrorLevel).Info("Multiple matching CertificateRequest resources exist for the private key profile, delete one of them. This is likely an error and should be reported on the issue tracker!")
+		case len(reqs) == 0:
+			if err := c.createNewCertificateRequest(ctx, profileCrt, pk, nextRevision, nextPrivateKeySecret.Name, profile.Name); err != nil {
+				return err
+			}
+		}
+	}
+
+	for name, reqs := range requests {
+		if profileNames.Has(name) {
+			continue
+		}
+		for _, req := range reqs {
+			logf.WithRelatedResource(logf.FromContext(ctx), req).V(logf.DebugLevel).Info("Deleting CertificateRequest as its private key profile no longer exists", "private_key_profile", name)
+			if err := c.client.CertmanagerV1().CertificateRequests(req.Namespace).Delete(ctx, req.Name, metav1.DeleteOptions{}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
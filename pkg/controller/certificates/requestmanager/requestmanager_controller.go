@@ -68,6 +68,11 @@ type controller struct {
 	client                   cmclient.Interface
 	recorder                 record.EventRecorder
 	clock                    clock.Clock
+
+	// retryBackoffMin and retryBackoffMax are the default bounds of the
+	// backoff applied before retrying a failed CertificateRequest.
+	retryBackoffMin time.Duration
+	retryBackoffMax time.Duration
 }
 
 func NewController(
@@ -77,6 +82,7 @@ func NewController(
 	cmFactory cminformers.SharedInformerFactory,
 	recorder record.EventRecorder,
 	clock clock.Clock,
+	certificateOptions controllerpkg.CertificateOptions,
 ) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced) {
 	// create a queue used to queue up items to be processed
 	queue := workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Second*1, time.Second*30), ControllerName)
@@ -115,6 +121,8 @@ func NewController(
 		client:                   client,
 		recorder:                 recorder,
 		clock:                    clock,
+		retryBackoffMin:          certificateOptions.IssuanceRetryBackoffMin,
+		retryBackoffMax:          certificateOptions.IssuanceRetryBackoffMax,
 	}, queue, mustSync
 }
 
@@ -190,12 +198,16 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 		return err
 	}
 
+	// CertificateRequests for the Certificate's private key profiles are
+	// handled separately once the primary private key has been requested.
+	requests, profileRequests := requestsByPrivateKeyProfile(requests)
+
 	requests, err = c.deleteRequestsNotMatchingSpec(ctx, crt, pk.Public(), requests...)
 	if err != nil {
 		return err
 	}
 
-	requests, err = c.deleteCurrentFailedRequests(ctx, requests...)
+	requests, err = c.deleteCurrentFailedRequests(ctx, crt, requests...)
 	if err != nil {
 		return err
 	}
@@ -208,16 +220,18 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 		return nil
 	}
 
-	if len(requests) == 1 {
-		// Nothing to do as we've already verified that the CertificateRequest
-		// is up to date above.
-		return nil
+	// If exactly one CertificateRequest exists there is nothing to do, as
+	// we've already verified that it is up to date above.
+	if len(requests) == 0 {
+		if err := c.createNewCertificateRequest(ctx, crt, pk, nextRevision, nextPrivateKeySecret.Name, ""); err != nil {
+			return err
+		}
 	}
 
-	return c.createNewCertificateRequest(ctx, crt, pk, nextRevision, nextPrivateKeySecret.Name)
+	return c.ensurePrivateKeyProfileRequests(ctx, crt, nextPrivateKeySecret, nextRevision, profileRequests)
 }
 
-func (c *controller) deleteCurrentFailedRequests(ctx context.Context, reqs ...*cmapi.CertificateRequest) ([]*cmapi.CertificateRequest, error) {
+func (c *controller) deleteCurrentFailedRequests(ctx context.Context, crt *cmapi.Certificate, reqs ...*cmapi.CertificateRequest) ([]*cmapi.CertificateRequest, error) {
 	log := logf.FromContext(ctx)
 	var remaining []*cmapi.CertificateRequest
 	for _, req := range reqs {
@@ -232,11 +246,9 @@ func (c *controller) deleteCurrentFailedRequests(ctx context.Context, reqs ...*c
 			remaining = append(remaining, req)
 			continue
 		}
-		// TODO: once we have implemented exponential back off for
-		// Certificate failures, this should be changed accordingly.
 		now := c.clock.Now()
 		durationSinceFailure := now.Sub(cond.LastTransitionTime.Time)
-		if durationSinceFailure >= certificates.RetryAfterLastFailure {
+		if durationSinceFailure >= certificates.IssuanceRetryBackoff(crt, c.retryBackoffMin, c.retryBackoffMax) {
 			if err := c.client.CertmanagerV1().CertificateRequests(req.Namespace).Delete(ctx, req.Name, metav1.DeleteOptions{}); err != nil {
 				return nil, err
 			}
@@ -335,7 +347,11 @@ func (c *controller) deleteRequestsNotMatchingSpec(ctx context.Context, crt *cma
 	return remaining, nil
 }
 
-func (c *controller) createNewCertificateRequest(ctx context.Context, crt *cmapi.Certificate, pk crypto.Signer, nextRevision int, nextPrivateKeySecretName string) error {
+// createNewCertificateRequest creates a CertificateRequest for the given
+// revision of the Certificate. If privateKeyProfile is set, the
+// CertificateRequest is created for the named private key profile, and crt is
+// expected to have the private key options of the profile.
+func (c *controller) createNewCertificateRequest(ctx context.Context, crt *cmapi.Certificate, pk crypto.Signer, nextRevision int, nextPrivateKeySecretName, privateKeyProfile string) error {
 	log := logf.FromContext(ctx)
 	x509CSR, err := pki.GenerateCSR(crt)
 	if err != nil {
@@ -360,6 +376,9 @@ func (c *controller) createNewCertificateRequest(ctx context.Context, crt *cmapi
 	annotations[cmapi.CertificateRequestRevisionAnnotationKey] = strconv.Itoa(nextRevision)
 	annotations[cmapi.CertificateRequestPrivateKeyAnnotationKey] = nextPrivateKeySecretName
 	annotations[cmapi.CertificateNameKey] = crt.Name
+	if len(privateKeyProfile) > 0 {
+		annotations[cmapi.CertificateRequestPrivateKeyProfileAnnotationKey] = privateKeyProfile
+	}
 
 	cr := &cmapi.CertificateRequest{
 		ObjectMeta: metav1.ObjectMeta{
@@ -419,6 +438,7 @@ func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.Rate
 		ctx.SharedInformerFactory,
 		ctx.Recorder,
 		ctx.Clock,
+		ctx.CertificateOptions,
 	)
 	c.controller = ctrl
 
@@ -157,7 +157,20 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 // those that have a valid revision number set, and return a slice of requests
 // that should be deleted according to the limit given. Oldest
 // CertificateRequests by revision will be returned.
+// CertificateRequests for private key profiles are not counted towards the
+// limit, and are deleted along with the revisions they belong to.
 func certificateRequestsToDelete(log logr.Logger, limit int, requests []*cmapi.CertificateRequest) []revision {
+	var profileRequests []*cmapi.CertificateRequest
+	var primaryRequests []*cmapi.CertificateRequest
+	for _, req := range requests {
+		if len(certificates.PrivateKeyProfileName(req)) > 0 {
+			profileRequests = append(profileRequests, req)
+			continue
+		}
+		primaryRequests = append(primaryRequests, req)
+	}
+	requests = primaryRequests
+
 	// If the number of requests is the same or below the limit, return nothing.
 	if limit >= len(requests) {
 		return nil
@@ -192,7 +205,23 @@ func certificateRequestsToDelete(log logr.Logger, limit int, requests []*cmapi.C
 		return nil
 	}
 
-	return revisions[:remaining]
+	toDelete := revisions[:remaining]
+	if remaining == 0 || len(profileRequests) == 0 {
+		return toDelete
+	}
+
+	// Delete the CertificateRequests of private key profiles that are older
+	// than the oldest revision being kept.
+	oldestKept := revisions[remaining].rev
+	for _, req := range profileRequests {
+		rn, err := strconv.Atoi(req.Annotations[cmapi.CertificateRequestRevisionAnnotationKey])
+		if err != nil || rn >= oldestKept {
+			continue
+		}
+		toDelete = append(toDelete, revision{rn, types.NamespacedName{Namespace: req.Namespace, Name: req.Name}})
+	}
+
+	return toDelete
 }
 
 // controllerWrapper wraps the `controller` structure to make it implement
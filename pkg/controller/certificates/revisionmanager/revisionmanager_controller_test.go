@@ -405,6 +405,45 @@ func TestCertificateRequestsToDelete(t *testing.T) {
 				},
 			},
 		},
+		"requests for private key profiles should not count towards the limit, and be deleted with their revision": {
+			input: []*cmapi.CertificateRequest{
+				gen.CertificateRequestFrom(baseCR,
+					gen.SetCertificateRequestName("cr-1"),
+					gen.SetCertificateRequestRevision("1"),
+				),
+				gen.CertificateRequestFrom(baseCR,
+					gen.SetCertificateRequestName("cr-1-ecdsa"),
+					gen.SetCertificateRequestRevision("1"),
+					gen.AddCertificateRequestAnnotations(map[string]string{cmapi.CertificateRequestPrivateKeyProfileAnnotationKey: "ecdsa"}),
+				),
+				gen.CertificateRequestFrom(baseCR,
+					gen.SetCertificateRequestName("cr-2"),
+					gen.SetCertificateRequestRevision("2"),
+				),
+				gen.CertificateRequestFrom(baseCR,
+					gen.SetCertificateRequestName("cr-2-ecdsa"),
+					gen.SetCertificateRequestRevision("2"),
+					gen.AddCertificateRequestAnnotations(map[string]string{cmapi.CertificateRequestPrivateKeyProfileAnnotationKey: "ecdsa"}),
+				),
+			},
+			limit: 1,
+			exp: []revision{
+				{
+					1,
+					types.NamespacedName{
+						Namespace: gen.DefaultTestNamespace,
+						Name:      "cr-1",
+					},
+				},
+				{
+					1,
+					types.NamespacedName{
+						Namespace: gen.DefaultTestNamespace,
+						Name:      "cr-1-ecdsa",
+					},
+				},
+			},
+		},
 	}
 
 	for name, test := range tests {
@@ -0,0 +1,178 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trigger
+
+import (
+	"crypto/x509"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmlisters "github.com/jetstack/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
+	"github.com/jetstack/cert-manager/pkg/issuer"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+const (
+	// issuerCAReissueQPS and issuerCAReissueBurst bound the rate at which
+	// Certificates are re-issued because their issuer's CA has been rotated,
+	// so that rotating a CA with many dependent Certificates does not cause
+	// them all to be re-issued at once.
+	issuerCAReissueQPS   = 1
+	issuerCAReissueBurst = 10
+
+	// issuerCAReissueRecheckDelay is the base delay before re-checking a
+	// Certificate whose re-issuance was held back by the rate limit. The
+	// actual delay is jittered to spread out re-checks.
+	issuerCAReissueRecheckDelay = time.Second * 30
+)
+
+// issuerCAGetter looks up the CA certificate that a Certificate's issuer
+// currently signs certificates with.
+type issuerCAGetter struct {
+	issuerHelper  issuer.Helper
+	secretLister  corelisters.SecretLister
+	issuerOptions controllerpkg.IssuerOptions
+}
+
+// IssuerCA returns the first certificate stored in the CA Secret of the
+// Certificate's issuer. It returns nil if the issuer is not a CA issuer, or
+// if the issuer or its Secret cannot be found or decoded, as the issuer will
+// not be able to sign certificates until this is resolved.
+func (g *issuerCAGetter) IssuerCA(crt *cmapi.Certificate) (*x509.Certificate, error) {
+	if !isCertManagerIssuerRef(crt.Spec.IssuerRef.Group) {
+		return nil, nil
+	}
+	iss, err := g.issuerHelper.GetGenericIssuer(crt.Spec.IssuerRef, crt.Namespace)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if iss.GetSpec().CA == nil {
+		return nil, nil
+	}
+
+	secret, err := g.secretLister.Secrets(g.issuerOptions.ResourceNamespace(iss)).Get(iss.GetSpec().CA.SecretName)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	chain, err := pki.DecodeX509CertificateChainBytes(secret.Data[corev1.TLSCertKey])
+	if err != nil || len(chain) == 0 {
+		return nil, nil
+	}
+	return chain[0], nil
+}
+
+// enqueueCertificatesForIssuerCASecret returns a function that, when given a
+// Secret, enqueues every Certificate whose Issuer or ClusterIssuer uses the
+// Secret as its CA.
+func enqueueCertificatesForIssuerCASecret(log logr.Logger, queue workqueue.Interface,
+	certificateLister cmlisters.CertificateLister,
+	issuerLister cmlisters.IssuerLister,
+	clusterIssuerLister cmlisters.ClusterIssuerLister,
+	clusterResourceNamespace string,
+) func(obj interface{}) {
+	return func(obj interface{}) {
+		secret, ok := obj.(metav1.Object)
+		if !ok {
+			log.V(logf.ErrorLevel).Info("Non-Object type resource passed to enqueueCertificatesForIssuerCASecret")
+			return
+		}
+
+		issuers, err := issuerLister.Issuers(secret.GetNamespace()).List(labels.Everything())
+		if err != nil {
+			log.Error(err, "Failed listing Issuer resources")
+			return
+		}
+		for _, iss := range issuers {
+			if !usesCASecret(iss, secret.GetName()) {
+				continue
+			}
+			certs, err := certificateLister.Certificates(iss.Namespace).List(labels.Everything())
+			if err != nil {
+				log.Error(err, "Failed listing Certificate resources")
+				return
+			}
+			enqueueCertificatesForIssuer(log, queue, certs, cmapi.IssuerKind, iss.Name)
+		}
+
+		if clusterIssuerLister == nil || secret.GetNamespace() != clusterResourceNamespace {
+			return
+		}
+		clusterIssuers, err := clusterIssuerLister.List(labels.Everything())
+		if err != nil {
+			log.Error(err, "Failed listing ClusterIssuer resources")
+			return
+		}
+		for _, iss := range clusterIssuers {
+			if !usesCASecret(iss, secret.GetName()) {
+				continue
+			}
+			certs, err := certificateLister.List(labels.Everything())
+			if err != nil {
+				log.Error(err, "Failed listing Certificate resources")
+				return
+			}
+			enqueueCertificatesForIssuer(log, queue, certs, cmapi.ClusterIssuerKind, iss.Name)
+		}
+	}
+}
+
+func usesCASecret(iss cmapi.GenericIssuer, secretName string) bool {
+	ca := iss.GetSpec().CA
+	return ca != nil && ca.SecretName == secretName
+}
+
+// enqueueCertificatesForIssuer enqueues those of the given Certificates that
+// reference the issuer with the given kind and name.
+func enqueueCertificatesForIssuer(log logr.Logger, queue workqueue.Interface, certs []*cmapi.Certificate, kind, name string) {
+	for _, crt := range certs {
+		ref := crt.Spec.IssuerRef
+		refKind := ref.Kind
+		if refKind == "" {
+			refKind = cmapi.IssuerKind
+		}
+		if ref.Name != name || refKind != kind || !isCertManagerIssuerRef(ref.Group) {
+			continue
+		}
+		key, err := controllerpkg.KeyFunc(crt)
+		if err != nil {
+			log.Error(err, "Error determining 'key' for resource")
+			continue
+		}
+		queue.Add(key)
+	}
+}
+
+func isCertManagerIssuerRef(group string) bool {
+	return group == "" || group == certmanager.GroupName
+}
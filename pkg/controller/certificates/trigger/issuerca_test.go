@@ -0,0 +1,106 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trigger
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	cmlisters "github.com/jetstack/cert-manager/pkg/client/listers/certmanager/v1"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+	"github.com/jetstack/cert-manager/test/unit/gen"
+)
+
+func TestEnqueueCertificatesForIssuerCASecret(t *testing.T) {
+	newIndexer := func(objs ...interface{}) cache.Indexer {
+		indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+		for _, obj := range objs {
+			if err := indexer.Add(obj); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return indexer
+	}
+	caIssuer := func(secretName string) cmapi.IssuerConfig {
+		return cmapi.IssuerConfig{CA: &cmapi.CAIssuer{SecretName: secretName}}
+	}
+	cert := func(namespace, name, issuerKind, issuerName string) *cmapi.Certificate {
+		return gen.Certificate(name,
+			gen.SetCertificateNamespace(namespace),
+			gen.SetCertificateIssuer(cmmeta.ObjectReference{Kind: issuerKind, Name: issuerName}),
+		)
+	}
+
+	certificateLister := cmlisters.NewCertificateLister(newIndexer(
+		cert("ns1", "issuer-default-kind", "", "ca"),
+		cert("ns1", "issuer", cmapi.IssuerKind, "ca"),
+		cert("ns1", "other-issuer", cmapi.IssuerKind, "other"),
+		cert("ns2", "issuer-other-namespace", cmapi.IssuerKind, "ca"),
+		cert("ns1", "cluster-issuer", cmapi.ClusterIssuerKind, "cluster-ca"),
+		cert("ns2", "cluster-issuer-other-namespace", cmapi.ClusterIssuerKind, "cluster-ca"),
+	))
+	issuerLister := cmlisters.NewIssuerLister(newIndexer(
+		&cmapi.Issuer{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "ca"}, Spec: cmapi.IssuerSpec{IssuerConfig: caIssuer("ca-secret")}},
+		&cmapi.Issuer{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "other"}, Spec: cmapi.IssuerSpec{IssuerConfig: caIssuer("other-secret")}},
+		&cmapi.Issuer{ObjectMeta: metav1.ObjectMeta{Namespace: "ns2", Name: "ca"}, Spec: cmapi.IssuerSpec{IssuerConfig: caIssuer("other-secret")}},
+	))
+	clusterIssuerLister := cmlisters.NewClusterIssuerLister(newIndexer(
+		&cmapi.ClusterIssuer{ObjectMeta: metav1.ObjectMeta{Name: "cluster-ca"}, Spec: cmapi.IssuerSpec{IssuerConfig: caIssuer("ca-secret")}},
+	))
+
+	tests := map[string]struct {
+		secret       *corev1.Secret
+		expectedKeys []string
+	}{
+		"secret used by an Issuer": {
+			secret:       &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "ca-secret"}},
+			expectedKeys: []string{"ns1/issuer", "ns1/issuer-default-kind"},
+		},
+		"secret used by a ClusterIssuer": {
+			secret:       &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "cert-manager", Name: "ca-secret"}},
+			expectedKeys: []string{"ns1/cluster-issuer", "ns2/cluster-issuer-other-namespace"},
+		},
+		"secret not used by any issuer": {
+			secret: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "unused"}},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			queue := workqueue.New()
+			defer queue.ShutDown()
+
+			enqueueCertificatesForIssuerCASecret(logf.Log, queue, certificateLister, issuerLister, clusterIssuerLister, "cert-manager")(test.secret)
+
+			var keys []string
+			for queue.Len() > 0 {
+				key, _ := queue.Get()
+				keys = append(keys, key.(string))
+				queue.Done(key)
+			}
+			sort.Strings(keys)
+			assert.Equal(t, test.expectedKeys, keys)
+		})
+	}
+}
@@ -38,6 +38,10 @@ const (
 	// Renewing is a policy violation reason for a scenario where
 	// Certificate's renewal time is now or in past.
 	Renewing string = "Renewing"
+	// IssuerCAChanged is a policy violation reason for a scenario where the
+	// CA certificate or key used by the Certificate's issuer has changed
+	// since the certificate was issued.
+	IssuerCAChanged string = "IssuerCAChanged"
 	// Expired is a policy violation reason for a scenario where Certificate has
 	// expired.
 	Expired string = "Expired"
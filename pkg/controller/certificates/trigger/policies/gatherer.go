@@ -209,6 +209,7 @@ package policies
 
 import (
 	"context"
+	"crypto/x509"
 	"fmt"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -227,6 +228,11 @@ import (
 type Gatherer struct {
 	CertificateRequestLister cmlisters.CertificateRequestLister
 	SecretLister             corelisters.SecretLister
+
+	// IssuerCA is optional. If set, it is used to look up the CA certificate
+	// that the Certificate's issuer currently signs certificates with. It
+	// returns nil if the issuer does not sign using a CA stored in a Secret.
+	IssuerCA func(crt *cmapi.Certificate) (*x509.Certificate, error)
 }
 
 // DataForCertificate returns the secret as well as the "current" and "next"
@@ -264,6 +270,7 @@ func (g *Gatherer) DataForCertificate(ctx context.Context, crt *cmapi.Certificat
 			labels.Everything(),
 			predicate.ResourceOwnedBy(crt),
 			predicate.CertificateRequestRevision(*crt.Status.Revision),
+			predicate.CertificateRequestPrivateKeyProfile(""),
 		)
 		if err != nil {
 			return Input{}, err
@@ -291,6 +298,7 @@ func (g *Gatherer) DataForCertificate(ctx context.Context, crt *cmapi.Certificat
 		labels.Everything(),
 		predicate.ResourceOwnedBy(crt),
 		predicate.CertificateRequestRevision(nextCRRevision),
+		predicate.CertificateRequestPrivateKeyProfile(""),
 	)
 	if err != nil {
 		return Input{}, err
@@ -312,10 +320,19 @@ func (g *Gatherer) DataForCertificate(ctx context.Context, crt *cmapi.Certificat
 		log.V(logf.DebugLevel).Info("Found no CertificateRequest resources owned by this Certificate for the next revision", "revision", nextCRRevision)
 	}
 
+	var issuerCA *x509.Certificate
+	if g.IssuerCA != nil {
+		issuerCA, err = g.IssuerCA(crt)
+		if err != nil {
+			return Input{}, err
+		}
+	}
+
 	return Input{
 		Certificate:            crt,
 		Secret:                 secret,
 		CurrentRevisionRequest: curCR,
 		NextRevisionRequest:    nextCR,
+		IssuerCA:               issuerCA,
 	}, nil
 }
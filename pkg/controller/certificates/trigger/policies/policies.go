@@ -19,6 +19,7 @@ package policies
 
 import (
 	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"time"
 
@@ -28,7 +29,6 @@ import (
 	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
 	"github.com/jetstack/cert-manager/pkg/controller/certificates"
 	"github.com/jetstack/cert-manager/pkg/util/pki"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 type Input struct {
@@ -46,7 +46,17 @@ type Input struct {
 	// The "next" certificate request is the one that is currently being issued.
 	// Take a look at the gatherer package's documentation to see more about why
 	// we care about the "next" certificate request.
+	//
+	// Both the "current" and "next" certificate requests are those for the
+	// Certificate's primary private key, and not for any of its private key
+	// profiles.
 	NextRevisionRequest *cmapi.CertificateRequest
+
+	// IssuerCA is the CA certificate that the Certificate's issuer currently
+	// signs certificates with. It is only set for issuers that sign using a
+	// CA stored in a Secret, and when the gatherer is configured to look it
+	// up.
+	IssuerCA *x509.Certificate
 }
 
 // A Func evaluates the given input data and decides whether a
@@ -70,15 +80,20 @@ func (c Chain) Evaluate(input Input) (string, string, bool) {
 	return "", "", false
 }
 
-func NewTriggerPolicyChain(c clock.Clock) Chain {
+// NewTriggerPolicyChain constructs the chain of policies used to determine
+// whether a Certificate should be issued. renewalTime is used to calculate
+// when the currently issued certificate should be renewed.
+func NewTriggerPolicyChain(c clock.Clock, renewalTime certificates.RenewalTimeFunc) Chain {
 	return Chain{
 		SecretDoesNotExist,
 		SecretIsMissingData,
 		SecretPublicKeysDiffer,
 		SecretPrivateKeyMatchesSpec,
+		SecretPrivateKeyProfilesNotUpToDate,
 		SecretIssuerAnnotationsNotUpToDate,
 		CurrentCertificateRequestNotValidForSpec,
-		CurrentCertificateNearingExpiry(c),
+		CurrentCertificateIssuerCAChanged,
+		CurrentCertificateNearingExpiry(c, renewalTime),
 	}
 }
 
@@ -137,6 +152,34 @@ func SecretPrivateKeyMatchesSpec(input Input) (string, string, bool) {
 	return "", "", false
 }
 
+// SecretPrivateKeyProfilesNotUpToDate checks that the Secret contains a valid
+// certificate and private key for each of the Certificate's private key
+// profiles, and that each private key matches the profile's spec.
+func SecretPrivateKeyProfilesNotUpToDate(input Input) (string, string, bool) {
+	for _, profile := range input.Certificate.Spec.PrivateKeyProfiles {
+		certData := input.Secret.Data[certificates.PrivateKeyProfileCertificateKey(profile.Name)]
+		pkData := input.Secret.Data[certificates.PrivateKeyProfilePrivateKeyKey(profile.Name)]
+		if len(certData) == 0 || len(pkData) == 0 {
+			return MissingData, fmt.Sprintf("Issuing certificate as Secret does not contain a certificate and private key for private key profile %q", profile.Name), true
+		}
+		if _, err := tls.X509KeyPair(certData, pkData); err != nil {
+			return InvalidKeyPair, fmt.Sprintf("Issuing certificate as Secret contains an invalid key-pair for private key profile %q: %v", profile.Name, err), true
+		}
+		pk, err := pki.DecodePrivateKeyBytes(pkData)
+		if err != nil {
+			return SecretMismatch, fmt.Sprintf("Existing issued Secret contains invalid private key data for private key profile %q: %v", profile.Name, err), true
+		}
+		violations, err := certificates.PrivateKeyMatchesSpec(pk, certificates.CertificateForPrivateKeyProfile(input.Certificate, profile).Spec)
+		if err != nil {
+			return SecretMismatch, fmt.Sprintf("Failed to check private key of private key profile %q is up to date: %v", profile.Name, err), true
+		}
+		if len(violations) > 0 {
+			return SecretMismatch, fmt.Sprintf("Existing private key of private key profile %q is not up to date for spec: %v", profile.Name, violations), true
+		}
+	}
+	return "", "", false
+}
+
 func SecretIssuerAnnotationsNotUpToDate(input Input) (string, string, bool) {
 	name := input.Secret.Annotations[cmapi.IssuerNameAnnotationKey]
 	kind := input.Secret.Annotations[cmapi.IssuerKindAnnotationKey]
@@ -149,6 +192,56 @@ func SecretIssuerAnnotationsNotUpToDate(input Input) (string, string, bool) {
 	return "", "", false
 }
 
+// SecretAdoptable checks whether the Certificate's existing Secret, which
+// was not issued by cert-manager, can be taken over without re-issuing the
+// certificate it contains. This is the case if the Certificate has the
+// cert-manager.io/adopt-existing-secret annotation, the Secret is a
+// kubernetes.io/tls Secret without cert-manager's issuer annotations, its
+// certificate matches the Certificate's spec and it was signed by the CA of
+// the Certificate's issuer. If the Secret cannot be adopted, a human readable
+// message explaining why is returned.
+//
+// It assumes that the Secret contains a valid key pair that matches the
+// Certificate's private key spec, as checked by the earlier policies in the
+// trigger policy chain.
+func SecretAdoptable(input Input) (string, bool) {
+	if input.Certificate.Annotations[cmapi.AdoptExistingSecretAnnotation] != "true" {
+		return "Certificate does not request adoption of existing Secrets", false
+	}
+	if input.Secret == nil {
+		return "Secret does not exist", false
+	}
+	if input.Secret.Type != corev1.SecretTypeTLS {
+		return fmt.Sprintf("Secret is of type %q, not %q", input.Secret.Type, corev1.SecretTypeTLS), false
+	}
+	if name := input.Secret.Annotations[cmapi.IssuerNameAnnotationKey]; len(name) > 0 {
+		kind := input.Secret.Annotations[cmapi.IssuerKindAnnotationKey]
+		group := input.Secret.Annotations[cmapi.IssuerGroupAnnotationKey]
+		return fmt.Sprintf("Secret was previously issued by %s", formatIssuerRef(name, kind, group)), false
+	}
+
+	violations, err := certificates.SecretDataAltNamesMatchSpec(input.Secret, input.Certificate.Spec)
+	if err != nil {
+		return fmt.Sprintf("Failed to decode stored certificate: %v", err), false
+	}
+	if len(violations) > 0 {
+		return fmt.Sprintf("Existing certificate is not up to date for spec: %v", violations), false
+	}
+
+	if input.IssuerCA == nil {
+		return "The CA of the issuer is not known", false
+	}
+	x509cert, err := pki.DecodeX509CertificateBytes(input.Secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		return fmt.Sprintf("Failed to decode stored certificate: %v", err), false
+	}
+	if !pki.IsSignedBy(x509cert, input.IssuerCA) {
+		return "Existing certificate was not signed by the CA of the issuer", false
+	}
+
+	return "", true
+}
+
 func CurrentCertificateRequestNotValidForSpec(input Input) (string, string, bool) {
 	if input.CurrentRevisionRequest == nil {
 		// Fallback to comparing the Certificate spec with the issued certificate.
@@ -192,10 +285,40 @@ func currentSecretValidForSpec(input Input) (string, string, bool) {
 	return "", "", false
 }
 
+// CurrentCertificateIssuerCAChanged checks whether the CA that the Certificate's issuer
+// signs with has been rotated since the current certificate was issued. The
+// certificate is re-issued if it was not signed by the issuer's current CA
+// key, or if the CA certificate that signed it, as found in the Secret,
+// differs from the issuer's current CA certificate.
+func CurrentCertificateIssuerCAChanged(input Input) (string, string, bool) {
+	if input.IssuerCA == nil {
+		return "", "", false
+	}
+
+	x509cert, err := pki.DecodeX509CertificateBytes(input.Secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		// This case should never happen as it should always be caught by the
+		// secretPublicKeysMatch function beforehand, but handle it just in case.
+		return "InvalidCertificate", fmt.Sprintf("Failed to decode stored certificate: %v", err), true
+	}
+
+	if !pki.IsSignedBy(x509cert, input.IssuerCA) {
+		return IssuerCAChanged, "Re-issuing certificate as it was not signed by the issuer's current CA", true
+	}
+
+	if fingerprint := certificates.IssuerCAFingerprint(input.Secret); len(fingerprint) > 0 &&
+		fingerprint != pki.CertificateFingerprint(input.IssuerCA) {
+		return IssuerCAChanged, "Re-issuing certificate as the issuer's CA certificate has changed", true
+	}
+
+	return "", "", false
+}
+
 // CurrentCertificateNearingExpiry returns a policy function that can be used to
 // check whether an X.509 cert currently issued for a Certificate should be
+// renewed. renewalTime is used to calculate when the certificate should be
 // renewed.
-func CurrentCertificateNearingExpiry(c clock.Clock) Func {
+func CurrentCertificateNearingExpiry(c clock.Clock, renewalTime certificates.RenewalTimeFunc) Func {
 
 	return func(input Input) (string, string, bool) {
 
@@ -210,17 +333,21 @@ func CurrentCertificateNearingExpiry(c clock.Clock) Func {
 			return "InvalidCertificate", fmt.Sprintf("Failed to decode stored certificate: %v", err), true
 		}
 
-		notBefore := metav1.NewTime(x509cert.NotBefore)
-		notAfter := metav1.NewTime(x509cert.NotAfter)
-		crt := input.Certificate
-		renewalTime := certificates.RenewalTime(notBefore.Time, notAfter.Time, crt.Spec.RenewBefore)
+		renewAt := renewalTime(x509cert.NotBefore, x509cert.NotAfter, input.Certificate)
 
-		renewIn := renewalTime.Time.Sub(c.Now())
+		renewIn := renewAt.Time.Sub(c.Now())
 		if renewIn > 0 {
 			//renewal time is in future, no need to renew
 			return "", "", false
 		}
 
+		// Defer the renewal if none of the Certificate's renewal windows
+		// are open. Invalid renewal windows do not prevent renewal.
+		deferUntil, err := certificates.DeferRenewalUntil(input.Certificate, x509cert.NotAfter, c.Now())
+		if err == nil && !deferUntil.IsZero() {
+			return "", "", false
+		}
+
 		return Renewing, fmt.Sprintf("Renewing certificate as renewal was scheduled at %s", input.Certificate.Status.RenewalTime), true
 	}
 }
@@ -245,6 +372,18 @@ func CurrentCertificateHasExpired(c clock.Clock) Func {
 		if c.Now().After(cert.NotAfter) {
 			return Expired, fmt.Sprintf("Certificate expired on %s", cert.NotAfter.Format(time.RFC1123)), true
 		}
+
+		// The certificate of each private key profile must not have expired
+		// either.
+		for _, profile := range input.Certificate.Spec.PrivateKeyProfiles {
+			cert, err := pki.DecodeX509CertificateBytes(input.Secret.Data[certificates.PrivateKeyProfileCertificateKey(profile.Name)])
+			if err != nil {
+				return "InvalidCertificate", fmt.Sprintf("Failed to decode stored certificate of private key profile %q: %v", profile.Name, err), true
+			}
+			if c.Now().After(cert.NotAfter) {
+				return Expired, fmt.Sprintf("Certificate of private key profile %q expired on %s", profile.Name, cert.NotAfter.Format(time.RFC1123)), true
+			}
+		}
 		return "", "", false
 	}
 }
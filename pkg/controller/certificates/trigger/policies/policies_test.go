@@ -17,6 +17,10 @@ limitations under the License.
 package policies
 
 import (
+	"crypto"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
 	"testing"
 	"time"
 
@@ -26,7 +30,9 @@ import (
 
 	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	"github.com/jetstack/cert-manager/pkg/controller/certificates"
 	internaltest "github.com/jetstack/cert-manager/pkg/controller/certificates/internal/test"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
 )
 
 // Runs a full set of tests against the 'policy chain' once it is composed
@@ -379,6 +385,77 @@ func TestDefaultPolicyChain(t *testing.T) {
 			message: "Renewing certificate as renewal was scheduled at 0001-01-01 00:00:00 +0000 UTC",
 			reissue: true,
 		},
+		"do not trigger renewal if no renewal window is open": {
+			certificate: &cmapi.Certificate{
+				Spec: cmapi.CertificateSpec{
+					CommonName: "example.com",
+					IssuerRef: cmmeta.ObjectReference{
+						Name:  "testissuer",
+						Kind:  "IssuerKind",
+						Group: "group.example.com",
+					},
+					RenewBefore: &metav1.Duration{Duration: time.Hour * 24 * 3},
+					RenewalWindows: []cmapi.CertificateRenewalWindow{
+						{Schedule: "0 12 * * *", Duration: metav1.Duration{Duration: time.Hour}},
+					},
+				},
+			},
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "something",
+					Annotations: map[string]string{
+						cmapi.IssuerNameAnnotationKey:  "testissuer",
+						cmapi.IssuerKindAnnotationKey:  "IssuerKind",
+						cmapi.IssuerGroupAnnotationKey: "group.example.com",
+					},
+				},
+				Data: map[string][]byte{
+					corev1.TLSPrivateKeyKey: staticFixedPrivateKey,
+					corev1.TLSCertKey: internaltest.MustCreateCertWithNotBeforeAfter(t, staticFixedPrivateKey,
+						&cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "example.com"}},
+						clock.Now().Add(time.Hour*-24*10),
+						// expires more than a day after the next renewal window opens at 12:00
+						clock.Now().Add(time.Hour*48),
+					),
+				},
+			},
+		},
+		"trigger renewal if certificate expires before the next renewal window opens": {
+			certificate: &cmapi.Certificate{
+				Spec: cmapi.CertificateSpec{
+					CommonName: "example.com",
+					IssuerRef: cmmeta.ObjectReference{
+						Name:  "testissuer",
+						Kind:  "IssuerKind",
+						Group: "group.example.com",
+					},
+					RenewBefore: &metav1.Duration{Duration: time.Hour * 24},
+					RenewalWindows: []cmapi.CertificateRenewalWindow{
+						{Schedule: "0 12 * * *", Duration: metav1.Duration{Duration: time.Hour}},
+					},
+				},
+			},
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "something",
+					Annotations: map[string]string{
+						cmapi.IssuerNameAnnotationKey:  "testissuer",
+						cmapi.IssuerKindAnnotationKey:  "IssuerKind",
+						cmapi.IssuerGroupAnnotationKey: "group.example.com",
+					},
+				},
+				Data: map[string][]byte{
+					corev1.TLSPrivateKeyKey: staticFixedPrivateKey,
+					corev1.TLSCertKey: internaltest.MustCreateCertWithNotBeforeAfter(t, staticFixedPrivateKey,
+						&cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "example.com"}},
+						clock.Now().Add(time.Hour*-24*10),
+						// expires before the next renewal window opens at 12:00
+						clock.Now().Add(time.Hour*10),
+					),
+				},
+			},
+			reason:  Renewing,
+			message: "Renewing certificate as renewal was scheduled at <nil>",
+			reissue: true,
+		},
 		"trigger renewal if renewalTime is in the past": {
 			certificate: &cmapi.Certificate{
 				Spec: cmapi.CertificateSpec{
@@ -485,7 +562,7 @@ func TestDefaultPolicyChain(t *testing.T) {
 			},
 		},
 	}
-	policyChain := NewTriggerPolicyChain(clock)
+	policyChain := NewTriggerPolicyChain(clock, certificates.NewRenewalTimeFunc(certificates.RenewalOptions{}))
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
 			reason, message, reissue := policyChain.Evaluate(Input{
@@ -506,3 +583,273 @@ func TestDefaultPolicyChain(t *testing.T) {
 		})
 	}
 }
+
+func TestCurrentCertificateIssuerCAChanged(t *testing.T) {
+	ca := mustCreateCA(t, "ca")
+	// the same CA certificate re-issued with the same key
+	caReissued := mustSignCA(t, "ca", ca.key)
+	// a new CA with the same name but a different key
+	caRotated := mustCreateCA(t, "ca")
+
+	leaf := mustSignLeaf(t, ca)
+
+	tests := map[string]struct {
+		issuerCA *x509.Certificate
+		caPEM    []byte
+		reissue  bool
+		message  string
+	}{
+		"do nothing if the issuer CA is not known": {
+			caPEM: ca.pem,
+		},
+		"do nothing if the certificate was signed by the issuer's current CA": {
+			issuerCA: ca.cert,
+			caPEM:    ca.pem,
+		},
+		"do nothing if the signing CA cannot be found in the Secret but the key is unchanged": {
+			issuerCA: caReissued.cert,
+		},
+		"re-issue if the issuer's CA key has changed": {
+			issuerCA: caRotated.cert,
+			caPEM:    ca.pem,
+			reissue:  true,
+			message:  "Re-issuing certificate as it was not signed by the issuer's current CA",
+		},
+		"re-issue if the issuer's CA certificate has changed": {
+			issuerCA: caReissued.cert,
+			caPEM:    ca.pem,
+			reissue:  true,
+			message:  "Re-issuing certificate as the issuer's CA certificate has changed",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			reason, message, reissue := CurrentCertificateIssuerCAChanged(Input{
+				Certificate: &cmapi.Certificate{},
+				Secret: &corev1.Secret{Data: map[string][]byte{
+					corev1.TLSCertKey: leaf,
+					cmmeta.TLSCAKey:   test.caPEM,
+				}},
+				IssuerCA: test.issuerCA,
+			})
+			if reissue != test.reissue {
+				t.Fatalf("expected reissue=%v, got %v (%s)", test.reissue, reissue, message)
+			}
+			if test.reissue && (reason != IssuerCAChanged || message != test.message) {
+				t.Errorf("unexpected reason %q and message %q", reason, message)
+			}
+		})
+	}
+}
+
+func TestSecretPrivateKeyProfilesNotUpToDate(t *testing.T) {
+	ca := mustCreateCA(t, "profile")
+	other := mustCreateCA(t, "other")
+	keyPEM, err := pki.EncodePKCS8PrivateKey(ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ecdsaProfile := cmapi.CertificatePrivateKeyProfile{
+		Name:       "ecdsa",
+		PrivateKey: cmapi.CertificatePrivateKey{Algorithm: cmapi.ECDSAKeyAlgorithm, Size: 256},
+	}
+	rsaProfile := cmapi.CertificatePrivateKeyProfile{
+		Name:       "ecdsa",
+		PrivateKey: cmapi.CertificatePrivateKey{Algorithm: cmapi.RSAKeyAlgorithm},
+	}
+
+	tests := map[string]struct {
+		profiles []cmapi.CertificatePrivateKeyProfile
+		data     map[string][]byte
+		reason   string
+	}{
+		"do nothing if there are no private key profiles": {},
+		"do nothing if the private key profiles are up to date": {
+			profiles: []cmapi.CertificatePrivateKeyProfile{ecdsaProfile},
+			data: map[string][]byte{
+				"tls-ecdsa.crt": ca.pem,
+				"tls-ecdsa.key": keyPEM,
+			},
+		},
+		"re-issue if the data of a private key profile is missing": {
+			profiles: []cmapi.CertificatePrivateKeyProfile{ecdsaProfile},
+			data: map[string][]byte{
+				"tls-ecdsa.crt": ca.pem,
+			},
+			reason: MissingData,
+		},
+		"re-issue if the certificate and private key of a private key profile do not match": {
+			profiles: []cmapi.CertificatePrivateKeyProfile{ecdsaProfile},
+			data: map[string][]byte{
+				"tls-ecdsa.crt": other.pem,
+				"tls-ecdsa.key": keyPEM,
+			},
+			reason: InvalidKeyPair,
+		},
+		"re-issue if the private key of a private key profile does not match its spec": {
+			profiles: []cmapi.CertificatePrivateKeyProfile{rsaProfile},
+			data: map[string][]byte{
+				"tls-ecdsa.crt": ca.pem,
+				"tls-ecdsa.key": keyPEM,
+			},
+			reason: SecretMismatch,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			reason, message, reissue := SecretPrivateKeyProfilesNotUpToDate(Input{
+				Certificate: &cmapi.Certificate{Spec: cmapi.CertificateSpec{PrivateKeyProfiles: test.profiles}},
+				Secret:      &corev1.Secret{Data: test.data},
+			})
+			if reissue != (len(test.reason) > 0) || reason != test.reason {
+				t.Errorf("expected reason %q, got %q (%s)", test.reason, reason, message)
+			}
+		})
+	}
+}
+
+func TestSecretAdoptable(t *testing.T) {
+	ca := mustCreateCA(t, "ca")
+	otherCA := mustCreateCA(t, "other-ca")
+	leaf := mustSignLeaf(t, ca)
+
+	adoptAnnotation := map[string]string{cmapi.AdoptExistingSecretAnnotation: "true"}
+	tlsSecret := func(annotations map[string]string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Annotations: annotations},
+			Type:       corev1.SecretTypeTLS,
+			Data:       map[string][]byte{corev1.TLSCertKey: leaf},
+		}
+	}
+
+	tests := map[string]struct {
+		annotations map[string]string
+		commonName  string
+		secret      *corev1.Secret
+		issuerCA    *x509.Certificate
+		adoptable   bool
+		message     string
+	}{
+		"adopt a matching Secret signed by the issuer's CA": {
+			annotations: adoptAnnotation,
+			commonName:  "leaf",
+			secret:      tlsSecret(nil),
+			issuerCA:    ca.cert,
+			adoptable:   true,
+		},
+		"do not adopt if the Certificate does not request it": {
+			commonName: "leaf",
+			secret:     tlsSecret(nil),
+			issuerCA:   ca.cert,
+			message:    "Certificate does not request adoption of existing Secrets",
+		},
+		"do not adopt Secrets that are not TLS Secrets": {
+			annotations: adoptAnnotation,
+			commonName:  "leaf",
+			secret: &corev1.Secret{
+				Type: corev1.SecretTypeOpaque,
+				Data: map[string][]byte{corev1.TLSCertKey: leaf},
+			},
+			issuerCA: ca.cert,
+			message:  `Secret is of type "Opaque", not "kubernetes.io/tls"`,
+		},
+		"do not adopt Secrets issued by cert-manager": {
+			annotations: adoptAnnotation,
+			commonName:  "leaf",
+			secret: tlsSecret(map[string]string{
+				cmapi.IssuerNameAnnotationKey: "other-issuer",
+				cmapi.IssuerKindAnnotationKey: "ClusterIssuer",
+			}),
+			issuerCA: ca.cert,
+			message:  "Secret was previously issued by ClusterIssuer.cert-manager.io/other-issuer",
+		},
+		"do not adopt if the certificate does not match the spec": {
+			annotations: adoptAnnotation,
+			commonName:  "example.com",
+			secret:      tlsSecret(nil),
+			issuerCA:    ca.cert,
+			message:     `Existing certificate is not up to date for spec: [spec.commonName]`,
+		},
+		"do not adopt if the issuer's CA is not known": {
+			annotations: adoptAnnotation,
+			commonName:  "leaf",
+			secret:      tlsSecret(nil),
+			message:     "The CA of the issuer is not known",
+		},
+		"do not adopt if the certificate was not signed by the issuer's CA": {
+			annotations: adoptAnnotation,
+			commonName:  "leaf",
+			secret:      tlsSecret(nil),
+			issuerCA:    otherCA.cert,
+			message:     "Existing certificate was not signed by the CA of the issuer",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			message, adoptable := SecretAdoptable(Input{
+				Certificate: &cmapi.Certificate{
+					ObjectMeta: metav1.ObjectMeta{Annotations: test.annotations},
+					Spec:       cmapi.CertificateSpec{CommonName: test.commonName},
+				},
+				Secret:   test.secret,
+				IssuerCA: test.issuerCA,
+			})
+			if adoptable != test.adoptable {
+				t.Fatalf("expected adoptable=%v, got %v (%s)", test.adoptable, adoptable, message)
+			}
+			if message != test.message {
+				t.Errorf("expected message %q, got %q", test.message, message)
+			}
+		})
+	}
+}
+
+type testCA struct {
+	cert *x509.Certificate
+	key  crypto.Signer
+	pem  []byte
+}
+
+func mustCreateCA(t *testing.T, cn string) testCA {
+	key, err := pki.GenerateECPrivateKey(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return mustSignCA(t, cn, key)
+}
+
+func mustSignCA(t *testing.T, cn string, key crypto.Signer) testCA {
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: cn},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+	}
+	pem, cert, err := pki.SignCertificate(template, template, key.Public(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return testCA{cert: cert, key: key, pem: pem}
+}
+
+func mustSignLeaf(t *testing.T, ca testCA) []byte {
+	key, err := pki.GenerateECPrivateKey(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "leaf"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	pem, _, err := pki.SignCertificate(template, ca.cert, key.Public(), ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem
+}
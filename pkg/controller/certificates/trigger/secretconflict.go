@@ -0,0 +1,177 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trigger
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	"github.com/jetstack/cert-manager/pkg/controller/certificates"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+)
+
+const (
+	// secretNameIndex is the name of the Certificate informer index that
+	// indexes Certificates by the namespace and name of their
+	// spec.secretName.
+	secretNameIndex = "spec.secretName"
+)
+
+// secretNameIndexFunc indexes a Certificate by its namespace and
+// spec.secretName.
+func secretNameIndexFunc(obj interface{}) ([]string, error) {
+	crt, ok := obj.(*cmapi.Certificate)
+	if !ok {
+		return nil, nil
+	}
+	return []string{secretNameIndexKey(crt.Namespace, crt.Spec.SecretName)}, nil
+}
+
+func secretNameIndexKey(namespace, secretName string) string {
+	return namespace + "/" + secretName
+}
+
+// enqueueCertificatesWithSameSecretName returns an event handler that
+// enqueues all Certificates sharing a spec.secretName with a Certificate that
+// has changed, so that a conflict between them is re-evaluated when one of
+// them is updated or deleted.
+func enqueueCertificatesWithSameSecretName(log logr.Logger, queue workqueue.Interface, indexer cache.Indexer) cache.ResourceEventHandler {
+	enqueue := func(obj interface{}) {
+		if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+			obj = tombstone.Obj
+		}
+		crt, ok := obj.(*cmapi.Certificate)
+		if !ok {
+			return
+		}
+
+		peers, err := indexer.ByIndex(secretNameIndex, secretNameIndexKey(crt.Namespace, crt.Spec.SecretName))
+		if err != nil {
+			log.Error(err, "failed to list certificates with the same secretName", "namespace", crt.Namespace, "secretName", crt.Spec.SecretName)
+			return
+		}
+		for _, peer := range peers {
+			peerCrt := peer.(*cmapi.Certificate)
+			if peerCrt.Name == crt.Name {
+				continue
+			}
+			key, err := cache.MetaNamespaceKeyFunc(peerCrt)
+			if err != nil {
+				log.Error(err, "failed to compute key for certificate")
+				continue
+			}
+			queue.Add(key)
+		}
+	}
+
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: enqueue,
+		UpdateFunc: func(old, new interface{}) {
+			oldCrt, oldOK := old.(*cmapi.Certificate)
+			newCrt, newOK := new.(*cmapi.Certificate)
+			if oldOK && newOK && oldCrt.Spec.SecretName == newCrt.Spec.SecretName {
+				return
+			}
+			enqueue(old)
+			enqueue(new)
+		},
+		DeleteFunc: enqueue,
+	}
+}
+
+// conflictingCertificate returns the Certificate that takes precedence over
+// the given Certificate for their shared spec.secretName, if any. The oldest
+// Certificate takes precedence, so that a Secret already being managed is
+// not taken over by a copy of its Certificate.
+func (c *controller) conflictingCertificate(crt *cmapi.Certificate) (*cmapi.Certificate, error) {
+	peers, err := c.certificateIndexer.ByIndex(secretNameIndex, secretNameIndexKey(crt.Namespace, crt.Spec.SecretName))
+	if err != nil {
+		return nil, err
+	}
+
+	var winner *cmapi.Certificate
+	for _, peer := range peers {
+		peerCrt := peer.(*cmapi.Certificate)
+		if peerCrt.Name == crt.Name || !takesPrecedence(peerCrt, crt) {
+			continue
+		}
+		if winner == nil || takesPrecedence(peerCrt, winner) {
+			winner = peerCrt
+		}
+	}
+
+	return winner, nil
+}
+
+// takesPrecedence returns true if Certificate a was created before b, using
+// the name to break ties.
+func takesPrecedence(a, b *cmapi.Certificate) bool {
+	if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return a.CreationTimestamp.Before(&b.CreationTimestamp)
+	}
+	return a.Name < b.Name
+}
+
+// setSecretConflict marks the Certificate as not issuing because it shares
+// its spec.secretName with the given Certificate.
+func (c *controller) setSecretConflict(ctx context.Context, crt, conflict *cmapi.Certificate) error {
+	message := fmt.Sprintf("Secret %q is also the spec.secretName of Certificate %q, which takes precedence. "+
+		"This Certificate will not be issued until the conflict is resolved", crt.Spec.SecretName, conflict.Name)
+
+	if cond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionIssuing); cond != nil &&
+		cond.Status == cmmeta.ConditionFalse && cond.Reason == certificates.SecretConflictReason && cond.Message == message {
+		return nil
+	}
+
+	logf.FromContext(ctx).V(logf.InfoLevel).Info("Not issuing certificate as its secretName conflicts with another certificate", "conflict", conflict.Name)
+
+	crt = crt.DeepCopy()
+	apiutil.SetCertificateCondition(crt, crt.Generation, cmapi.CertificateConditionIssuing, cmmeta.ConditionFalse, certificates.SecretConflictReason, message)
+	if _, err := c.client.CertmanagerV1().Certificates(crt.Namespace).UpdateStatus(ctx, crt, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+	c.recorder.Event(crt, corev1.EventTypeWarning, certificates.SecretConflictReason, message)
+
+	return nil
+}
+
+// clearSecretConflict removes an Issuing=False condition left by a secretName
+// conflict that has since been resolved. It returns true if the condition was
+// removed.
+func (c *controller) clearSecretConflict(ctx context.Context, crt *cmapi.Certificate) (bool, error) {
+	cond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionIssuing)
+	if cond == nil || cond.Reason != certificates.SecretConflictReason {
+		return false, nil
+	}
+
+	crt = crt.DeepCopy()
+	apiutil.RemoveCertificateCondition(crt, cmapi.CertificateConditionIssuing)
+	if _, err := c.client.CertmanagerV1().Certificates(crt.Namespace).UpdateStatus(ctx, crt, metav1.UpdateOptions{}); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
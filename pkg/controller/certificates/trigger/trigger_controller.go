@@ -18,6 +18,7 @@ package trigger
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -25,10 +26,13 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
 	corelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/flowcontrol"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/utils/clock"
 
@@ -40,13 +44,21 @@ import (
 	cmlisters "github.com/jetstack/cert-manager/pkg/client/listers/certmanager/v1"
 	controllerpkg "github.com/jetstack/cert-manager/pkg/controller"
 	"github.com/jetstack/cert-manager/pkg/controller/certificates"
+	"github.com/jetstack/cert-manager/pkg/controller/certificates/internal/secretsmanager"
 	"github.com/jetstack/cert-manager/pkg/controller/certificates/trigger/policies"
+	"github.com/jetstack/cert-manager/pkg/issuer"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
 	"github.com/jetstack/cert-manager/pkg/scheduler"
 	"github.com/jetstack/cert-manager/pkg/util/predicate"
 )
 
-const ControllerName = "certificates-trigger"
+const (
+	ControllerName = "certificates-trigger"
+
+	// reasonAdopted is the reason of the event fired when an existing Secret
+	// is taken over without re-issuing the certificate it contains.
+	reasonAdopted = "Adopted"
+)
 
 // This controller observes the state of the certificate's currently
 // issued `spec.secretName` and the rest of the `certificate.spec` fields to
@@ -55,12 +67,27 @@ const ControllerName = "certificates-trigger"
 // certificate is required.
 type controller struct {
 	certificateLister        cmlisters.CertificateLister
+	certificateIndexer       cache.Indexer
 	certificateRequestLister cmlisters.CertificateRequestLister
 	secretLister             corelisters.SecretLister
 	client                   cmclient.Interface
 	recorder                 record.EventRecorder
 	scheduledWorkQueue       scheduler.ScheduledWorkQueue
 
+	// secretsManager is used to take over existing Secrets that were not
+	// issued by cert-manager.
+	secretsManager *secretsmanager.SecretsManager
+
+	// issuerCAReissueLimiter bounds the rate at which Certificates are
+	// re-issued because their issuer's CA has been rotated.
+	issuerCAReissueLimiter flowcontrol.RateLimiter
+
+	// retryBackoffMin and retryBackoffMax are the default bounds of the
+	// backoff applied before re-issuing a Certificate after a failed
+	// issuance.
+	retryBackoffMin time.Duration
+	retryBackoffMax time.Duration
+
 	// The following are used for testing purposes.
 	clock              clock.Clock
 	shouldReissue      policies.Func
@@ -69,13 +96,16 @@ type controller struct {
 
 func NewController(
 	log logr.Logger,
+	kubeClient kubernetes.Interface,
 	client cmclient.Interface,
 	factory informers.SharedInformerFactory,
 	cmFactory cminformers.SharedInformerFactory,
 	recorder record.EventRecorder,
 	clock clock.Clock,
 	shouldReissue policies.Func,
-) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced) {
+	issuerOptions controllerpkg.IssuerOptions,
+	certificateOptions controllerpkg.CertificateOptions,
+) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced, error) {
 	// create a queue used to queue up items to be processed
 	queue := workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Second*1, time.Second*30), ControllerName)
 
@@ -83,8 +113,19 @@ func NewController(
 	certificateInformer := cmFactory.Certmanager().V1().Certificates()
 	certificateRequestInformer := cmFactory.Certmanager().V1().CertificateRequests()
 	secretsInformer := factory.Core().V1().Secrets()
+	issuerInformer := cmFactory.Certmanager().V1().Issuers()
+	clusterIssuerInformer := cmFactory.Certmanager().V1().ClusterIssuers()
+
+	// Certificates are indexed by their spec.secretName so that Certificates
+	// writing to the same Secret can be found.
+	if err := certificateInformer.Informer().AddIndexers(cache.Indexers{secretNameIndex: secretNameIndexFunc}); err != nil {
+		return nil, nil, nil, err
+	}
 
 	certificateInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: queue})
+	// When a Certificate changes, enqueue the Certificates that share its
+	// spec.secretName so that any conflict between them is re-evaluated.
+	certificateInformer.Informer().AddEventHandler(enqueueCertificatesWithSameSecretName(log, queue, certificateInformer.Informer().GetIndexer()))
 
 	// When a CertificateRequest resource changes, enqueue the Certificate resource that owns it.
 	certificateRequestInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
@@ -96,6 +137,13 @@ func NewController(
 		WorkFunc: certificates.EnqueueCertificatesForResourceUsingPredicates(log, queue, certificateInformer.Lister(), labels.Everything(),
 			predicate.ExtractResourceName(predicate.CertificateSecretName)),
 	})
+	// When a Secret used as the CA of an Issuer or ClusterIssuer changes,
+	// enqueue the Certificate resources issued by it so they can be
+	// re-issued if the CA has been rotated.
+	secretsInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		WorkFunc: enqueueCertificatesForIssuerCASecret(log, queue, certificateInformer.Lister(),
+			issuerInformer.Lister(), clusterIssuerInformer.Lister(), issuerOptions.ClusterResourceNamespace),
+	})
 
 	// build a list of InformerSynced functions that will be returned by the Register method.
 	// the controller will only begin processing items once all of these informers have synced.
@@ -103,15 +151,22 @@ func NewController(
 		certificateRequestInformer.Informer().HasSynced,
 		secretsInformer.Informer().HasSynced,
 		certificateInformer.Informer().HasSynced,
+		issuerInformer.Informer().HasSynced,
+		clusterIssuerInformer.Informer().HasSynced,
 	}
 
 	return &controller{
 		certificateLister:        certificateInformer.Lister(),
+		certificateIndexer:       certificateInformer.Informer().GetIndexer(),
 		certificateRequestLister: certificateRequestInformer.Lister(),
 		secretLister:             secretsInformer.Lister(),
 		client:                   client,
 		recorder:                 recorder,
 		scheduledWorkQueue:       scheduler.NewScheduledWorkQueue(clock, queue.Add),
+		secretsManager:           secretsmanager.New(kubeClient, secretsInformer.Lister(), certificateOptions.EnableOwnerRef),
+		issuerCAReissueLimiter:   flowcontrol.NewTokenBucketRateLimiter(issuerCAReissueQPS, issuerCAReissueBurst),
+		retryBackoffMin:          certificateOptions.IssuanceRetryBackoffMin,
+		retryBackoffMax:          certificateOptions.IssuanceRetryBackoffMax,
 
 		// The following are used for testing purposes.
 		clock:         clock,
@@ -119,8 +174,13 @@ func NewController(
 		dataForCertificate: (&policies.Gatherer{
 			CertificateRequestLister: certificateRequestInformer.Lister(),
 			SecretLister:             secretsInformer.Lister(),
+			IssuerCA: (&issuerCAGetter{
+				issuerHelper:  issuer.NewHelper(issuerInformer.Lister(), clusterIssuerInformer.Lister()),
+				secretLister:  secretsInformer.Lister(),
+				issuerOptions: issuerOptions,
+			}).IssuerCA,
 		}).DataForCertificate,
-	}, queue, mustSync
+	}, queue, mustSync, nil
 }
 
 func (c *controller) ProcessItem(ctx context.Context, key string) error {
@@ -148,16 +208,30 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 		return nil
 	}
 
+	// Certificates that share a spec.secretName would overwrite each other's
+	// Secret forever, so only the oldest of them is ever issued.
+	conflict, err := c.conflictingCertificate(crt)
+	if err != nil {
+		return err
+	}
+	if conflict != nil {
+		return c.setSecretConflict(ctx, crt, conflict)
+	}
+	if cleared, err := c.clearSecretConflict(ctx, crt); err != nil || cleared {
+		// The Certificate will be re-queued once the update is observed.
+		return err
+	}
+
 	input, err := c.dataForCertificate(ctx, crt)
 	if err != nil {
 		return err
 	}
 
-	// Back off from re-issuing immediately when the certificate has been
-	// in failing mode for less than 1 hour.
-	backoff, delay := shouldBackoffReissuingOnFailure(log, c.clock, input.Certificate, input.NextRevisionRequest)
+	// Back off from re-issuing immediately when the certificate has failed
+	// more recently than its retry backoff.
+	backoff, delay := shouldBackoffReissuingOnFailure(log, c.clock, input.Certificate, input.NextRevisionRequest, c.retryBackoffMin, c.retryBackoffMax)
 	if backoff {
-		log.V(logf.InfoLevel).Info("Not re-issuing certificate as an attempt has been made in the last hour", "retry_delay", delay)
+		log.V(logf.InfoLevel).Info("Not re-issuing certificate as the retry backoff since the last failed attempt has not elapsed", "retry_delay", delay)
 		c.scheduleRecheckOfCertificateIfRequired(log, key, delay)
 		return nil
 	}
@@ -166,6 +240,18 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 		// ensure a resync is scheduled in the future so that we re-check
 		// Certificate resources and trigger them near expiry time
 		c.scheduleRecheckOfCertificateIfRequired(log, key, crt.Status.RenewalTime.Time.Sub(c.clock.Now()))
+
+		// if the renewal is due but is being deferred until a renewal
+		// window opens, re-check the Certificate once it has opened
+		if !crt.Status.RenewalTime.Time.After(c.clock.Now()) && crt.Status.NotAfter != nil {
+			deferUntil, err := certificates.DeferRenewalUntil(crt, crt.Status.NotAfter.Time, c.clock.Now())
+			if err != nil {
+				log.Error(err, "failed to evaluate renewal windows")
+			} else if !deferUntil.IsZero() {
+				log.V(logf.InfoLevel).Info("Deferring renewal of certificate until the next renewal window opens", "renewal_window", deferUntil)
+				c.scheduleRecheckOfCertificateIfRequired(log, key, deferUntil.Sub(c.clock.Now()))
+			}
+		}
 	}
 
 	reason, message, reissue := c.shouldReissue(input)
@@ -174,6 +260,28 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 		return nil
 	}
 
+	// Take over existing Secrets that were not issued by cert-manager rather
+	// than re-issuing them, if requested and possible.
+	if reason == policies.IncorrectIssuer && crt.Annotations[cmapi.AdoptExistingSecretAnnotation] == "true" {
+		adoptMessage, adoptable := policies.SecretAdoptable(input)
+		if adoptable {
+			if err := c.secretsManager.AdoptSecret(ctx, crt); err != nil {
+				return err
+			}
+			c.recorder.Eventf(crt, corev1.EventTypeNormal, reasonAdopted, "Adopted existing Secret %q without re-issuing the certificate", crt.Spec.SecretName)
+			return nil
+		}
+		log.V(logf.InfoLevel).Info("Not adopting existing Secret", "message", adoptMessage)
+		message = fmt.Sprintf("%s (existing Secret cannot be adopted: %s)", message, adoptMessage)
+	}
+
+	if reason == policies.IssuerCAChanged && !c.issuerCAReissueLimiter.TryAccept() {
+		delay := wait.Jitter(issuerCAReissueRecheckDelay, 1)
+		log.V(logf.InfoLevel).Info("Delaying re-issuance of certificate as too many certificates are being re-issued due to issuer CA rotation", "retry_delay", delay)
+		c.scheduleRecheckOfCertificateIfRequired(log, key, delay)
+		return nil
+	}
+
 	// Although the below recorder.Event already logs the event, the log
 	// line is quite unreadable (very long). Since this information is very
 	// important for the user and the operator, we log the following
@@ -191,14 +299,16 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 	return nil
 }
 
-// shouldBackoffReissuingOnFailure tells us if we should back-off re-issuing for
-// an hour or not. Notably, it returns no back-off when the certificate doesn't
+// shouldBackoffReissuingOnFailure tells us if we should back-off re-issuing or
+// not, and for how long. The back-off grows exponentially with the number of
+// failed issuance attempts, between min and max (see
+// certificates.IssuanceRetryBackoff). Notably, it returns no back-off when the certificate doesn't
 // match the "next" certificate (since a mismatch means that this certificate
 // gets re-issued immediately).
 //
 // Note that the request can be left nil: in that case, the returned back-off
 // will be 0 since it means the CR must be created immediately.
-func shouldBackoffReissuingOnFailure(log logr.Logger, c clock.Clock, crt *cmapi.Certificate, nextCR *cmapi.CertificateRequest) (backoff bool, delay time.Duration) {
+func shouldBackoffReissuingOnFailure(log logr.Logger, c clock.Clock, crt *cmapi.Certificate, nextCR *cmapi.CertificateRequest, min, max time.Duration) (backoff bool, delay time.Duration) {
 	if crt.Status.LastFailureTime == nil {
 		return false, 0
 	}
@@ -228,12 +338,13 @@ func shouldBackoffReissuingOnFailure(log logr.Logger, c clock.Clock, crt *cmapi.
 	}
 
 	now := c.Now()
+	retryAfter := certificates.IssuanceRetryBackoff(crt, min, max)
 	durationSinceFailure := now.Sub(crt.Status.LastFailureTime.Time)
-	if durationSinceFailure >= certificates.RetryAfterLastFailure {
+	if durationSinceFailure >= retryAfter {
 		log.V(logf.ExtendedInfoLevel).WithValues("since_failure", durationSinceFailure).Info("Certificate has been in failure state long enough, no need to back off")
 		return false, 0
 	}
-	return true, certificates.RetryAfterLastFailure - durationSinceFailure
+	return true, retryAfter - durationSinceFailure
 }
 
 // scheduleRecheckOfCertificateIfRequired will schedule the resource with the
@@ -267,14 +378,23 @@ func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.Rate
 	// construct a new named logger to be reused throughout the controller
 	log := logf.FromContext(ctx.RootContext, ControllerName)
 
-	ctrl, queue, mustSync := NewController(log,
+	ctrl, queue, mustSync, err := NewController(log,
+		ctx.Client,
 		ctx.CMClient,
 		ctx.KubeSharedInformerFactory,
 		ctx.SharedInformerFactory,
 		ctx.Recorder,
 		ctx.Clock,
-		policies.NewTriggerPolicyChain(ctx.Clock).Evaluate,
+		policies.NewTriggerPolicyChain(ctx.Clock, certificates.NewRenewalTimeFunc(certificates.RenewalOptions{
+			DefaultRenewBeforePercentage: ctx.CertificateOptions.DefaultRenewBeforePercentage,
+			DefaultJitter:                ctx.CertificateOptions.DefaultRenewalJitter,
+		})).Evaluate,
+		ctx.IssuerOptions,
+		ctx.CertificateOptions,
 	)
+	if err != nil {
+		return nil, nil, err
+	}
 	c.controller = ctrl
 
 	return queue, mustSync, nil
@@ -55,6 +55,10 @@ func Test_controller_ProcessItem(t *testing.T) {
 		// passed to ProcessItem instead.
 		existingCertificate *cmapi.Certificate
 
+		// otherCertificates are Certificates that exist alongside the
+		// Certificate being synced.
+		otherCertificates []*cmapi.Certificate
+
 		mockDataForCertificateReturn    policies.Input
 		mockDataForCertificateReturnErr error
 		wantDataForCertificateCalled    bool
@@ -130,6 +134,58 @@ func Test_controller_ProcessItem(t *testing.T) {
 				}
 			},
 		},
+		"should set Issuing=False if an older Certificate has the same secretName": {
+			existingCertificate: gen.Certificate("cert-2", gen.SetCertificateNamespace("testns"),
+				gen.SetCertificateSecretName("secret-1"),
+				gen.SetCertificateGeneration(42),
+				gen.SetCertificateCreationTimestamp(fixedNow),
+			),
+			otherCertificates: []*cmapi.Certificate{
+				gen.Certificate("cert-1", gen.SetCertificateNamespace("testns"),
+					gen.SetCertificateSecretName("secret-1"),
+					gen.SetCertificateCreationTimestamp(metav1.NewTime(fixedNow.Add(-time.Hour))),
+				),
+			},
+			wantEvent: `Warning SecretConflict Secret "secret-1" is also the spec.secretName of Certificate "cert-1", which takes precedence. This Certificate will not be issued until the conflict is resolved`,
+			wantConditions: []cmapi.CertificateCondition{{
+				Type:               "Issuing",
+				Status:             "False",
+				Reason:             "SecretConflict",
+				Message:            `Secret "secret-1" is also the spec.secretName of Certificate "cert-1", which takes precedence. This Certificate will not be issued until the conflict is resolved`,
+				LastTransitionTime: &fixedNow,
+				ObservedGeneration: 42,
+			}},
+		},
+		"should not be blocked by a newer Certificate with the same secretName": {
+			existingCertificate: gen.Certificate("cert-1", gen.SetCertificateNamespace("testns"),
+				gen.SetCertificateSecretName("secret-1"),
+				gen.SetCertificateCreationTimestamp(metav1.NewTime(fixedNow.Add(-time.Hour))),
+			),
+			otherCertificates: []*cmapi.Certificate{
+				gen.Certificate("cert-2", gen.SetCertificateNamespace("testns"),
+					gen.SetCertificateSecretName("secret-1"),
+					gen.SetCertificateCreationTimestamp(fixedNow),
+				),
+			},
+			wantDataForCertificateCalled: true,
+			wantShouldReissueCalled:      true,
+			mockShouldReissue: func(*testing.T) policies.Func {
+				return func(policies.Input) (string, string, bool) {
+					return "", "", false
+				}
+			},
+		},
+		"should remove the SecretConflict condition once the conflict has been resolved": {
+			existingCertificate: gen.Certificate("cert-2", gen.SetCertificateNamespace("testns"),
+				gen.SetCertificateSecretName("secret-1"),
+				gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+					Type:   "Issuing",
+					Status: "False",
+					Reason: "SecretConflict",
+				}),
+			),
+			wantConditions: []cmapi.CertificateCondition{},
+		},
 		"should log error when dataForCertificate errors": {
 			existingCertificate:             gen.Certificate("cert-1", gen.SetCertificateNamespace("testns")),
 			wantDataForCertificateCalled:    true,
@@ -240,6 +296,9 @@ func Test_controller_ProcessItem(t *testing.T) {
 			if test.existingCertificate != nil {
 				builder.CertManagerObjects = append(builder.CertManagerObjects, test.existingCertificate)
 			}
+			for _, crt := range test.otherCertificates {
+				builder.CertManagerObjects = append(builder.CertManagerObjects, crt)
+			}
 			builder.Init()
 
 			w := &controllerWrapper{}
@@ -275,6 +334,9 @@ func Test_controller_ProcessItem(t *testing.T) {
 				}
 				expectedCert := test.existingCertificate.DeepCopy()
 				expectedCert.Status.Conditions = test.wantConditions
+				if len(test.wantConditions) == 0 {
+					expectedCert.Status.Conditions = nil
+				}
 				builder.ExpectedActions = append(builder.ExpectedActions,
 					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
 						cmapi.SchemeGroupVersion.WithResource("certificates"),
@@ -437,10 +499,42 @@ func Test_shouldBackoffReissuingOnFailure(t *testing.T) {
 			)),
 			wantBackoff: false,
 		},
+		"should back off from reissuing for longer after several failed attempts": {
+			givenCert: gen.Certificate("cert-1", gen.SetCertificateNamespace("testns"),
+				gen.SetCertificateUID("cert-1-uid"),
+				gen.SetCertificateRevision(1),
+				gen.SetCertificateDNSNames("example.com"),
+				gen.SetCertificateLastFailureTime(metav1.NewTime(clock.Now().Add(-3*time.Hour))),
+				gen.SetCertificateFailedIssuanceAttempts(3),
+			),
+			givenNextCR: createCertificateRequestOrPanic(gen.Certificate("cert-1", gen.SetCertificateNamespace("testns"),
+				gen.SetCertificateUID("cert-1-uid"),
+				gen.SetCertificateRevision(1),
+				gen.SetCertificateDNSNames("example.com"),
+			)),
+			wantBackoff: true,
+			wantDelay:   1 * time.Hour,
+		},
+		"should use the retry backoff configured on the certificate": {
+			givenCert: gen.Certificate("cert-1", gen.SetCertificateNamespace("testns"),
+				gen.SetCertificateUID("cert-1-uid"),
+				gen.SetCertificateRevision(1),
+				gen.SetCertificateDNSNames("example.com"),
+				gen.SetCertificateLastFailureTime(metav1.NewTime(clock.Now().Add(-1*time.Minute))),
+				gen.SetCertificateRetryBackoff(5*time.Minute, 10*time.Minute),
+			),
+			givenNextCR: createCertificateRequestOrPanic(gen.Certificate("cert-1", gen.SetCertificateNamespace("testns"),
+				gen.SetCertificateUID("cert-1-uid"),
+				gen.SetCertificateRevision(1),
+				gen.SetCertificateDNSNames("example.com"),
+			)),
+			wantBackoff: true,
+			wantDelay:   4 * time.Minute,
+		},
 	}
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
-			gotBackoff, gotDelay := shouldBackoffReissuingOnFailure(logtest.TestLogger{T: t}, clock, test.givenCert, test.givenNextCR)
+			gotBackoff, gotDelay := shouldBackoffReissuingOnFailure(logtest.TestLogger{T: t}, clock, test.givenCert, test.givenNextCR, 0, 0)
 			assert.Equal(t, test.wantBackoff, gotBackoff)
 			assert.Equal(t, test.wantDelay, gotDelay)
 		})
@@ -22,6 +22,7 @@ import (
 	"crypto/ed25519"
 	"crypto/rsa"
 	"fmt"
+	"hash/fnv"
 	"reflect"
 	"time"
 
@@ -34,11 +35,62 @@ import (
 	"github.com/jetstack/cert-manager/pkg/util/pki"
 )
 
-// The amount of time after the LastFailureTime of a Certificate
-// before the request should be retried.
-// In future this should be replaced with a more dynamic exponential
-// back-off algorithm.
-const RetryAfterLastFailure = time.Hour
+const (
+	// DefaultIssuanceRetryBackoffMin is the default amount of time after the
+	// LastFailureTime of a Certificate before issuance is first retried.
+	DefaultIssuanceRetryBackoffMin = time.Hour
+
+	// DefaultIssuanceRetryBackoffMax is the default maximum amount of time
+	// after the LastFailureTime of a Certificate before issuance is retried.
+	DefaultIssuanceRetryBackoffMax = time.Hour * 32
+
+	// SecretConflictReason is the reason of the Issuing=False condition set
+	// on Certificates that share a spec.secretName with another Certificate
+	// that takes precedence.
+	SecretConflictReason = "SecretConflict"
+)
+
+// IssuanceRetryBackoff returns the amount of time after the LastFailureTime
+// of the Certificate before issuance should be retried. The backoff starts at
+// min after the first failure and doubles with each further consecutive
+// failure, up to max. The Certificate's spec.retryBackoff takes precedence
+// over min and max, which default to DefaultIssuanceRetryBackoffMin and
+// DefaultIssuanceRetryBackoffMax if zero.
+func IssuanceRetryBackoff(crt *cmapi.Certificate, min, max time.Duration) time.Duration {
+	if min <= 0 {
+		min = DefaultIssuanceRetryBackoffMin
+	}
+	if max <= 0 {
+		max = DefaultIssuanceRetryBackoffMax
+	}
+	if b := crt.Spec.RetryBackoff; b != nil {
+		if b.Min != nil {
+			min = b.Min.Duration
+		}
+		if b.Max != nil {
+			max = b.Max.Duration
+		}
+	}
+	if max < min {
+		max = min
+	}
+
+	// Certificates that failed before the number of attempts was recorded
+	// are treated as having failed once.
+	attempts := 1
+	if crt.Status.FailedIssuanceAttempts != nil && *crt.Status.FailedIssuanceAttempts > 1 {
+		attempts = *crt.Status.FailedIssuanceAttempts
+	}
+
+	backoff := min
+	for i := 1; i < attempts && backoff < max; i++ {
+		backoff *= 2
+	}
+	if backoff > max {
+		backoff = max
+	}
+	return backoff
+}
 
 // PrivateKeyMatchesSpec returns an error if the private key bit size
 // doesn't match the provided spec. RSA, Ed25519 and ECDSA are supported.
@@ -283,14 +335,47 @@ func GenerateLocallySignedTemporaryCertificate(crt *cmapi.Certificate, pkData []
 	return b, nil
 }
 
-//RenewalTimeFunc is a custom function type for calculating renewal time of a certificate.
-type RenewalTimeFunc func(time.Time, time.Time, *metav1.Duration) *metav1.Time
+// RenewalTimeFunc is a custom function type for calculating renewal time of a certificate.
+type RenewalTimeFunc func(notBefore, notAfter time.Time, crt *cmapi.Certificate) *metav1.Time
+
+// RenewalOptions holds the controller-wide defaults used when calculating
+// the renewal time of a Certificate that does not configure them itself.
+type RenewalOptions struct {
+	// DefaultRenewBeforePercentage is used for Certificates that set neither
+	// spec.renewBefore nor spec.renewBeforePercentage. Zero means 2/3 through
+	// the certificate's lifetime.
+	DefaultRenewBeforePercentage int32
+
+	// DefaultJitter is used for Certificates that do not set
+	// spec.renewalJitter. Zero disables jitter.
+	DefaultJitter time.Duration
+}
+
+// NewRenewalTimeFunc returns a RenewalTimeFunc that calculates the renewal
+// time using the Certificate's spec, falling back to the given defaults, and
+// then brings it forward by the Certificate's renewal jitter.
+func NewRenewalTimeFunc(opts RenewalOptions) RenewalTimeFunc {
+	return func(notBefore, notAfter time.Time, crt *cmapi.Certificate) *metav1.Time {
+		renewBeforePercentage := crt.Spec.RenewBeforePercentage
+		if renewBeforePercentage == nil && opts.DefaultRenewBeforePercentage > 0 {
+			renewBeforePercentage = &opts.DefaultRenewBeforePercentage
+		}
+		rt := RenewalTime(notBefore, notAfter, crt.Spec.RenewBefore, renewBeforePercentage)
+
+		jitter := opts.DefaultJitter
+		if crt.Spec.RenewalJitter != nil {
+			jitter = crt.Spec.RenewalJitter.Duration
+		}
+		return applyRenewalJitter(rt, notBefore, jitter, crt)
+	}
+}
 
 // RenewalTime calculates renewal time for a certificate. Default renewal time
 // is 2/3 through certificate's lifetime. If user has configured
 // spec.renewBefore, renewal time will be renewBefore period before expiry
-// (unless that is after the expiry).
-func RenewalTime(notBefore, notAfter time.Time, renewBeforeOverride *metav1.Duration) *metav1.Time {
+// (unless that is after the expiry). Otherwise, if renewBeforePercentage is
+// set, renewal time will be that percentage of the lifetime before expiry.
+func RenewalTime(notBefore, notAfter time.Time, renewBeforeOverride *metav1.Duration, renewBeforePercentage *int32) *metav1.Time {
 
 	// 1. Calculate how long before expiry a cert should be renewed
 
@@ -303,6 +388,9 @@ func RenewalTime(notBefore, notAfter time.Time, renewBeforeOverride *metav1.Dura
 	// longer lived certs more frequently.
 	if renewBeforeOverride != nil && renewBeforeOverride.Duration < actualDuration {
 		renewBefore = renewBeforeOverride.Duration
+	} else if renewBeforeOverride == nil && renewBeforePercentage != nil &&
+		*renewBeforePercentage > 0 && *renewBeforePercentage < 100 {
+		renewBefore = actualDuration * time.Duration(*renewBeforePercentage) / 100
 	}
 
 	// 2. Calculate when a cert should be renewed
@@ -310,3 +398,25 @@ func RenewalTime(notBefore, notAfter time.Time, renewBeforeOverride *metav1.Dura
 	rt := metav1.NewTime(notAfter.Add(-1 * renewBefore))
 	return &rt
 }
+
+// applyRenewalJitter brings the renewal time forward by an offset of up to
+// jitter, but never to before notBefore. The offset is derived from the
+// Certificate's identity and notBefore so that it is stable between
+// calculations for the same issuance, whilst differing between Certificates
+// and between successive issuances of the same Certificate.
+func applyRenewalJitter(rt *metav1.Time, notBefore time.Time, jitter time.Duration, crt *cmapi.Certificate) *metav1.Time {
+	if jitter <= 0 {
+		return rt
+	}
+
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%s/%s/%s/%d", crt.Namespace, crt.Name, crt.UID, notBefore.Unix())
+	offset := time.Duration(h.Sum64() % uint64(jitter))
+
+	jittered := rt.Add(-offset)
+	if jittered.Before(notBefore) {
+		jittered = notBefore
+	}
+	t := metav1.NewTime(jittered)
+	return &t
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 
 	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
 	"github.com/jetstack/cert-manager/pkg/util/pki"
@@ -300,6 +301,7 @@ func TestRenewalTime(t *testing.T) {
 		notBefore           time.Time
 		notAfter            time.Time
 		renewBeforeOverride *metav1.Duration
+		renewBeforePercent  *int32
 		expectedRenewalTime *metav1.Time
 	}
 	now := time.Now()
@@ -343,12 +345,150 @@ func TestRenewalTime(t *testing.T) {
 			renewBeforeOverride: &metav1.Duration{Duration: time.Hour * 24},
 			expectedRenewalTime: &metav1.Time{Time: now.Add(time.Minute * 3)}, // renew in 3 minutes
 		},
+		"renewBeforePercentage is set": {
+			notBefore:           now,
+			notAfter:            now.Add(time.Hour * 100),
+			renewBeforePercent:  int32Ptr(25),
+			expectedRenewalTime: &metav1.Time{Time: now.Add(time.Hour * 75)},
+		},
+		"spec.renewBefore takes precedence over renewBeforePercentage": {
+			notBefore:           now,
+			notAfter:            now.Add(time.Hour * 100),
+			renewBeforeOverride: &metav1.Duration{Duration: time.Hour * 10},
+			renewBeforePercent:  int32Ptr(25),
+			expectedRenewalTime: &metav1.Time{Time: now.Add(time.Hour * 90)},
+		},
 	}
 	for n, s := range tests {
 		t.Run(n, func(t *testing.T) {
-			renewalTime := RenewalTime(s.notBefore, s.notAfter, s.renewBeforeOverride)
+			renewalTime := RenewalTime(s.notBefore, s.notAfter, s.renewBeforeOverride, s.renewBeforePercent)
 			assert.Equal(t, s.expectedRenewalTime, renewalTime, fmt.Sprintf("Expected renewal time: %v got: %v", s.expectedRenewalTime, renewalTime))
 
 		})
 	}
 }
+
+func TestNewRenewalTimeFunc(t *testing.T) {
+	notBefore := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	notAfter := notBefore.Add(time.Hour * 90)
+	baseRenewalTime := notBefore.Add(time.Hour * 60)
+
+	newCert := func(name string, jitter *metav1.Duration) *cmapi.Certificate {
+		return &cmapi.Certificate{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: name, UID: types.UID(name)},
+			Spec:       cmapi.CertificateSpec{RenewalJitter: jitter},
+		}
+	}
+
+	t.Run("no jitter uses the base renewal time", func(t *testing.T) {
+		rt := NewRenewalTimeFunc(RenewalOptions{})(notBefore, notAfter, newCert("a", nil))
+		assert.Equal(t, baseRenewalTime, rt.Time)
+	})
+
+	t.Run("default renewBeforePercentage is used if the certificate does not set one", func(t *testing.T) {
+		rt := NewRenewalTimeFunc(RenewalOptions{DefaultRenewBeforePercentage: 10})(notBefore, notAfter, newCert("a", nil))
+		assert.Equal(t, notBefore.Add(time.Hour*81), rt.Time)
+
+		crt := newCert("a", nil)
+		crt.Spec.RenewBeforePercentage = int32Ptr(50)
+		rt = NewRenewalTimeFunc(RenewalOptions{DefaultRenewBeforePercentage: 10})(notBefore, notAfter, crt)
+		assert.Equal(t, notBefore.Add(time.Hour*45), rt.Time)
+	})
+
+	t.Run("jitter brings renewal forward deterministically", func(t *testing.T) {
+		f := NewRenewalTimeFunc(RenewalOptions{DefaultJitter: time.Hour * 10})
+		seen := make(map[time.Time]bool)
+		for i := 0; i < 20; i++ {
+			crt := newCert(fmt.Sprintf("crt-%d", i), nil)
+			rt := f(notBefore, notAfter, crt)
+			if rt.Time.After(baseRenewalTime) || !rt.Time.After(baseRenewalTime.Add(-time.Hour*10)) {
+				t.Errorf("expected renewal time within the jitter window, got %v", rt.Time)
+			}
+			assert.Equal(t, rt, f(notBefore, notAfter, crt), "expected renewal time to be stable")
+			seen[rt.Time] = true
+		}
+		if len(seen) < 2 {
+			t.Errorf("expected renewal times to be spread out, got %v", seen)
+		}
+	})
+
+	t.Run("spec.renewalJitter overrides the default", func(t *testing.T) {
+		f := NewRenewalTimeFunc(RenewalOptions{DefaultJitter: time.Hour * 10})
+		rt := f(notBefore, notAfter, newCert("a", &metav1.Duration{}))
+		assert.Equal(t, baseRenewalTime, rt.Time)
+	})
+
+	t.Run("jitter never brings renewal before notBefore", func(t *testing.T) {
+		f := NewRenewalTimeFunc(RenewalOptions{DefaultJitter: time.Hour * 10000})
+		for i := 0; i < 20; i++ {
+			rt := f(notBefore, notAfter, newCert(fmt.Sprintf("crt-%d", i), nil))
+			if rt.Time.Before(notBefore) {
+				t.Errorf("expected renewal time not to be before notBefore, got %v", rt.Time)
+			}
+		}
+	})
+}
+
+func TestIssuanceRetryBackoff(t *testing.T) {
+	attempts := func(n int) *int { return &n }
+	tests := map[string]struct {
+		attempts *int
+		backoff  *cmapi.CertificateRetryBackoff
+		min, max time.Duration
+		expected time.Duration
+	}{
+		"defaults are used if no min or max are given": {
+			expected: DefaultIssuanceRetryBackoffMin,
+		},
+		"first failure backs off for min": {
+			attempts: attempts(1),
+			min:      time.Minute,
+			max:      time.Hour,
+			expected: time.Minute,
+		},
+		"backoff doubles with each failure": {
+			attempts: attempts(4),
+			min:      time.Minute,
+			max:      time.Hour,
+			expected: time.Minute * 8,
+		},
+		"backoff is capped at max": {
+			attempts: attempts(100),
+			min:      time.Minute,
+			max:      time.Hour,
+			expected: time.Hour,
+		},
+		"spec.retryBackoff overrides min and max": {
+			attempts: attempts(3),
+			backoff: &cmapi.CertificateRetryBackoff{
+				Min: &metav1.Duration{Duration: time.Second},
+				Max: &metav1.Duration{Duration: time.Second * 3},
+			},
+			min:      time.Minute,
+			max:      time.Hour,
+			expected: time.Second * 3,
+		},
+		"max is raised to min if lower": {
+			attempts: attempts(3),
+			backoff: &cmapi.CertificateRetryBackoff{
+				Min: &metav1.Duration{Duration: time.Hour * 2},
+			},
+			min:      time.Minute,
+			max:      time.Hour,
+			expected: time.Hour * 2,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			crt := &cmapi.Certificate{
+				Spec:   cmapi.CertificateSpec{RetryBackoff: test.backoff},
+				Status: cmapi.CertificateStatus{FailedIssuanceAttempts: test.attempts},
+			}
+			assert.Equal(t, test.expected, IssuanceRetryBackoff(crt, test.min, test.max))
+		})
+	}
+}
+
+func int32Ptr(i int32) *int32 {
+	return &i
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificatesigningrequests
+
+import (
+	certificatesv1 "k8s.io/api/certificates/v1"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	experimentalapi "github.com/jetstack/cert-manager/pkg/apis/experimental/v1alpha1"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+// checkIssuerConstraints returns an error if the CertificateSigningRequest
+// violates the constraints of the given issuer.
+func checkIssuerConstraints(csr *certificatesv1.CertificateSigningRequest, iss cmapi.GenericIssuer) error {
+	constraints := iss.GetSpec().Constraints
+	if constraints == nil {
+		return nil
+	}
+
+	duration, err := pki.DurationFromCertificateSigningRequest(csr)
+	if err != nil {
+		return err
+	}
+
+	x509csr, err := pki.DecodeX509CertificateRequestBytes(csr.Spec.Request)
+	if err != nil {
+		return err
+	}
+
+	isCA := csr.Annotations[experimentalapi.CertificateSigningRequestIsCAAnnotationKey] == "true"
+	req, err := pki.ConstrainedRequestForCSR(x509csr, duration, isCA)
+	if err != nil {
+		return err
+	}
+
+	return pki.CheckIssuerConstraints(constraints, req)
+}
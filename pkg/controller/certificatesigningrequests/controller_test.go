@@ -18,6 +18,10 @@ package certificatesigningrequests
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"errors"
 	"testing"
 	"time"
@@ -37,6 +41,7 @@ import (
 	"github.com/jetstack/cert-manager/pkg/controller/certificatesigningrequests/fake"
 	"github.com/jetstack/cert-manager/pkg/controller/certificatesigningrequests/util"
 	testpkg "github.com/jetstack/cert-manager/pkg/controller/test"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
 	"github.com/jetstack/cert-manager/test/unit/gen"
 )
 
@@ -70,6 +75,18 @@ func TestController(t *testing.T) {
 		}
 	}
 
+	pk, err := pki.GenerateECPrivateKey(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	csrDER, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject: pkix.Name{CommonName: "test"},
+	}, pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	csrPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER})
+
 	tests := map[string]struct {
 		// key that should be passed to ProcessItem. If not set, the
 		// 'namespace/name' of the 'CertificateSigningRequest' field will be used.
@@ -437,6 +454,75 @@ func TestController(t *testing.T) {
 				}),
 			),
 		},
+		"if CertificateSigningRequest violates the constraints of the Issuer, should update Failed without calling sign": {
+			signerType: apiutil.IssuerCA,
+			existingCSR: gen.CertificateSigningRequest("csr-1",
+				gen.SetCertificateSigningRequestSignerName("issuers.cert-manager.io/hello.world"),
+				gen.SetCertificateSigningRequestUsername("user-1"),
+				gen.SetCertificateSigningRequestGroups([]string{"group-1", "group-2"}),
+				gen.SetCertificateSigningRequestUID("uid-1"),
+				gen.SetCertificateSigningRequestIsCA(true),
+				gen.SetCertificateSigningRequestRequest(csrPEM),
+				gen.SetCertificateSigningRequestStatusCondition(certificatesv1.CertificateSigningRequestCondition{
+					Type:    certificatesv1.CertificateApproved,
+					Status:  corev1.ConditionTrue,
+					Reason:  "ApprovedReason",
+					Message: "Approved message",
+				}),
+			),
+			signerImpl:  signerExpectNoCall,
+			sarReaction: sarReactionAllow,
+			wantSARCreation: []*authzv1.SubjectAccessReview{
+				{
+					Spec: authzv1.SubjectAccessReviewSpec{
+						User:   "user-1",
+						Groups: []string{"group-1", "group-2"},
+						Extra:  map[string]authzv1.ExtraValue{},
+						UID:    "uid-1",
+
+						ResourceAttributes: &authzv1.ResourceAttributes{
+							Group:     "cert-manager.io",
+							Resource:  "signers",
+							Verb:      "reference",
+							Namespace: "hello",
+							Name:      "world",
+							Version:   "*",
+						},
+					},
+				},
+			},
+			existingIssuer: gen.Issuer("world", gen.SetIssuerNamespace("hello"),
+				gen.SetIssuerCA(cmapi.CAIssuer{
+					SecretName: "tls",
+				}),
+				gen.SetIssuerConstraints(cmapi.IssuerConstraints{
+					AllowCA: false,
+				}),
+				gen.AddIssuerCondition(cmapi.IssuerCondition{
+					Type:    cmapi.IssuerConditionReady,
+					Status:  cmmeta.ConditionTrue,
+					Reason:  "IssuerReady",
+					Message: "Issuer ready message",
+				}),
+			),
+			wantEvent: "Warning ConstraintViolation Certificate signing request violates the constraints of the issuer: CA certificates are not allowed",
+			wantConditions: []certificatesv1.CertificateSigningRequestCondition{
+				{
+					Type:    certificatesv1.CertificateApproved,
+					Status:  corev1.ConditionTrue,
+					Reason:  "ApprovedReason",
+					Message: "Approved message",
+				},
+				{
+					Type:               certificatesv1.CertificateFailed,
+					Status:             corev1.ConditionTrue,
+					Reason:             "ConstraintViolation",
+					Message:            "Certificate signing request violates the constraints of the issuer: CA certificates are not allowed",
+					LastTransitionTime: metaFixedClockStart,
+					LastUpdateTime:     metaFixedClockStart,
+				},
+			},
+		},
 		"if CertificateSigningRequest called invoked sign but it errors, should return error": {
 			signerType: apiutil.IssuerCA,
 			existingCSR: gen.CertificateSigningRequest("csr-1",
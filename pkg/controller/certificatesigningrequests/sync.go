@@ -127,6 +127,17 @@ func (c *Controller) Sync(ctx context.Context, csr *certificatesv1.CertificateSi
 		return nil
 	}
 
+	if err := checkIssuerConstraints(csr, issuerObj); err != nil {
+		message := fmt.Sprintf("Certificate signing request violates the constraints of the issuer: %v", err)
+		c.recorder.Event(csr, corev1.EventTypeWarning, "ConstraintViolation", message)
+		util.CertificateSigningRequestSetFailed(csr, "ConstraintViolation", message)
+		if _, err := c.certClient.UpdateStatus(ctx, csr, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+
+		return nil
+	}
+
 	dbg.Info("invoking sign function as existing certificate does not exist")
 
 	return c.signer.Sign(ctx, csr, issuerObj)
@@ -18,9 +18,11 @@ package controller
 
 import (
 	"context"
+	"crypto/tls"
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/labels"
 	kubeinformers "k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
@@ -30,6 +32,7 @@ import (
 	"github.com/jetstack/cert-manager/pkg/acme/accounts"
 	clientset "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
 	informers "github.com/jetstack/cert-manager/pkg/client/informers/externalversions"
+	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/embedded"
 	"github.com/jetstack/cert-manager/pkg/metrics"
 )
 
@@ -76,6 +79,7 @@ type Context struct {
 	IngressShimOptions
 	CertificateOptions
 	SchedulerOptions
+	ExternalApproverOptions
 }
 
 type IssuerOptions struct {
@@ -124,6 +128,15 @@ type ACMEOptions struct {
 
 	// DNS01CheckRetryPeriod is the time the controller should wait between checking if a ACME dns entry exists.
 	DNS01CheckRetryPeriod time.Duration
+
+	// DNS01ServerRecords is the set of records served by the embedded DNS01
+	// server. It is nil if the embedded DNS01 server is not enabled.
+	DNS01ServerRecords *embedded.RecordSet
+
+	// DNS01BatchWindow is the amount of time to wait for DNS01 record changes
+	// for other challenges, so that changes for the same zone can be applied
+	// by DNS providers in a single request. Batching is disabled if zero.
+	DNS01BatchWindow time.Duration
 }
 
 type IngressShimOptions struct {
@@ -138,6 +151,55 @@ type CertificateOptions struct {
 	// EnableOwnerRef controls whether the certificate is configured as an owner of
 	// secret where the effective TLS certificate is stored.
 	EnableOwnerRef bool
+
+	// DefaultRenewBeforePercentage is the percentage of a certificate's
+	// duration before expiry at which it is renewed, for Certificates that
+	// do not configure this themselves. Zero means 2/3 through its duration.
+	DefaultRenewBeforePercentage int32
+
+	// DefaultRenewalJitter is the maximum amount of time by which the renewal
+	// of a certificate is brought forward, for Certificates that do not
+	// configure this themselves. Zero disables jitter.
+	DefaultRenewalJitter time.Duration
+
+	// IssuanceRetryBackoffMin is the amount of time after a failed issuance
+	// before it is first retried, for Certificates that do not configure
+	// this themselves.
+	IssuanceRetryBackoffMin time.Duration
+
+	// IssuanceRetryBackoffMax is the maximum amount of time after a failed
+	// issuance before it is retried, for Certificates that do not configure
+	// this themselves.
+	IssuanceRetryBackoffMax time.Duration
+
+	// AdditionalSecretTargetNamespaceSelector selects the namespaces, other
+	// than their own, that Certificates may copy their Secret to. If nil,
+	// Secrets are not copied to other namespaces.
+	AdditionalSecretTargetNamespaceSelector labels.Selector
+}
+
+type ExternalApproverOptions struct {
+	// ExternalApproverURL is the HTTPS endpoint that CertificateRequests are
+	// sent to for review by the external approver controller.
+	ExternalApproverURL string
+
+	// ExternalApproverTLSConfig is the TLS configuration used to connect to
+	// the external approver.
+	ExternalApproverTLSConfig *tls.Config
+
+	// ExternalApproverTimeout is the maximum amount of time to wait for the
+	// external approver to respond.
+	ExternalApproverTimeout time.Duration
+
+	// ExternalApproverFailurePolicy determines what happens to a
+	// CertificateRequest if the external approver cannot be called or
+	// returns an invalid response.
+	ExternalApproverFailurePolicy string
+
+	// ExternalApproverCacheTTL is the amount of time that the verdict of the
+	// external approver on a CertificateRequest is cached for. Zero disables
+	// caching.
+	ExternalApproverCacheTTL time.Duration
 }
 
 type SchedulerOptions struct {
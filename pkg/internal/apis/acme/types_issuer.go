@@ -306,6 +306,20 @@ type ACMEChallengeSolverDNS01 struct {
 	// to manage DNS01 challenge records.
 	RFC2136 *ACMEIssuerDNS01ProviderRFC2136
 
+	// Use external-dns (https://github.com/kubernetes-sigs/external-dns) to
+	// manage DNS01 challenge records by creating DNSEndpoint resources.
+	// This does not require cert-manager to have any credentials for the DNS
+	// provider.
+	ExternalDNS *ACMEIssuerDNS01ProviderExternalDNS
+
+	// Use the DNS server embedded in the cert-manager controller to serve
+	// DNS01 challenge records.
+	// The '_acme-challenge' subdomain of each domain must be delegated to the
+	// embedded DNS server using NS records, and the server must be enabled
+	// using the controller's '--dns01-server-listen-address' flag.
+	// CNAME records are not followed when using this provider.
+	Embedded *ACMEIssuerDNS01ProviderEmbedded
+
 	// Configure an external webhook based DNS01 challenge solver to manage
 	// DNS01 challenge records.
 	Webhook *ACMEIssuerDNS01ProviderWebhook
@@ -437,6 +451,11 @@ type ACMEIssuerDNS01ProviderRFC2136 struct {
 	// This field is required.
 	Nameserver string
 
+	// A list of additional nameservers, in the same form as ``nameserver``,
+	// that dynamic updates will be sent to, in order, if sending an update to
+	// ``nameserver`` fails. This allows for redundant primary nameservers.
+	FailoverNameservers []string
+
 	// The name of the secret containing the TSIG value.
 	// If ``tsigKeyName`` is defined, this field is required.
 	TSIGSecret cmmeta.SecretKeySelector
@@ -450,8 +469,50 @@ type ACMEIssuerDNS01ProviderRFC2136 struct {
 	// Supported values are (case-insensitive): ``HMACMD5`` (default),
 	// ``HMACSHA1``, ``HMACSHA256`` or ``HMACSHA512``.
 	TSIGAlgorithm string
+
+	// Configures SIG(0) (RFC2931) public key signing of dynamic updates, as
+	// an alternative to TSIG.
+	// May not be specified together with ``tsigKeyName`` or
+	// ``tsigSecretSecretRef``.
+	SIG0 *ACMEIssuerDNS01ProviderRFC2136SIG0
+}
+
+// ACMEIssuerDNS01ProviderRFC2136SIG0 is a structure containing the
+// configuration for SIG(0) signing of RFC2136 dynamic updates.
+type ACMEIssuerDNS01ProviderRFC2136SIG0 struct {
+	// The public key used to sign updates as a DNS KEY record, e.g. the
+	// contents of the '.key' file generated by ``dnssec-keygen -T KEY``.
+	// The name of the record is used as the signer name.
+	PublicKey string
+
+	// The name of the secret containing the private key used to sign updates
+	// in the BIND private key format, e.g. the contents of the '.private' file
+	// generated by ``dnssec-keygen -T KEY``.
+	PrivateKey cmmeta.SecretKeySelector
 }
 
+// ACMEIssuerDNS01ProviderExternalDNS is a structure containing the
+// configuration for solving DNS01 challenges using external-dns.
+// A DNSEndpoint resource (externaldns.k8s.io/v1alpha1) containing the TXT
+// record will be created in the issuer's resource namespace, and external-dns
+// must be configured with the 'crd' source in order to publish it.
+type ACMEIssuerDNS01ProviderExternalDNS struct {
+	// Labels that will be added to the created DNSEndpoint resources.
+	// This can be used to match an external-dns instance configured with
+	// '--label-filter'.
+	Labels map[string]string
+
+	// Annotations that will be added to the created DNSEndpoint resources.
+	// This can be used to match an external-dns instance configured with
+	// '--annotation-filter'.
+	Annotations map[string]string
+}
+
+// ACMEIssuerDNS01ProviderEmbedded is a structure containing the
+// configuration for solving DNS01 challenges using the DNS server embedded in
+// the cert-manager controller.
+type ACMEIssuerDNS01ProviderEmbedded struct{}
+
 // ACMEIssuerDNS01ProviderWebhook specifies configuration for a webhook DNS01
 // provider, including where to POST ChallengePayload resources.
 type ACMEIssuerDNS01ProviderWebhook struct {
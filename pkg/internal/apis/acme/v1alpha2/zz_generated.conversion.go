@@ -232,6 +232,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.ACMEIssuerDNS01ProviderEmbedded)(nil), (*acme.ACMEIssuerDNS01ProviderEmbedded)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_ACMEIssuerDNS01ProviderEmbedded_To_acme_ACMEIssuerDNS01ProviderEmbedded(a.(*v1alpha2.ACMEIssuerDNS01ProviderEmbedded), b.(*acme.ACMEIssuerDNS01ProviderEmbedded), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEIssuerDNS01ProviderEmbedded)(nil), (*v1alpha2.ACMEIssuerDNS01ProviderEmbedded)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEIssuerDNS01ProviderEmbedded_To_v1alpha2_ACMEIssuerDNS01ProviderEmbedded(a.(*acme.ACMEIssuerDNS01ProviderEmbedded), b.(*v1alpha2.ACMEIssuerDNS01ProviderEmbedded), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.ACMEIssuerDNS01ProviderExternalDNS)(nil), (*acme.ACMEIssuerDNS01ProviderExternalDNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_ACMEIssuerDNS01ProviderExternalDNS_To_acme_ACMEIssuerDNS01ProviderExternalDNS(a.(*v1alpha2.ACMEIssuerDNS01ProviderExternalDNS), b.(*acme.ACMEIssuerDNS01ProviderExternalDNS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEIssuerDNS01ProviderExternalDNS)(nil), (*v1alpha2.ACMEIssuerDNS01ProviderExternalDNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEIssuerDNS01ProviderExternalDNS_To_v1alpha2_ACMEIssuerDNS01ProviderExternalDNS(a.(*acme.ACMEIssuerDNS01ProviderExternalDNS), b.(*v1alpha2.ACMEIssuerDNS01ProviderExternalDNS), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1alpha2.ACMEIssuerDNS01ProviderRFC2136)(nil), (*acme.ACMEIssuerDNS01ProviderRFC2136)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_ACMEIssuerDNS01ProviderRFC2136_To_acme_ACMEIssuerDNS01ProviderRFC2136(a.(*v1alpha2.ACMEIssuerDNS01ProviderRFC2136), b.(*acme.ACMEIssuerDNS01ProviderRFC2136), scope)
 	}); err != nil {
@@ -242,6 +262,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.ACMEIssuerDNS01ProviderRFC2136SIG0)(nil), (*acme.ACMEIssuerDNS01ProviderRFC2136SIG0)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_ACMEIssuerDNS01ProviderRFC2136SIG0_To_acme_ACMEIssuerDNS01ProviderRFC2136SIG0(a.(*v1alpha2.ACMEIssuerDNS01ProviderRFC2136SIG0), b.(*acme.ACMEIssuerDNS01ProviderRFC2136SIG0), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEIssuerDNS01ProviderRFC2136SIG0)(nil), (*v1alpha2.ACMEIssuerDNS01ProviderRFC2136SIG0)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEIssuerDNS01ProviderRFC2136SIG0_To_v1alpha2_ACMEIssuerDNS01ProviderRFC2136SIG0(a.(*acme.ACMEIssuerDNS01ProviderRFC2136SIG0), b.(*v1alpha2.ACMEIssuerDNS01ProviderRFC2136SIG0), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1alpha2.ACMEIssuerDNS01ProviderRoute53)(nil), (*acme.ACMEIssuerDNS01ProviderRoute53)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_ACMEIssuerDNS01ProviderRoute53_To_acme_ACMEIssuerDNS01ProviderRoute53(a.(*v1alpha2.ACMEIssuerDNS01ProviderRoute53), b.(*acme.ACMEIssuerDNS01ProviderRoute53), scope)
 	}); err != nil {
@@ -531,6 +561,8 @@ func autoConvert_v1alpha2_ACMEChallengeSolverDNS01_To_acme_ACMEChallengeSolverDN
 	} else {
 		out.RFC2136 = nil
 	}
+	out.ExternalDNS = (*acme.ACMEIssuerDNS01ProviderExternalDNS)(unsafe.Pointer(in.ExternalDNS))
+	out.Embedded = (*acme.ACMEIssuerDNS01ProviderEmbedded)(unsafe.Pointer(in.Embedded))
 	out.Webhook = (*acme.ACMEIssuerDNS01ProviderWebhook)(unsafe.Pointer(in.Webhook))
 	return nil
 }
@@ -614,6 +646,8 @@ func autoConvert_acme_ACMEChallengeSolverDNS01_To_v1alpha2_ACMEChallengeSolverDN
 	} else {
 		out.RFC2136 = nil
 	}
+	out.ExternalDNS = (*v1alpha2.ACMEIssuerDNS01ProviderExternalDNS)(unsafe.Pointer(in.ExternalDNS))
+	out.Embedded = (*v1alpha2.ACMEIssuerDNS01ProviderEmbedded)(unsafe.Pointer(in.Embedded))
 	out.Webhook = (*v1alpha2.ACMEIssuerDNS01ProviderWebhook)(unsafe.Pointer(in.Webhook))
 	return nil
 }
@@ -1133,13 +1167,63 @@ func Convert_acme_ACMEIssuerDNS01ProviderDigitalOcean_To_v1alpha2_ACMEIssuerDNS0
 	return autoConvert_acme_ACMEIssuerDNS01ProviderDigitalOcean_To_v1alpha2_ACMEIssuerDNS01ProviderDigitalOcean(in, out, s)
 }
 
+func autoConvert_v1alpha2_ACMEIssuerDNS01ProviderEmbedded_To_acme_ACMEIssuerDNS01ProviderEmbedded(in *v1alpha2.ACMEIssuerDNS01ProviderEmbedded, out *acme.ACMEIssuerDNS01ProviderEmbedded, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_v1alpha2_ACMEIssuerDNS01ProviderEmbedded_To_acme_ACMEIssuerDNS01ProviderEmbedded is an autogenerated conversion function.
+func Convert_v1alpha2_ACMEIssuerDNS01ProviderEmbedded_To_acme_ACMEIssuerDNS01ProviderEmbedded(in *v1alpha2.ACMEIssuerDNS01ProviderEmbedded, out *acme.ACMEIssuerDNS01ProviderEmbedded, s conversion.Scope) error {
+	return autoConvert_v1alpha2_ACMEIssuerDNS01ProviderEmbedded_To_acme_ACMEIssuerDNS01ProviderEmbedded(in, out, s)
+}
+
+func autoConvert_acme_ACMEIssuerDNS01ProviderEmbedded_To_v1alpha2_ACMEIssuerDNS01ProviderEmbedded(in *acme.ACMEIssuerDNS01ProviderEmbedded, out *v1alpha2.ACMEIssuerDNS01ProviderEmbedded, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_acme_ACMEIssuerDNS01ProviderEmbedded_To_v1alpha2_ACMEIssuerDNS01ProviderEmbedded is an autogenerated conversion function.
+func Convert_acme_ACMEIssuerDNS01ProviderEmbedded_To_v1alpha2_ACMEIssuerDNS01ProviderEmbedded(in *acme.ACMEIssuerDNS01ProviderEmbedded, out *v1alpha2.ACMEIssuerDNS01ProviderEmbedded, s conversion.Scope) error {
+	return autoConvert_acme_ACMEIssuerDNS01ProviderEmbedded_To_v1alpha2_ACMEIssuerDNS01ProviderEmbedded(in, out, s)
+}
+
+func autoConvert_v1alpha2_ACMEIssuerDNS01ProviderExternalDNS_To_acme_ACMEIssuerDNS01ProviderExternalDNS(in *v1alpha2.ACMEIssuerDNS01ProviderExternalDNS, out *acme.ACMEIssuerDNS01ProviderExternalDNS, s conversion.Scope) error {
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	return nil
+}
+
+// Convert_v1alpha2_ACMEIssuerDNS01ProviderExternalDNS_To_acme_ACMEIssuerDNS01ProviderExternalDNS is an autogenerated conversion function.
+func Convert_v1alpha2_ACMEIssuerDNS01ProviderExternalDNS_To_acme_ACMEIssuerDNS01ProviderExternalDNS(in *v1alpha2.ACMEIssuerDNS01ProviderExternalDNS, out *acme.ACMEIssuerDNS01ProviderExternalDNS, s conversion.Scope) error {
+	return autoConvert_v1alpha2_ACMEIssuerDNS01ProviderExternalDNS_To_acme_ACMEIssuerDNS01ProviderExternalDNS(in, out, s)
+}
+
+func autoConvert_acme_ACMEIssuerDNS01ProviderExternalDNS_To_v1alpha2_ACMEIssuerDNS01ProviderExternalDNS(in *acme.ACMEIssuerDNS01ProviderExternalDNS, out *v1alpha2.ACMEIssuerDNS01ProviderExternalDNS, s conversion.Scope) error {
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	return nil
+}
+
+// Convert_acme_ACMEIssuerDNS01ProviderExternalDNS_To_v1alpha2_ACMEIssuerDNS01ProviderExternalDNS is an autogenerated conversion function.
+func Convert_acme_ACMEIssuerDNS01ProviderExternalDNS_To_v1alpha2_ACMEIssuerDNS01ProviderExternalDNS(in *acme.ACMEIssuerDNS01ProviderExternalDNS, out *v1alpha2.ACMEIssuerDNS01ProviderExternalDNS, s conversion.Scope) error {
+	return autoConvert_acme_ACMEIssuerDNS01ProviderExternalDNS_To_v1alpha2_ACMEIssuerDNS01ProviderExternalDNS(in, out, s)
+}
+
 func autoConvert_v1alpha2_ACMEIssuerDNS01ProviderRFC2136_To_acme_ACMEIssuerDNS01ProviderRFC2136(in *v1alpha2.ACMEIssuerDNS01ProviderRFC2136, out *acme.ACMEIssuerDNS01ProviderRFC2136, s conversion.Scope) error {
 	out.Nameserver = in.Nameserver
+	out.FailoverNameservers = *(*[]string)(unsafe.Pointer(&in.FailoverNameservers))
 	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.TSIGSecret, &out.TSIGSecret, s); err != nil {
 		return err
 	}
 	out.TSIGKeyName = in.TSIGKeyName
 	out.TSIGAlgorithm = in.TSIGAlgorithm
+	if in.SIG0 != nil {
+		in, out := &in.SIG0, &out.SIG0
+		*out = new(acme.ACMEIssuerDNS01ProviderRFC2136SIG0)
+		if err := Convert_v1alpha2_ACMEIssuerDNS01ProviderRFC2136SIG0_To_acme_ACMEIssuerDNS01ProviderRFC2136SIG0(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SIG0 = nil
+	}
 	return nil
 }
 
@@ -1150,11 +1234,21 @@ func Convert_v1alpha2_ACMEIssuerDNS01ProviderRFC2136_To_acme_ACMEIssuerDNS01Prov
 
 func autoConvert_acme_ACMEIssuerDNS01ProviderRFC2136_To_v1alpha2_ACMEIssuerDNS01ProviderRFC2136(in *acme.ACMEIssuerDNS01ProviderRFC2136, out *v1alpha2.ACMEIssuerDNS01ProviderRFC2136, s conversion.Scope) error {
 	out.Nameserver = in.Nameserver
+	out.FailoverNameservers = *(*[]string)(unsafe.Pointer(&in.FailoverNameservers))
 	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.TSIGSecret, &out.TSIGSecret, s); err != nil {
 		return err
 	}
 	out.TSIGKeyName = in.TSIGKeyName
 	out.TSIGAlgorithm = in.TSIGAlgorithm
+	if in.SIG0 != nil {
+		in, out := &in.SIG0, &out.SIG0
+		*out = new(v1alpha2.ACMEIssuerDNS01ProviderRFC2136SIG0)
+		if err := Convert_acme_ACMEIssuerDNS01ProviderRFC2136SIG0_To_v1alpha2_ACMEIssuerDNS01ProviderRFC2136SIG0(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SIG0 = nil
+	}
 	return nil
 }
 
@@ -1163,6 +1257,32 @@ func Convert_acme_ACMEIssuerDNS01ProviderRFC2136_To_v1alpha2_ACMEIssuerDNS01Prov
 	return autoConvert_acme_ACMEIssuerDNS01ProviderRFC2136_To_v1alpha2_ACMEIssuerDNS01ProviderRFC2136(in, out, s)
 }
 
+func autoConvert_v1alpha2_ACMEIssuerDNS01ProviderRFC2136SIG0_To_acme_ACMEIssuerDNS01ProviderRFC2136SIG0(in *v1alpha2.ACMEIssuerDNS01ProviderRFC2136SIG0, out *acme.ACMEIssuerDNS01ProviderRFC2136SIG0, s conversion.Scope) error {
+	out.PublicKey = in.PublicKey
+	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.PrivateKey, &out.PrivateKey, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha2_ACMEIssuerDNS01ProviderRFC2136SIG0_To_acme_ACMEIssuerDNS01ProviderRFC2136SIG0 is an autogenerated conversion function.
+func Convert_v1alpha2_ACMEIssuerDNS01ProviderRFC2136SIG0_To_acme_ACMEIssuerDNS01ProviderRFC2136SIG0(in *v1alpha2.ACMEIssuerDNS01ProviderRFC2136SIG0, out *acme.ACMEIssuerDNS01ProviderRFC2136SIG0, s conversion.Scope) error {
+	return autoConvert_v1alpha2_ACMEIssuerDNS01ProviderRFC2136SIG0_To_acme_ACMEIssuerDNS01ProviderRFC2136SIG0(in, out, s)
+}
+
+func autoConvert_acme_ACMEIssuerDNS01ProviderRFC2136SIG0_To_v1alpha2_ACMEIssuerDNS01ProviderRFC2136SIG0(in *acme.ACMEIssuerDNS01ProviderRFC2136SIG0, out *v1alpha2.ACMEIssuerDNS01ProviderRFC2136SIG0, s conversion.Scope) error {
+	out.PublicKey = in.PublicKey
+	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.PrivateKey, &out.PrivateKey, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_acme_ACMEIssuerDNS01ProviderRFC2136SIG0_To_v1alpha2_ACMEIssuerDNS01ProviderRFC2136SIG0 is an autogenerated conversion function.
+func Convert_acme_ACMEIssuerDNS01ProviderRFC2136SIG0_To_v1alpha2_ACMEIssuerDNS01ProviderRFC2136SIG0(in *acme.ACMEIssuerDNS01ProviderRFC2136SIG0, out *v1alpha2.ACMEIssuerDNS01ProviderRFC2136SIG0, s conversion.Scope) error {
+	return autoConvert_acme_ACMEIssuerDNS01ProviderRFC2136SIG0_To_v1alpha2_ACMEIssuerDNS01ProviderRFC2136SIG0(in, out, s)
+}
+
 func autoConvert_v1alpha2_ACMEIssuerDNS01ProviderRoute53_To_acme_ACMEIssuerDNS01ProviderRoute53(in *v1alpha2.ACMEIssuerDNS01ProviderRoute53, out *acme.ACMEIssuerDNS01ProviderRoute53, s conversion.Scope) error {
 	out.AccessKeyID = in.AccessKeyID
 	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.SecretAccessKey, &out.SecretAccessKey, s); err != nil {
@@ -71,7 +71,7 @@ func extrasMatch(crExtra map[string][]string, reqExtra map[string]authentication
 	return true
 }
 
-func ValidateUpdate(_ *admissionv1.AdmissionRequest, oldObj, newObj runtime.Object) (field.ErrorList, validation.WarningList) {
+func ValidateUpdate(req *admissionv1.AdmissionRequest, oldObj, newObj runtime.Object) (field.ErrorList, validation.WarningList) {
 	oldCR, newCR := oldObj.(*cmapi.CertificateRequest), newObj.(*cmapi.CertificateRequest)
 	fldPath := field.NewPath("spec")
 
@@ -89,9 +89,41 @@ func ValidateUpdate(_ *admissionv1.AdmissionRequest, oldObj, newObj runtime.Obje
 		el = append(el, field.Forbidden(fldPath.Child("extra"), "extra identity cannot be changed once set"))
 	}
 
+	el = append(el, validateApprovalsUpdate(req, oldCR.Status.Approvals, newCR.Status.Approvals)...)
+
 	return el, nil
 }
 
+// validateApprovalsUpdate ensures that existing approvals are never changed or
+// removed, and that any new approval is recorded by the requester, who may
+// only approve a CertificateRequest once.
+func validateApprovalsUpdate(req *admissionv1.AdmissionRequest, oldApprovals, newApprovals []cmapi.CertificateRequestApproval) field.ErrorList {
+	fldPath := field.NewPath("status", "approvals")
+
+	if len(newApprovals) < len(oldApprovals) || !reflect.DeepEqual(oldApprovals, newApprovals[:len(oldApprovals)]) {
+		return field.ErrorList{field.Forbidden(fldPath, "existing approvals cannot be changed or removed")}
+	}
+
+	var el field.ErrorList
+	usernames := make(map[string]bool)
+	for _, approval := range oldApprovals {
+		usernames[approval.Username] = true
+	}
+	for i := len(oldApprovals); i < len(newApprovals); i++ {
+		username := newApprovals[i].Username
+		if username != req.UserInfo.Username {
+			el = append(el, field.Forbidden(fldPath.Index(i).Child("username"), "username must be that of the approver"))
+			continue
+		}
+		if usernames[username] {
+			el = append(el, field.Duplicate(fldPath.Index(i).Child("username"), username))
+		}
+		usernames[username] = true
+	}
+
+	return el
+}
+
 func MutateCreate(req *admissionv1.AdmissionRequest, obj runtime.Object) {
 	cr := obj.(*cmapi.CertificateRequest)
 	userInfo := req.DeepCopy().UserInfo
@@ -105,5 +137,12 @@ func MutateCreate(req *admissionv1.AdmissionRequest, obj runtime.Object) {
 	}
 }
 
-func MutateUpdate(_ *admissionv1.AdmissionRequest, _, _ runtime.Object) {
+// MutateUpdate sets the username of any approvals added by the update to that
+// of the requester.
+func MutateUpdate(req *admissionv1.AdmissionRequest, oldObj, newObj runtime.Object) {
+	oldCR, newCR := oldObj.(*cmapi.CertificateRequest), newObj.(*cmapi.CertificateRequest)
+
+	for i := len(oldCR.Status.Approvals); i < len(newCR.Status.Approvals); i++ {
+		newCR.Status.Approvals[i].Username = req.UserInfo.Username
+	}
 }
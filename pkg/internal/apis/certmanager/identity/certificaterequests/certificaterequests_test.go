@@ -110,7 +110,20 @@ func TestValidateCreate(t *testing.T) {
 func TestValidateUpdate(t *testing.T) {
 	fldPath := field.NewPath("spec")
 
+	approvalsPath := field.NewPath("status", "approvals")
+	approverReq := &admissionv1.AdmissionRequest{
+		UserInfo: authenticationv1.UserInfo{Username: "approver-2"},
+	}
+	withApprovals := func(usernames ...string) *cmapi.CertificateRequest {
+		cr := new(cmapi.CertificateRequest)
+		for _, username := range usernames {
+			cr.Status.Approvals = append(cr.Status.Approvals, cmapi.CertificateRequestApproval{Username: username})
+		}
+		return cr
+	}
+
 	tests := map[string]struct {
+		req          *admissionv1.AdmissionRequest
 		oldCR, newCR *cmapi.CertificateRequest
 		wantE        field.ErrorList
 		wantW        validation.WarningList
@@ -170,11 +183,49 @@ func TestValidateUpdate(t *testing.T) {
 			},
 			wantE: nil,
 		},
+		"if an approval is added by the approver, should pass": {
+			req:   approverReq,
+			oldCR: withApprovals("approver-1"),
+			newCR: withApprovals("approver-1", "approver-2"),
+			wantE: nil,
+		},
+		"if an approval is added for another user, should fail": {
+			req:   approverReq,
+			oldCR: withApprovals("approver-1"),
+			newCR: withApprovals("approver-1", "approver-3"),
+			wantE: field.ErrorList{
+				field.Forbidden(approvalsPath.Index(1).Child("username"), "username must be that of the approver"),
+			},
+		},
+		"if the approver has already approved, should fail": {
+			req:   approverReq,
+			oldCR: withApprovals("approver-2"),
+			newCR: withApprovals("approver-2", "approver-2"),
+			wantE: field.ErrorList{
+				field.Duplicate(approvalsPath.Index(1).Child("username"), "approver-2"),
+			},
+		},
+		"if an existing approval is changed, should fail": {
+			req:   approverReq,
+			oldCR: withApprovals("approver-1"),
+			newCR: withApprovals("approver-2"),
+			wantE: field.ErrorList{
+				field.Forbidden(approvalsPath, "existing approvals cannot be changed or removed"),
+			},
+		},
+		"if an existing approval is removed, should fail": {
+			req:   approverReq,
+			oldCR: withApprovals("approver-1"),
+			newCR: withApprovals(),
+			wantE: field.ErrorList{
+				field.Forbidden(approvalsPath, "existing approvals cannot be changed or removed"),
+			},
+		},
 	}
 
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
-			gotE, gotW := ValidateUpdate(nil, test.newCR, test.oldCR)
+			gotE, gotW := ValidateUpdate(test.req, test.oldCR, test.newCR)
 			if !reflect.DeepEqual(gotE, test.wantE) {
 				t.Errorf("errors from ValidateUpdate() = %v, want %v", gotE, test.wantE)
 			}
@@ -262,3 +313,29 @@ func TestMutateCreate(t *testing.T) {
 		})
 	}
 }
+
+func TestMutateUpdate(t *testing.T) {
+	req := &admissionv1.AdmissionRequest{
+		UserInfo: authenticationv1.UserInfo{Username: "approver-2"},
+	}
+	oldCR := &cmapi.CertificateRequest{
+		Status: cmapi.CertificateRequestStatus{
+			Approvals: []cmapi.CertificateRequestApproval{{Username: "approver-1"}},
+		},
+	}
+	newCR := &cmapi.CertificateRequest{
+		Status: cmapi.CertificateRequestStatus{
+			Approvals: []cmapi.CertificateRequestApproval{{Username: "approver-1"}, {Reason: "Reviewed"}},
+		},
+	}
+	expectedCR := &cmapi.CertificateRequest{
+		Status: cmapi.CertificateRequestStatus{
+			Approvals: []cmapi.CertificateRequestApproval{{Username: "approver-1"}, {Username: "approver-2", Reason: "Reviewed"}},
+		},
+	}
+
+	MutateUpdate(req, oldCR, newCR)
+	if !reflect.DeepEqual(expectedCR, newCR) {
+		t.Errorf("MutateUpdate() = %v, want %v", newCR, expectedCR)
+	}
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package namespacedefaults applies defaults configured through annotations on
+// a Namespace to the Certificates created in that Namespace.
+package namespacedefaults
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	cmapiv1 "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/jetstack/cert-manager/pkg/internal/api/mutation"
+	cmapi "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+)
+
+// Defaulter fills in the fields of a Certificate that have been left unset
+// with the defaults annotated on the Certificate's Namespace.
+type Defaulter struct {
+	log             logr.Logger
+	namespaceLister corelisters.NamespaceLister
+}
+
+// New constructs a Defaulter that reads Namespaces using the given lister.
+func New(log logr.Logger, namespaceLister corelisters.NamespaceLister) *Defaulter {
+	return &Defaulter{
+		log:             log.WithName("namespace-defaults"),
+		namespaceLister: namespaceLister,
+	}
+}
+
+// AddToMutationRegistry registers the Defaulter's mutation functions for
+// Certificates with the given registry.
+func (d *Defaulter) AddToMutationRegistry(reg *mutation.Registry) error {
+	return reg.AddMutateFunc(&cmapi.Certificate{}, d.MutateCreate)
+}
+
+// MutateCreate applies the Namespace defaults to a Certificate being created.
+// Defaults are only applied on creation, so that changes to the Namespace's
+// annotations do not change the spec of existing Certificates.
+func (d *Defaulter) MutateCreate(req *admissionv1.AdmissionRequest, obj runtime.Object) {
+	if len(req.SubResource) > 0 {
+		return
+	}
+	crt, ok := obj.(*cmapi.Certificate)
+	if !ok {
+		return
+	}
+	d.defaultCertificate(req.Namespace, crt)
+}
+
+// defaultCertificate applies the defaults of the named Namespace to the
+// Certificate. Defaults are a convenience rather than a policy, so if the
+// Namespace cannot be read or one of its annotations is invalid the error is
+// logged and the affected fields are left as they are.
+func (d *Defaulter) defaultCertificate(namespace string, crt *cmapi.Certificate) {
+	log := d.log.WithValues("namespace", namespace)
+
+	ns, err := d.namespaceLister.Get(namespace)
+	if err != nil {
+		log.Error(err, "failed to get namespace, not applying defaults")
+		return
+	}
+
+	if err := ApplyDefaults(crt, ns.Annotations); err != nil {
+		log.Error(err, "some namespace defaults are invalid and have not been applied")
+		return
+	}
+
+	log.V(logf.DebugLevel).Info("applied namespace defaults to certificate", "name", crt.Name)
+}
+
+// ApplyDefaults sets each field of the Certificate that has been left unset
+// to the default given in the annotations. All valid defaults are applied,
+// and an aggregate of errors is returned for any that are invalid.
+func ApplyDefaults(crt *cmapi.Certificate, annotations map[string]string) error {
+	var errs []error
+
+	if name, found := annotations[cmapiv1.DefaultIssuerNameAnnotationKey]; found && len(crt.Spec.IssuerRef.Name) == 0 {
+		crt.Spec.IssuerRef.Name = name
+		crt.Spec.IssuerRef.Kind = annotations[cmapiv1.DefaultIssuerKindAnnotationKey]
+		crt.Spec.IssuerRef.Group = annotations[cmapiv1.DefaultIssuerGroupAnnotationKey]
+	}
+
+	if duration, found := annotations[cmapiv1.DefaultDurationAnnotationKey]; found && crt.Spec.Duration == nil {
+		d, err := time.ParseDuration(duration)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid annotation %q: %v", cmapiv1.DefaultDurationAnnotationKey, err))
+		} else {
+			crt.Spec.Duration = &metav1.Duration{Duration: d}
+		}
+	}
+
+	if renewBefore, found := annotations[cmapiv1.DefaultRenewBeforeAnnotationKey]; found && crt.Spec.RenewBefore == nil {
+		d, err := time.ParseDuration(renewBefore)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid annotation %q: %v", cmapiv1.DefaultRenewBeforeAnnotationKey, err))
+		} else {
+			crt.Spec.RenewBefore = &metav1.Duration{Duration: d}
+		}
+	}
+
+	if algorithm, found := annotations[cmapiv1.DefaultPrivateKeyAlgorithmAnnotationKey]; found && (crt.Spec.PrivateKey == nil || len(crt.Spec.PrivateKey.Algorithm) == 0) {
+		switch alg := cmapi.PrivateKeyAlgorithm(algorithm); alg {
+		case cmapi.RSAKeyAlgorithm, cmapi.ECDSAKeyAlgorithm, cmapi.Ed25519KeyAlgorithm:
+			if crt.Spec.PrivateKey == nil {
+				crt.Spec.PrivateKey = &cmapi.CertificatePrivateKey{}
+			}
+			crt.Spec.PrivateKey.Algorithm = alg
+		default:
+			errs = append(errs, fmt.Errorf("invalid annotation %q: unsupported private key algorithm %q", cmapiv1.DefaultPrivateKeyAlgorithmAnnotationKey, algorithm))
+		}
+	}
+
+	if policy, found := annotations[cmapiv1.DefaultPrivateKeyRotationPolicyAnnotationKey]; found && (crt.Spec.PrivateKey == nil || len(crt.Spec.PrivateKey.RotationPolicy) == 0) {
+		switch p := cmapi.PrivateKeyRotationPolicy(policy); p {
+		case cmapi.RotationPolicyNever, cmapi.RotationPolicyAlways:
+			if crt.Spec.PrivateKey == nil {
+				crt.Spec.PrivateKey = &cmapi.CertificatePrivateKey{}
+			}
+			crt.Spec.PrivateKey.RotationPolicy = p
+		default:
+			errs = append(errs, fmt.Errorf("invalid annotation %q: unsupported private key rotation policy %q", cmapiv1.DefaultPrivateKeyRotationPolicyAnnotationKey, policy))
+		}
+	}
+
+	if organizations, found := annotations[cmapiv1.DefaultOrganizationsAnnotationKey]; found && (crt.Spec.Subject == nil || len(crt.Spec.Subject.Organizations) == 0) {
+		if crt.Spec.Subject == nil {
+			crt.Spec.Subject = &cmapi.X509Subject{}
+		}
+		crt.Spec.Subject.Organizations = splitList(organizations)
+	}
+
+	if usages, found := annotations[cmapiv1.DefaultUsagesAnnotationKey]; found && len(crt.Spec.Usages) == 0 {
+		var newUsages []cmapi.KeyUsage
+		var invalid bool
+		for _, usageName := range splitList(usages) {
+			_, isKU := apiutil.KeyUsageType(cmapiv1.KeyUsage(usageName))
+			_, isEKU := apiutil.ExtKeyUsageType(cmapiv1.KeyUsage(usageName))
+			if !isKU && !isEKU {
+				errs = append(errs, fmt.Errorf("invalid annotation %q: invalid key usage name %q", cmapiv1.DefaultUsagesAnnotationKey, usageName))
+				invalid = true
+				continue
+			}
+			newUsages = append(newUsages, cmapi.KeyUsage(usageName))
+		}
+		if !invalid {
+			crt.Spec.Usages = newUsages
+		}
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// splitList splits a comma separated annotation value, trimming whitespace
+// and dropping empty elements.
+func splitList(value string) []string {
+	var out []string
+	for _, s := range strings.Split(value, ",") {
+		if s = strings.TrimSpace(s); len(s) > 0 {
+			out = append(out, s)
+		}
+	}
+	return out
+}
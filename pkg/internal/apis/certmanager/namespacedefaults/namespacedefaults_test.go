@@ -0,0 +1,171 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package namespacedefaults
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2/klogr"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
+	cmmeta "github.com/jetstack/cert-manager/pkg/internal/apis/meta"
+)
+
+func TestApplyDefaults(t *testing.T) {
+	allDefaults := map[string]string{
+		"defaults.cert-manager.io/issuer-name":                 "tenant-issuer",
+		"defaults.cert-manager.io/issuer-kind":                 "ClusterIssuer",
+		"defaults.cert-manager.io/issuer-group":                "cert-manager.io",
+		"defaults.cert-manager.io/duration":                    "720h",
+		"defaults.cert-manager.io/renew-before":                "240h",
+		"defaults.cert-manager.io/private-key-algorithm":       "ECDSA",
+		"defaults.cert-manager.io/private-key-rotation-policy": "Always",
+		"defaults.cert-manager.io/organizations":               "Tenant A, Tenant B",
+		"defaults.cert-manager.io/usages":                      "digital signature, server auth",
+	}
+
+	tests := map[string]struct {
+		annotations map[string]string
+		spec        cmapi.CertificateSpec
+		expSpec     cmapi.CertificateSpec
+		expErr      bool
+	}{
+		"no annotations should leave the certificate unchanged": {
+			spec:    cmapi.CertificateSpec{SecretName: "tls"},
+			expSpec: cmapi.CertificateSpec{SecretName: "tls"},
+		},
+		"all defaults should be applied to an empty certificate": {
+			annotations: allDefaults,
+			spec:        cmapi.CertificateSpec{SecretName: "tls"},
+			expSpec: cmapi.CertificateSpec{
+				SecretName: "tls",
+				IssuerRef: cmmeta.ObjectReference{
+					Name:  "tenant-issuer",
+					Kind:  "ClusterIssuer",
+					Group: "cert-manager.io",
+				},
+				Duration:    &metav1.Duration{Duration: 720 * time.Hour},
+				RenewBefore: &metav1.Duration{Duration: 240 * time.Hour},
+				PrivateKey: &cmapi.CertificatePrivateKey{
+					Algorithm:      cmapi.ECDSAKeyAlgorithm,
+					RotationPolicy: cmapi.RotationPolicyAlways,
+				},
+				Subject: &cmapi.X509Subject{
+					Organizations: []string{"Tenant A", "Tenant B"},
+				},
+				Usages: []cmapi.KeyUsage{cmapi.UsageDigitalSignature, cmapi.UsageServerAuth},
+			},
+		},
+		"fields that are already set should not be overridden": {
+			annotations: allDefaults,
+			spec: cmapi.CertificateSpec{
+				SecretName:  "tls",
+				IssuerRef:   cmmeta.ObjectReference{Name: "my-issuer"},
+				Duration:    &metav1.Duration{Duration: time.Hour},
+				RenewBefore: &metav1.Duration{Duration: time.Minute},
+				PrivateKey: &cmapi.CertificatePrivateKey{
+					Algorithm: cmapi.RSAKeyAlgorithm,
+				},
+				Subject: &cmapi.X509Subject{
+					Organizations: []string{"Me"},
+				},
+				Usages: []cmapi.KeyUsage{cmapi.UsageClientAuth},
+			},
+			expSpec: cmapi.CertificateSpec{
+				SecretName:  "tls",
+				IssuerRef:   cmmeta.ObjectReference{Name: "my-issuer"},
+				Duration:    &metav1.Duration{Duration: time.Hour},
+				RenewBefore: &metav1.Duration{Duration: time.Minute},
+				PrivateKey: &cmapi.CertificatePrivateKey{
+					Algorithm:      cmapi.RSAKeyAlgorithm,
+					RotationPolicy: cmapi.RotationPolicyAlways,
+				},
+				Subject: &cmapi.X509Subject{
+					Organizations: []string{"Me"},
+				},
+				Usages: []cmapi.KeyUsage{cmapi.UsageClientAuth},
+			},
+		},
+		"invalid annotations should return an error but still apply valid defaults": {
+			annotations: map[string]string{
+				"defaults.cert-manager.io/issuer-name":           "tenant-issuer",
+				"defaults.cert-manager.io/duration":              "a month",
+				"defaults.cert-manager.io/private-key-algorithm": "DSA",
+				"defaults.cert-manager.io/usages":                "server auth, not a usage",
+			},
+			spec: cmapi.CertificateSpec{SecretName: "tls"},
+			expSpec: cmapi.CertificateSpec{
+				SecretName: "tls",
+				IssuerRef:  cmmeta.ObjectReference{Name: "tenant-issuer"},
+			},
+			expErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			crt := &cmapi.Certificate{Spec: test.spec}
+			err := ApplyDefaults(crt, test.annotations)
+			if test.expErr != (err != nil) {
+				t.Errorf("unexpected error, exp=%t got=%v", test.expErr, err)
+			}
+			if !reflect.DeepEqual(test.expSpec, crt.Spec) {
+				t.Errorf("unexpected spec, exp=%#+v got=%#+v", test.expSpec, crt.Spec)
+			}
+		})
+	}
+}
+
+func TestMutateCreate(t *testing.T) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	if err := indexer.Add(&corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "tenant",
+			Annotations: map[string]string{
+				"defaults.cert-manager.io/issuer-name": "tenant-issuer",
+			},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	d := New(klogr.New(), corelisters.NewNamespaceLister(indexer))
+
+	crt := &cmapi.Certificate{Spec: cmapi.CertificateSpec{SecretName: "tls"}}
+	d.MutateCreate(&admissionv1.AdmissionRequest{Namespace: "tenant"}, crt)
+	if crt.Spec.IssuerRef.Name != "tenant-issuer" {
+		t.Errorf("expected issuerRef to be defaulted from the namespace, got %#+v", crt.Spec.IssuerRef)
+	}
+
+	crt = &cmapi.Certificate{Spec: cmapi.CertificateSpec{SecretName: "tls"}}
+	d.MutateCreate(&admissionv1.AdmissionRequest{Namespace: "does-not-exist"}, crt)
+	if crt.Spec.IssuerRef.Name != "" {
+		t.Errorf("expected issuerRef to be left unset for a missing namespace, got %#+v", crt.Spec.IssuerRef)
+	}
+
+	crt = &cmapi.Certificate{Spec: cmapi.CertificateSpec{SecretName: "tls"}}
+	d.MutateCreate(&admissionv1.AdmissionRequest{Namespace: "tenant", SubResource: "status"}, crt)
+	if crt.Spec.IssuerRef.Name != "" {
+		t.Errorf("expected issuerRef to be left unset for a subresource request, got %#+v", crt.Spec.IssuerRef)
+	}
+}
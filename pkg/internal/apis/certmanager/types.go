@@ -97,8 +97,14 @@ const (
 
 const (
 	// WantInjectAnnotation is the annotation that specifies that a particular
-	// object wants injection of CAs.  It takes the form of a reference to a certificate
-	// as namespace/name.  The certificate is expected to have the is-serving-for annotations.
+	// object wants injection of CAs.  It takes the form of a comma separated list of
+	// references to certificates as namespace/name.  The certificate is expected to have
+	// the is-serving-for annotations.
+	// The CAs of all referenced certificates, and of any other injection annotations of
+	// the object, are merged into a single de-duplicated bundle.
+	// A list may only reference Certificates. To inject the CAs of both Certificates and
+	// Secrets, for example during a CA rollover, also set the inject-ca-from-secret
+	// annotation.
 	WantInjectAnnotation = "cert-manager.io/inject-ca-from"
 
 	// WantInjectAPIServerCAAnnotation, if set to "true", will make the cainjector
@@ -108,8 +114,10 @@ const (
 	WantInjectAPIServerCAAnnotation = "cert-manager.io/inject-apiserver-ca"
 
 	// WantInjectFromSecretAnnotation is the annotation that specifies that a particular
-	// object wants injection of CAs.  It takes the form of a reference to a Secret
-	// as namespace/name.
+	// object wants injection of CAs.  It takes the form of a comma separated list of
+	// references to Secrets as namespace/name. It may be set together with the
+	// inject-ca-from annotation, in which case the CAs of the referenced Certificates
+	// and Secrets are merged.
 	WantInjectFromSecretAnnotation = "cert-manager.io/inject-ca-from-secret"
 
 	// AllowsInjectionFromSecretAnnotation is an annotation that must be added
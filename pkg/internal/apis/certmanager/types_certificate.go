@@ -77,6 +77,29 @@ const (
 	PKCS8 PrivateKeyEncoding = "PKCS8"
 )
 
+// CertificateOutputFormatType specifies an additional output format to be
+// written to the Certificate's target Secret.
+type CertificateOutputFormatType string
+
+const (
+	// CertificateOutputFormatCombinedPEM writes an additional
+	// `tls-combined.pem` entry to the Secret, containing the PEM encoded
+	// private key followed by the signed certificate chain
+	// (tls.key + tls.crt concatenated).
+	CertificateOutputFormatCombinedPEM CertificateOutputFormatType = "CombinedPEM"
+
+	// CertificateOutputFormatDER writes additional `key.der` and `tls.der`
+	// entries to the Secret, containing the DER (binary) encoded private key
+	// and leaf certificate.
+	CertificateOutputFormatDER CertificateOutputFormatType = "DER"
+
+	// CertificateOutputFormatFullChainPEM writes an additional
+	// `fullchain.pem` entry to the Secret, containing the signed certificate
+	// chain followed by the CA certificate (tls.crt + ca.crt concatenated),
+	// so that the chain includes the root.
+	CertificateOutputFormatFullChainPEM CertificateOutputFormatType = "FullChainPEM"
+)
+
 // CertificateSpec defines the desired state of Certificate.
 // A valid Certificate requires at least one of a CommonName, DNSName, or
 // URISAN to be valid.
@@ -105,6 +128,28 @@ type CertificateSpec struct {
 	// the way through the certificate's duration.
 	RenewBefore *metav1.Duration
 
+	// RenewBeforePercentage is like RenewBefore, except it is expressed as
+	// a percentage of the issued certificate's duration rather than as an
+	// absolute duration. Must be between 1 and 99, and cannot be set
+	// together with RenewBefore.
+	RenewBeforePercentage *int32
+
+	// RenewalJitter is the maximum amount of time by which the renewal of
+	// the certificate may be brought forward. The offset used is chosen at
+	// random per certificate and issuance. If not set, the controller's
+	// default renewal jitter is used.
+	RenewalJitter *metav1.Duration
+
+	// RenewalWindows restricts when a renewal of the certificate, triggered
+	// by it reaching its renewal time, may start. If set, such renewals are
+	// deferred until one of the windows is open, unless the next window
+	// opens less than 24 hours before the certificate expires.
+	RenewalWindows []CertificateRenewalWindow
+
+	// RetryBackoff overrides the controller's default backoff between
+	// failed attempts to issue the certificate.
+	RetryBackoff *CertificateRetryBackoff
+
 	// DNSNames is a list of DNS subjectAltNames to be set on the Certificate.
 	DNSNames []string
 
@@ -123,10 +168,33 @@ type CertificateSpec struct {
 	// denoted issuer.
 	SecretName string
 
+	// SecretTemplate defines annotations and labels to be copied to the
+	// Certificate's Secret. They are reconciled onto the Secret each time it
+	// is issued and whenever the template changes. Labels and annotations
+	// removed from the template are not removed from the Secret.
+	// SecretTemplate annotations are added in conjunction with, and cannot
+	// overwrite, the base set of annotations cert-manager sets on the
+	// Certificate's Secret.
+	SecretTemplate *CertificateSecretTemplate
+
 	// Keystores configures additional keystore output formats stored in the
 	// `secretName` Secret resource.
 	Keystores *CertificateKeystores
 
+	// AdditionalOutputFormats defines extra output formats of the private key
+	// and signed certificate chain to be written to this Certificate's target
+	// Secret. Entries for formats that are removed from this list are removed
+	// from the Secret.
+	AdditionalOutputFormats []CertificateAdditionalOutputFormat
+
+	// AdditionalSecretTargets are Secrets, in the Certificate's namespace or
+	// in other namespaces, that the contents of the Secret named by
+	// secretName are copied to and kept in sync with. Copies in other
+	// namespaces are only written if the namespace is selected by the
+	// controller's --additional-secret-target-namespace-selector flag.
+	// Copies are deleted when they are removed from this list.
+	AdditionalSecretTargets []CertificateSecretTarget
+
 	// IssuerRef is a reference to the issuer for this certificate.
 	// If the `kind` field is not set, or set to `Issuer`, an Issuer resource
 	// with the given name in the same namespace as the Certificate will be used.
@@ -146,6 +214,13 @@ type CertificateSpec struct {
 	// Options to control private keys used for the Certificate.
 	PrivateKey *CertificatePrivateKey
 
+	// PrivateKeyProfiles are additional private keys to issue certificates
+	// for, alongside the private key configured by `privateKey`. Each
+	// certificate has the same subject and names, and is stored in the Secret
+	// together with its private key under the `tls-<name>.crt` and
+	// `tls-<name>.key` keys.
+	PrivateKeyProfiles []CertificatePrivateKeyProfile
+
 	// EncodeUsagesInRequest controls whether key usages should be present
 	// in the CertificateRequest
 	EncodeUsagesInRequest *bool
@@ -198,6 +273,18 @@ type CertificatePrivateKey struct {
 	Size int
 }
 
+// CertificatePrivateKeyProfile is an additional private key for which a
+// certificate is issued.
+type CertificatePrivateKeyProfile struct {
+	// Name of the profile, which must be a valid DNS label. The private key
+	// and certificate of the profile are stored in the Secret under the
+	// `tls-<name>.key` and `tls-<name>.crt` keys.
+	Name string
+
+	// Options to control the private key of the profile.
+	PrivateKey CertificatePrivateKey
+}
+
 // Denotes how private keys should be generated or sourced when a Certificate
 // is being issued.
 type PrivateKeyRotationPolicy string
@@ -234,6 +321,60 @@ type X509Subject struct {
 	SerialNumber string
 }
 
+// CertificateSecretTemplate defines the default labels and annotations
+// to be copied to the Kubernetes Secret resource named in `CertificateSpec.secretName`.
+type CertificateSecretTemplate struct {
+	// Annotations is a key value map to be copied to the target Kubernetes Secret.
+	Annotations map[string]string
+
+	// Labels is a key value map to be copied to the target Kubernetes Secret.
+	Labels map[string]string
+}
+
+// CertificateRenewalWindow is a recurring window of time in which the
+// renewal of a certificate may start.
+type CertificateRenewalWindow struct {
+	// Schedule is a standard 5 field cron schedule which determines when the
+	// window opens.
+	Schedule string
+
+	// Duration is how long the window stays open for once it has opened.
+	Duration metav1.Duration
+
+	// TimeZone is the IANA time zone name in which the schedule is
+	// evaluated. Defaults to UTC.
+	TimeZone string
+}
+
+// CertificateRetryBackoff configures the exponential backoff between failed
+// attempts to issue a certificate.
+type CertificateRetryBackoff struct {
+	// Min is the delay after the first failed issuance attempt.
+	Min *metav1.Duration
+
+	// Max is the maximum delay between failed issuance attempts.
+	Max *metav1.Duration
+}
+
+// CertificateAdditionalOutputFormat defines an additional output format of a
+// Certificate resource. These contain supplementary data formats of the
+// signed certificate chain and paired private key.
+type CertificateAdditionalOutputFormat struct {
+	// Type is the name of the format type that should be written to the
+	// Certificate's target Secret.
+	Type CertificateOutputFormatType
+}
+
+// CertificateSecretTarget is a Secret that the contents of a Certificate's
+// Secret are copied to.
+type CertificateSecretTarget struct {
+	// Namespace of the Secret.
+	Namespace string
+
+	// Name of the Secret.
+	Name string
+}
+
 // CertificateKeystores configures additional keystore output formats to be
 // created in the Certificate's output Secret.
 type CertificateKeystores struct {
@@ -286,9 +427,13 @@ type CertificateStatus struct {
 	// of the most recent failure to complete a CertificateRequest for this
 	// Certificate resource.
 	// If set, cert-manager will not re-request another Certificate until
-	// 1 hour has elapsed from this time.
+	// the retry backoff has elapsed from this time.
 	LastFailureTime *metav1.Time
 
+	// FailedIssuanceAttempts is the number of consecutive failed attempts to
+	// issue the certificate since it was last successfully issued.
+	FailedIssuanceAttempts *int
+
 	// The time after which the certificate stored in the secret named
 	// by this resource in spec.secretName is valid.
 	NotBefore *metav1.Time
@@ -302,6 +447,11 @@ type CertificateStatus struct {
 	// If not set, no upcoming renewal is scheduled.
 	RenewalTime *metav1.Time
 
+	// IssuerCAFingerprint is the SHA-256 fingerprint of the CA certificate
+	// that signed the currently issued certificate, if it could be found in
+	// the certificate chain or CA stored in the Secret.
+	IssuerCAFingerprint string
+
 	// The current 'revision' of the certificate as issued.
 	//
 	// When a CertificateRequest resource is created, it will have the
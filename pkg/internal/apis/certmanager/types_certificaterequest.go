@@ -132,6 +132,28 @@ type CertificateRequestStatus struct {
 	// FailureTime stores the time that this CertificateRequest failed. This is
 	// used to influence garbage collection and back-off.
 	FailureTime *metav1.Time
+
+	// Approvals records the users that have approved this CertificateRequest.
+	// An issuer may require approvals from a number of distinct users before
+	// the CertificateRequest may be marked as Approved.
+	Approvals []CertificateRequestApproval
+}
+
+// CertificateRequestApproval records the approval of a CertificateRequest by
+// a single user.
+type CertificateRequestApproval struct {
+	// Username of the user that approved the CertificateRequest. This is
+	// always set by cert-manager to the user that recorded the approval.
+	Username string
+
+	// ApprovalTime is the time at which the approval was recorded.
+	ApprovalTime *metav1.Time
+
+	// Reason is a brief machine readable explanation for the approval.
+	Reason string
+
+	// Message is a human readable description of the approval.
+	Message string
 }
 
 // CertificateRequestCondition contains condition information for a CertificateRequest.
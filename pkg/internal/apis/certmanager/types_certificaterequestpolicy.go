@@ -0,0 +1,176 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certmanager
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// A CertificateRequestPolicy describes the CertificateRequests that may be
+// approved for a set of issuers.
+//
+// A policy only applies to CertificateRequests whose requester is bound to it
+// through RBAC, by being allowed the 'use' verb on the
+// 'certificaterequestpolicies' resource with the name of the policy, either
+// cluster wide or in the namespace of the CertificateRequest. A
+// CertificateRequest is approved if it is allowed by at least one of the
+// policies that apply to it, and denied otherwise.
+type CertificateRequestPolicy struct {
+	metav1.TypeMeta  
+	metav1.ObjectMeta
+
+	// Desired state of the CertificateRequestPolicy resource.
+	Spec CertificateRequestPolicySpec
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CertificateRequestPolicyList is a list of CertificateRequestPolicies
+type CertificateRequestPolicyList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+
+	Items []CertificateRequestPolicy
+}
+
+// CertificateRequestPolicySpec describes the CertificateRequests that are
+// allowed by a policy.
+//
+// The allowed values of names and subject fields are patterns, in which '*'
+// matches any sequence of characters. If the list of allowed values of a field
+// is empty, CertificateRequests that request any value for that field are not
+// allowed.
+type CertificateRequestPolicySpec struct {
+	// IssuerRefs selects the issuers that the policy applies to. The policy
+	// applies to CertificateRequests that reference any of the given issuers.
+	// If empty, the policy applies to all issuers.
+	IssuerRefs []CertificateRequestPolicyIssuerRef
+
+	// AllowedCommonNames are the common names that may be requested.
+	AllowedCommonNames []string
+
+	// AllowedDNSNames are the DNS subjectAltNames that may be requested.
+	AllowedDNSNames []string
+
+	// AllowedIPAddresses are the IP address subjectAltNames that may be
+	// requested.
+	AllowedIPAddresses []string
+
+	// AllowedURIs are the URI subjectAltNames that may be requested.
+	AllowedURIs []string
+
+	// AllowedEmailAddresses are the email address subjectAltNames that may be
+	// requested.
+	AllowedEmailAddresses []string
+
+	// AllowedSubject are the values of the X509 subject fields, other than
+	// the common name, that may be requested.
+	AllowedSubject *CertificateRequestPolicySubject
+
+	// AllowedUsages are the key usages that may be requested.
+	AllowedUsages []KeyUsage
+
+	// AllowIsCA allows CertificateRequests for CA certificates.
+	AllowIsCA bool
+
+	// MinDuration is the minimum duration that may be requested.
+	// CertificateRequests that do not request a duration are treated as
+	// requesting the default duration of 90 days.
+	MinDuration *metav1.Duration
+
+	// MaxDuration is the maximum duration that may be requested.
+	// CertificateRequests that do not request a duration are treated as
+	// requesting the default duration of 90 days.
+	MaxDuration *metav1.Duration
+
+	// AllowedPrivateKeys are the private key algorithms and sizes that may be
+	// requested. If empty, any private key may be requested.
+	AllowedPrivateKeys []CertificateRequestPolicyPrivateKey
+
+	// Expressions are CEL expressions that must all evaluate to true for the
+	// policy to allow a CertificateRequest, in addition to the other fields
+	// of the policy. Expressions are evaluated against the 'request'
+	// variable, which has the fields:
+	// - namespace and name of the CertificateRequest.
+	// - username, uid, groups and extra of the requester.
+	// - issuerRef, with the name, kind and group of the issuer.
+	// - commonName, dnsNames, ipAddresses, uris and emailAddresses requested
+	//   in the CSR.
+	// - subject, with the organizations, countries, organizationalUnits,
+	//   localities, provinces, streetAddresses, postalCodes and serialNumber
+	//   requested in the CSR.
+	// - usages, isCA and duration requested by the CertificateRequest.
+	// - keyAlgorithm and keySize of the public key of the CSR.
+	// For example, "request.dnsNames.all(n, n.endsWith(request.namespace +
+	// '.svc'))" only allows DNS names ending in the namespace of the
+	// CertificateRequest.
+	Expressions []string
+}
+
+// CertificateRequestPolicyIssuerRef selects issuers by their name, kind and
+// group, each of which may be a pattern in which '*' matches any sequence of
+// characters. An empty field matches any value.
+type CertificateRequestPolicyIssuerRef struct {
+	// Name of the issuer.
+	Name string
+
+	// Kind of the issuer. CertificateRequests that do not set the kind of
+	// their issuer are matched as referencing an 'Issuer'.
+	Kind string
+
+	// Group of the issuer. CertificateRequests that do not set the group of
+	// their issuer are matched as referencing the 'cert-manager.io' group.
+	Group string
+}
+
+// CertificateRequestPolicySubject lists the allowed values of each X509
+// subject field.
+type CertificateRequestPolicySubject struct {
+	// Organizations that may be requested.
+	Organizations []string
+	// Countries that may be requested.
+	Countries []string
+	// Organizational Units that may be requested.
+	OrganizationalUnits []string
+	// Cities that may be requested.
+	Localities []string
+	// State/Provinces that may be requested.
+	Provinces []string
+	// Street addresses that may be requested.
+	StreetAddresses []string
+	// Postal codes that may be requested.
+	PostalCodes []string
+	// Serial numbers that may be requested.
+	SerialNumbers []string
+}
+
+// CertificateRequestPolicyPrivateKey is a private key algorithm, and the
+// range of sizes of that algorithm, that may be requested.
+type CertificateRequestPolicyPrivateKey struct {
+	// Algorithm of the private key.
+	Algorithm PrivateKeyAlgorithm
+
+	// MinSize is the minimum size of the private key, in bits for RSA keys
+	// or the curve size for ECDSA keys. If not set, there is no minimum.
+	MinSize int
+
+	// MaxSize is the maximum size of the private key, in bits for RSA keys
+	// or the curve size for ECDSA keys. If not set, there is no maximum.
+	MaxSize int
+}
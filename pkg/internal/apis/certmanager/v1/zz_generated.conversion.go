@@ -63,6 +63,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateAdditionalOutputFormat)(nil), (*certmanager.CertificateAdditionalOutputFormat)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateAdditionalOutputFormat_To_certmanager_CertificateAdditionalOutputFormat(a.(*v1.CertificateAdditionalOutputFormat), b.(*certmanager.CertificateAdditionalOutputFormat), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateAdditionalOutputFormat)(nil), (*v1.CertificateAdditionalOutputFormat)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateAdditionalOutputFormat_To_v1_CertificateAdditionalOutputFormat(a.(*certmanager.CertificateAdditionalOutputFormat), b.(*v1.CertificateAdditionalOutputFormat), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.CertificateCondition)(nil), (*certmanager.CertificateCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_CertificateCondition_To_certmanager_CertificateCondition(a.(*v1.CertificateCondition), b.(*certmanager.CertificateCondition), scope)
 	}); err != nil {
@@ -103,6 +113,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificatePrivateKeyProfile)(nil), (*certmanager.CertificatePrivateKeyProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificatePrivateKeyProfile_To_certmanager_CertificatePrivateKeyProfile(a.(*v1.CertificatePrivateKeyProfile), b.(*certmanager.CertificatePrivateKeyProfile), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificatePrivateKeyProfile)(nil), (*v1.CertificatePrivateKeyProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificatePrivateKeyProfile_To_v1_CertificatePrivateKeyProfile(a.(*certmanager.CertificatePrivateKeyProfile), b.(*v1.CertificatePrivateKeyProfile), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateRenewalWindow)(nil), (*certmanager.CertificateRenewalWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRenewalWindow_To_certmanager_CertificateRenewalWindow(a.(*v1.CertificateRenewalWindow), b.(*certmanager.CertificateRenewalWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRenewalWindow)(nil), (*v1.CertificateRenewalWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRenewalWindow_To_v1_CertificateRenewalWindow(a.(*certmanager.CertificateRenewalWindow), b.(*v1.CertificateRenewalWindow), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.CertificateRequest)(nil), (*certmanager.CertificateRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_CertificateRequest_To_certmanager_CertificateRequest(a.(*v1.CertificateRequest), b.(*certmanager.CertificateRequest), scope)
 	}); err != nil {
@@ -113,6 +143,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateRequestApproval)(nil), (*certmanager.CertificateRequestApproval)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRequestApproval_To_certmanager_CertificateRequestApproval(a.(*v1.CertificateRequestApproval), b.(*certmanager.CertificateRequestApproval), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRequestApproval)(nil), (*v1.CertificateRequestApproval)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRequestApproval_To_v1_CertificateRequestApproval(a.(*certmanager.CertificateRequestApproval), b.(*v1.CertificateRequestApproval), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.CertificateRequestCondition)(nil), (*certmanager.CertificateRequestCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_CertificateRequestCondition_To_certmanager_CertificateRequestCondition(a.(*v1.CertificateRequestCondition), b.(*certmanager.CertificateRequestCondition), scope)
 	}); err != nil {
@@ -133,6 +173,66 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateRequestPolicy)(nil), (*certmanager.CertificateRequestPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRequestPolicy_To_certmanager_CertificateRequestPolicy(a.(*v1.CertificateRequestPolicy), b.(*certmanager.CertificateRequestPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRequestPolicy)(nil), (*v1.CertificateRequestPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRequestPolicy_To_v1_CertificateRequestPolicy(a.(*certmanager.CertificateRequestPolicy), b.(*v1.CertificateRequestPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateRequestPolicyIssuerRef)(nil), (*certmanager.CertificateRequestPolicyIssuerRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRequestPolicyIssuerRef_To_certmanager_CertificateRequestPolicyIssuerRef(a.(*v1.CertificateRequestPolicyIssuerRef), b.(*certmanager.CertificateRequestPolicyIssuerRef), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRequestPolicyIssuerRef)(nil), (*v1.CertificateRequestPolicyIssuerRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRequestPolicyIssuerRef_To_v1_CertificateRequestPolicyIssuerRef(a.(*certmanager.CertificateRequestPolicyIssuerRef), b.(*v1.CertificateRequestPolicyIssuerRef), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateRequestPolicyList)(nil), (*certmanager.CertificateRequestPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRequestPolicyList_To_certmanager_CertificateRequestPolicyList(a.(*v1.CertificateRequestPolicyList), b.(*certmanager.CertificateRequestPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRequestPolicyList)(nil), (*v1.CertificateRequestPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRequestPolicyList_To_v1_CertificateRequestPolicyList(a.(*certmanager.CertificateRequestPolicyList), b.(*v1.CertificateRequestPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateRequestPolicyPrivateKey)(nil), (*certmanager.CertificateRequestPolicyPrivateKey)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRequestPolicyPrivateKey_To_certmanager_CertificateRequestPolicyPrivateKey(a.(*v1.CertificateRequestPolicyPrivateKey), b.(*certmanager.CertificateRequestPolicyPrivateKey), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRequestPolicyPrivateKey)(nil), (*v1.CertificateRequestPolicyPrivateKey)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRequestPolicyPrivateKey_To_v1_CertificateRequestPolicyPrivateKey(a.(*certmanager.CertificateRequestPolicyPrivateKey), b.(*v1.CertificateRequestPolicyPrivateKey), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateRequestPolicySpec)(nil), (*certmanager.CertificateRequestPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRequestPolicySpec_To_certmanager_CertificateRequestPolicySpec(a.(*v1.CertificateRequestPolicySpec), b.(*certmanager.CertificateRequestPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRequestPolicySpec)(nil), (*v1.CertificateRequestPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRequestPolicySpec_To_v1_CertificateRequestPolicySpec(a.(*certmanager.CertificateRequestPolicySpec), b.(*v1.CertificateRequestPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateRequestPolicySubject)(nil), (*certmanager.CertificateRequestPolicySubject)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRequestPolicySubject_To_certmanager_CertificateRequestPolicySubject(a.(*v1.CertificateRequestPolicySubject), b.(*certmanager.CertificateRequestPolicySubject), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRequestPolicySubject)(nil), (*v1.CertificateRequestPolicySubject)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRequestPolicySubject_To_v1_CertificateRequestPolicySubject(a.(*certmanager.CertificateRequestPolicySubject), b.(*v1.CertificateRequestPolicySubject), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.CertificateRequestSpec)(nil), (*certmanager.CertificateRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_CertificateRequestSpec_To_certmanager_CertificateRequestSpec(a.(*v1.CertificateRequestSpec), b.(*certmanager.CertificateRequestSpec), scope)
 	}); err != nil {
@@ -153,6 +253,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateRetryBackoff)(nil), (*certmanager.CertificateRetryBackoff)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRetryBackoff_To_certmanager_CertificateRetryBackoff(a.(*v1.CertificateRetryBackoff), b.(*certmanager.CertificateRetryBackoff), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRetryBackoff)(nil), (*v1.CertificateRetryBackoff)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRetryBackoff_To_v1_CertificateRetryBackoff(a.(*certmanager.CertificateRetryBackoff), b.(*v1.CertificateRetryBackoff), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateSecretTarget)(nil), (*certmanager.CertificateSecretTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateSecretTarget_To_certmanager_CertificateSecretTarget(a.(*v1.CertificateSecretTarget), b.(*certmanager.CertificateSecretTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateSecretTarget)(nil), (*v1.CertificateSecretTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateSecretTarget_To_v1_CertificateSecretTarget(a.(*certmanager.CertificateSecretTarget), b.(*v1.CertificateSecretTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateSecretTemplate)(nil), (*certmanager.CertificateSecretTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateSecretTemplate_To_certmanager_CertificateSecretTemplate(a.(*v1.CertificateSecretTemplate), b.(*certmanager.CertificateSecretTemplate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateSecretTemplate)(nil), (*v1.CertificateSecretTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateSecretTemplate_To_v1_CertificateSecretTemplate(a.(*certmanager.CertificateSecretTemplate), b.(*v1.CertificateSecretTemplate), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.CertificateStatus)(nil), (*certmanager.CertificateStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_CertificateStatus_To_certmanager_CertificateStatus(a.(*v1.CertificateStatus), b.(*certmanager.CertificateStatus), scope)
 	}); err != nil {
@@ -193,6 +323,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.IssuerApproval)(nil), (*certmanager.IssuerApproval)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_IssuerApproval_To_certmanager_IssuerApproval(a.(*v1.IssuerApproval), b.(*certmanager.IssuerApproval), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.IssuerApproval)(nil), (*v1.IssuerApproval)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_IssuerApproval_To_v1_IssuerApproval(a.(*certmanager.IssuerApproval), b.(*v1.IssuerApproval), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.IssuerCondition)(nil), (*certmanager.IssuerCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_IssuerCondition_To_certmanager_IssuerCondition(a.(*v1.IssuerCondition), b.(*certmanager.IssuerCondition), scope)
 	}); err != nil {
@@ -213,6 +353,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.IssuerConstraints)(nil), (*certmanager.IssuerConstraints)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_IssuerConstraints_To_certmanager_IssuerConstraints(a.(*v1.IssuerConstraints), b.(*certmanager.IssuerConstraints), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.IssuerConstraints)(nil), (*v1.IssuerConstraints)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_IssuerConstraints_To_v1_IssuerConstraints(a.(*certmanager.IssuerConstraints), b.(*v1.IssuerConstraints), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.IssuerList)(nil), (*certmanager.IssuerList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_IssuerList_To_certmanager_IssuerList(a.(*v1.IssuerList), b.(*certmanager.IssuerList), scope)
 	}); err != nil {
@@ -223,6 +373,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.IssuerPrivateKeyConstraint)(nil), (*certmanager.IssuerPrivateKeyConstraint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_IssuerPrivateKeyConstraint_To_certmanager_IssuerPrivateKeyConstraint(a.(*v1.IssuerPrivateKeyConstraint), b.(*certmanager.IssuerPrivateKeyConstraint), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.IssuerPrivateKeyConstraint)(nil), (*v1.IssuerPrivateKeyConstraint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_IssuerPrivateKeyConstraint_To_v1_IssuerPrivateKeyConstraint(a.(*certmanager.IssuerPrivateKeyConstraint), b.(*v1.IssuerPrivateKeyConstraint), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.IssuerSpec)(nil), (*certmanager.IssuerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_IssuerSpec_To_certmanager_IssuerSpec(a.(*v1.IssuerSpec), b.(*certmanager.IssuerSpec), scope)
 	}); err != nil {
@@ -422,6 +582,26 @@ func Convert_certmanager_Certificate_To_v1_Certificate(in *certmanager.Certifica
 	return autoConvert_certmanager_Certificate_To_v1_Certificate(in, out, s)
 }
 
+func autoConvert_v1_CertificateAdditionalOutputFormat_To_certmanager_CertificateAdditionalOutputFormat(in *v1.CertificateAdditionalOutputFormat, out *certmanager.CertificateAdditionalOutputFormat, s conversion.Scope) error {
+	out.Type = certmanager.CertificateOutputFormatType(in.Type)
+	return nil
+}
+
+// Convert_v1_CertificateAdditionalOutputFormat_To_certmanager_CertificateAdditionalOutputFormat is an autogenerated conversion function.
+func Convert_v1_CertificateAdditionalOutputFormat_To_certmanager_CertificateAdditionalOutputFormat(in *v1.CertificateAdditionalOutputFormat, out *certmanager.CertificateAdditionalOutputFormat, s conversion.Scope) error {
+	return autoConvert_v1_CertificateAdditionalOutputFormat_To_certmanager_CertificateAdditionalOutputFormat(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateAdditionalOutputFormat_To_v1_CertificateAdditionalOutputFormat(in *certmanager.CertificateAdditionalOutputFormat, out *v1.CertificateAdditionalOutputFormat, s conversion.Scope) error {
+	out.Type = v1.CertificateOutputFormatType(in.Type)
+	return nil
+}
+
+// Convert_certmanager_CertificateAdditionalOutputFormat_To_v1_CertificateAdditionalOutputFormat is an autogenerated conversion function.
+func Convert_certmanager_CertificateAdditionalOutputFormat_To_v1_CertificateAdditionalOutputFormat(in *certmanager.CertificateAdditionalOutputFormat, out *v1.CertificateAdditionalOutputFormat, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateAdditionalOutputFormat_To_v1_CertificateAdditionalOutputFormat(in, out, s)
+}
+
 func autoConvert_v1_CertificateCondition_To_certmanager_CertificateCondition(in *v1.CertificateCondition, out *certmanager.CertificateCondition, s conversion.Scope) error {
 	out.Type = certmanager.CertificateConditionType(in.Type)
 	out.Status = meta.ConditionStatus(in.Status)
@@ -574,6 +754,56 @@ func Convert_certmanager_CertificatePrivateKey_To_v1_CertificatePrivateKey(in *c
 	return autoConvert_certmanager_CertificatePrivateKey_To_v1_CertificatePrivateKey(in, out, s)
 }
 
+func autoConvert_v1_CertificatePrivateKeyProfile_To_certmanager_CertificatePrivateKeyProfile(in *v1.CertificatePrivateKeyProfile, out *certmanager.CertificatePrivateKeyProfile, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_v1_CertificatePrivateKey_To_certmanager_CertificatePrivateKey(&in.PrivateKey, &out.PrivateKey, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_CertificatePrivateKeyProfile_To_certmanager_CertificatePrivateKeyProfile is an autogenerated conversion function.
+func Convert_v1_CertificatePrivateKeyProfile_To_certmanager_CertificatePrivateKeyProfile(in *v1.CertificatePrivateKeyProfile, out *certmanager.CertificatePrivateKeyProfile, s conversion.Scope) error {
+	return autoConvert_v1_CertificatePrivateKeyProfile_To_certmanager_CertificatePrivateKeyProfile(in, out, s)
+}
+
+func autoConvert_certmanager_CertificatePrivateKeyProfile_To_v1_CertificatePrivateKeyProfile(in *certmanager.CertificatePrivateKeyProfile, out *v1.CertificatePrivateKeyProfile, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_certmanager_CertificatePrivateKey_To_v1_CertificatePrivateKey(&in.PrivateKey, &out.PrivateKey, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_CertificatePrivateKeyProfile_To_v1_CertificatePrivateKeyProfile is an autogenerated conversion function.
+func Convert_certmanager_CertificatePrivateKeyProfile_To_v1_CertificatePrivateKeyProfile(in *certmanager.CertificatePrivateKeyProfile, out *v1.CertificatePrivateKeyProfile, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificatePrivateKeyProfile_To_v1_CertificatePrivateKeyProfile(in, out, s)
+}
+
+func autoConvert_v1_CertificateRenewalWindow_To_certmanager_CertificateRenewalWindow(in *v1.CertificateRenewalWindow, out *certmanager.CertificateRenewalWindow, s conversion.Scope) error {
+	out.Schedule = in.Schedule
+	out.Duration = in.Duration
+	out.TimeZone = in.TimeZone
+	return nil
+}
+
+// Convert_v1_CertificateRenewalWindow_To_certmanager_CertificateRenewalWindow is an autogenerated conversion function.
+func Convert_v1_CertificateRenewalWindow_To_certmanager_CertificateRenewalWindow(in *v1.CertificateRenewalWindow, out *certmanager.CertificateRenewalWindow, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRenewalWindow_To_certmanager_CertificateRenewalWindow(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRenewalWindow_To_v1_CertificateRenewalWindow(in *certmanager.CertificateRenewalWindow, out *v1.CertificateRenewalWindow, s conversion.Scope) error {
+	out.Schedule = in.Schedule
+	out.Duration = in.Duration
+	out.TimeZone = in.TimeZone
+	return nil
+}
+
+// Convert_certmanager_CertificateRenewalWindow_To_v1_CertificateRenewalWindow is an autogenerated conversion function.
+func Convert_certmanager_CertificateRenewalWindow_To_v1_CertificateRenewalWindow(in *certmanager.CertificateRenewalWindow, out *v1.CertificateRenewalWindow, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRenewalWindow_To_v1_CertificateRenewalWindow(in, out, s)
+}
+
 func autoConvert_v1_CertificateRequest_To_certmanager_CertificateRequest(in *v1.CertificateRequest, out *certmanager.CertificateRequest, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1_CertificateRequestSpec_To_certmanager_CertificateRequestSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -606,6 +836,32 @@ func Convert_certmanager_CertificateRequest_To_v1_CertificateRequest(in *certman
 	return autoConvert_certmanager_CertificateRequest_To_v1_CertificateRequest(in, out, s)
 }
 
+func autoConvert_v1_CertificateRequestApproval_To_certmanager_CertificateRequestApproval(in *v1.CertificateRequestApproval, out *certmanager.CertificateRequestApproval, s conversion.Scope) error {
+	out.Username = in.Username
+	out.ApprovalTime = (*metav1.Time)(unsafe.Pointer(in.ApprovalTime))
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1_CertificateRequestApproval_To_certmanager_CertificateRequestApproval is an autogenerated conversion function.
+func Convert_v1_CertificateRequestApproval_To_certmanager_CertificateRequestApproval(in *v1.CertificateRequestApproval, out *certmanager.CertificateRequestApproval, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRequestApproval_To_certmanager_CertificateRequestApproval(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRequestApproval_To_v1_CertificateRequestApproval(in *certmanager.CertificateRequestApproval, out *v1.CertificateRequestApproval, s conversion.Scope) error {
+	out.Username = in.Username
+	out.ApprovalTime = (*metav1.Time)(unsafe.Pointer(in.ApprovalTime))
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_certmanager_CertificateRequestApproval_To_v1_CertificateRequestApproval is an autogenerated conversion function.
+func Convert_certmanager_CertificateRequestApproval_To_v1_CertificateRequestApproval(in *certmanager.CertificateRequestApproval, out *v1.CertificateRequestApproval, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRequestApproval_To_v1_CertificateRequestApproval(in, out, s)
+}
+
 func autoConvert_v1_CertificateRequestCondition_To_certmanager_CertificateRequestCondition(in *v1.CertificateRequestCondition, out *certmanager.CertificateRequestCondition, s conversion.Scope) error {
 	out.Type = certmanager.CertificateRequestConditionType(in.Type)
 	out.Status = meta.ConditionStatus(in.Status)
@@ -676,6 +932,180 @@ func Convert_certmanager_CertificateRequestList_To_v1_CertificateRequestList(in
 	return autoConvert_certmanager_CertificateRequestList_To_v1_CertificateRequestList(in, out, s)
 }
 
+func autoConvert_v1_CertificateRequestPolicy_To_certmanager_CertificateRequestPolicy(in *v1.CertificateRequestPolicy, out *certmanager.CertificateRequestPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_CertificateRequestPolicySpec_To_certmanager_CertificateRequestPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_CertificateRequestPolicy_To_certmanager_CertificateRequestPolicy is an autogenerated conversion function.
+func Convert_v1_CertificateRequestPolicy_To_certmanager_CertificateRequestPolicy(in *v1.CertificateRequestPolicy, out *certmanager.CertificateRequestPolicy, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRequestPolicy_To_certmanager_CertificateRequestPolicy(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRequestPolicy_To_v1_CertificateRequestPolicy(in *certmanager.CertificateRequestPolicy, out *v1.CertificateRequestPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_certmanager_CertificateRequestPolicySpec_To_v1_CertificateRequestPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_CertificateRequestPolicy_To_v1_CertificateRequestPolicy is an autogenerated conversion function.
+func Convert_certmanager_CertificateRequestPolicy_To_v1_CertificateRequestPolicy(in *certmanager.CertificateRequestPolicy, out *v1.CertificateRequestPolicy, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRequestPolicy_To_v1_CertificateRequestPolicy(in, out, s)
+}
+
+func autoConvert_v1_CertificateRequestPolicyIssuerRef_To_certmanager_CertificateRequestPolicyIssuerRef(in *v1.CertificateRequestPolicyIssuerRef, out *certmanager.CertificateRequestPolicyIssuerRef, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Kind = in.Kind
+	out.Group = in.Group
+	return nil
+}
+
+// Convert_v1_CertificateRequestPolicyIssuerRef_To_certmanager_CertificateRequestPolicyIssuerRef is an autogenerated conversion function.
+func Convert_v1_CertificateRequestPolicyIssuerRef_To_certmanager_CertificateRequestPolicyIssuerRef(in *v1.CertificateRequestPolicyIssuerRef, out *certmanager.CertificateRequestPolicyIssuerRef, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRequestPolicyIssuerRef_To_certmanager_CertificateRequestPolicyIssuerRef(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRequestPolicyIssuerRef_To_v1_CertificateRequestPolicyIssuerRef(in *certmanager.CertificateRequestPolicyIssuerRef, out *v1.CertificateRequestPolicyIssuerRef, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Kind = in.Kind
+	out.Group = in.Group
+	return nil
+}
+
+// Convert_certmanager_CertificateRequestPolicyIssuerRef_To_v1_CertificateRequestPolicyIssuerRef is an autogenerated conversion function.
+func Convert_certmanager_CertificateRequestPolicyIssuerRef_To_v1_CertificateRequestPolicyIssuerRef(in *certmanager.CertificateRequestPolicyIssuerRef, out *v1.CertificateRequestPolicyIssuerRef, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRequestPolicyIssuerRef_To_v1_CertificateRequestPolicyIssuerRef(in, out, s)
+}
+
+func autoConvert_v1_CertificateRequestPolicyList_To_certmanager_CertificateRequestPolicyList(in *v1.CertificateRequestPolicyList, out *certmanager.CertificateRequestPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]certmanager.CertificateRequestPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_CertificateRequestPolicyList_To_certmanager_CertificateRequestPolicyList is an autogenerated conversion function.
+func Convert_v1_CertificateRequestPolicyList_To_certmanager_CertificateRequestPolicyList(in *v1.CertificateRequestPolicyList, out *certmanager.CertificateRequestPolicyList, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRequestPolicyList_To_certmanager_CertificateRequestPolicyList(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRequestPolicyList_To_v1_CertificateRequestPolicyList(in *certmanager.CertificateRequestPolicyList, out *v1.CertificateRequestPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1.CertificateRequestPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_certmanager_CertificateRequestPolicyList_To_v1_CertificateRequestPolicyList is an autogenerated conversion function.
+func Convert_certmanager_CertificateRequestPolicyList_To_v1_CertificateRequestPolicyList(in *certmanager.CertificateRequestPolicyList, out *v1.CertificateRequestPolicyList, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRequestPolicyList_To_v1_CertificateRequestPolicyList(in, out, s)
+}
+
+func autoConvert_v1_CertificateRequestPolicyPrivateKey_To_certmanager_CertificateRequestPolicyPrivateKey(in *v1.CertificateRequestPolicyPrivateKey, out *certmanager.CertificateRequestPolicyPrivateKey, s conversion.Scope) error {
+	out.Algorithm = certmanager.PrivateKeyAlgorithm(in.Algorithm)
+	out.MinSize = in.MinSize
+	out.MaxSize = in.MaxSize
+	return nil
+}
+
+// Convert_v1_CertificateRequestPolicyPrivateKey_To_certmanager_CertificateRequestPolicyPrivateKey is an autogenerated conversion function.
+func Convert_v1_CertificateRequestPolicyPrivateKey_To_certmanager_CertificateRequestPolicyPrivateKey(in *v1.CertificateRequestPolicyPrivateKey, out *certmanager.CertificateRequestPolicyPrivateKey, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRequestPolicyPrivateKey_To_certmanager_CertificateRequestPolicyPrivateKey(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRequestPolicyPrivateKey_To_v1_CertificateRequestPolicyPrivateKey(in *certmanager.CertificateRequestPolicyPrivateKey, out *v1.CertificateRequestPolicyPrivateKey, s conversion.Scope) error {
+	out.Algorithm = v1.PrivateKeyAlgorithm(in.Algorithm)
+	out.MinSize = in.MinSize
+	out.MaxSize = in.MaxSize
+	return nil
+}
+
+// Convert_certmanager_CertificateRequestPolicyPrivateKey_To_v1_CertificateRequestPolicyPrivateKey is an autogenerated conversion function.
+func Convert_certmanager_CertificateRequestPolicyPrivateKey_To_v1_CertificateRequestPolicyPrivateKey(in *certmanager.CertificateRequestPolicyPrivateKey, out *v1.CertificateRequestPolicyPrivateKey, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRequestPolicyPrivateKey_To_v1_CertificateRequestPolicyPrivateKey(in, out, s)
+}
+
+func autoConvert_v1_CertificateRequestPolicySpec_To_certmanager_CertificateRequestPolicySpec(in *v1.CertificateRequestPolicySpec, out *certmanager.CertificateRequestPolicySpec, s conversion.Scope) error {
+	out.IssuerRefs = *(*[]certmanager.CertificateRequestPolicyIssuerRef)(unsafe.Pointer(&in.IssuerRefs))
+	out.AllowedCommonNames = *(*[]string)(unsafe.Pointer(&in.AllowedCommonNames))
+	out.AllowedDNSNames = *(*[]string)(unsafe.Pointer(&in.AllowedDNSNames))
+	out.AllowedIPAddresses = *(*[]string)(unsafe.Pointer(&in.AllowedIPAddresses))
+	out.AllowedURIs = *(*[]string)(unsafe.Pointer(&in.AllowedURIs))
+	out.AllowedEmailAddresses = *(*[]string)(unsafe.Pointer(&in.AllowedEmailAddresses))
+	out.AllowedSubject = (*certmanager.CertificateRequestPolicySubject)(unsafe.Pointer(in.AllowedSubject))
+	out.AllowedUsages = *(*[]certmanager.KeyUsage)(unsafe.Pointer(&in.AllowedUsages))
+	out.AllowIsCA = in.AllowIsCA
+	out.MinDuration = (*metav1.Duration)(unsafe.Pointer(in.MinDuration))
+	out.MaxDuration = (*metav1.Duration)(unsafe.Pointer(in.MaxDuration))
+	out.AllowedPrivateKeys = *(*[]certmanager.CertificateRequestPolicyPrivateKey)(unsafe.Pointer(&in.AllowedPrivateKeys))
+	out.Expressions = *(*[]string)(unsafe.Pointer(&in.Expressions))
+	return nil
+}
+
+// Convert_v1_CertificateRequestPolicySpec_To_certmanager_CertificateRequestPolicySpec is an autogenerated conversion function.
+func Convert_v1_CertificateRequestPolicySpec_To_certmanager_CertificateRequestPolicySpec(in *v1.CertificateRequestPolicySpec, out *certmanager.CertificateRequestPolicySpec, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRequestPolicySpec_To_certmanager_CertificateRequestPolicySpec(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRequestPolicySpec_To_v1_CertificateRequestPolicySpec(in *certmanager.CertificateRequestPolicySpec, out *v1.CertificateRequestPolicySpec, s conversion.Scope) error {
+	out.IssuerRefs = *(*[]v1.CertificateRequestPolicyIssuerRef)(unsafe.Pointer(&in.IssuerRefs))
+	out.AllowedCommonNames = *(*[]string)(unsafe.Pointer(&in.AllowedCommonNames))
+	out.AllowedDNSNames = *(*[]string)(unsafe.Pointer(&in.AllowedDNSNames))
+	out.AllowedIPAddresses = *(*[]string)(unsafe.Pointer(&in.AllowedIPAddresses))
+	out.AllowedURIs = *(*[]string)(unsafe.Pointer(&in.AllowedURIs))
+	out.AllowedEmailAddresses = *(*[]string)(unsafe.Pointer(&in.AllowedEmailAddresses))
+	out.AllowedSubject = (*v1.CertificateRequestPolicySubject)(unsafe.Pointer(in.AllowedSubject))
+	out.AllowedUsages = *(*[]v1.KeyUsage)(unsafe.Pointer(&in.AllowedUsages))
+	out.AllowIsCA = in.AllowIsCA
+	out.MinDuration = (*metav1.Duration)(unsafe.Pointer(in.MinDuration))
+	out.MaxDuration = (*metav1.Duration)(unsafe.Pointer(in.MaxDuration))
+	out.AllowedPrivateKeys = *(*[]v1.CertificateRequestPolicyPrivateKey)(unsafe.Pointer(&in.AllowedPrivateKeys))
+	out.Expressions = *(*[]string)(unsafe.Pointer(&in.Expressions))
+	return nil
+}
+
+// Convert_certmanager_CertificateRequestPolicySpec_To_v1_CertificateRequestPolicySpec is an autogenerated conversion function.
+func Convert_certmanager_CertificateRequestPolicySpec_To_v1_CertificateRequestPolicySpec(in *certmanager.CertificateRequestPolicySpec, out *v1.CertificateRequestPolicySpec, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRequestPolicySpec_To_v1_CertificateRequestPolicySpec(in, out, s)
+}
+
+func autoConvert_v1_CertificateRequestPolicySubject_To_certmanager_CertificateRequestPolicySubject(in *v1.CertificateRequestPolicySubject, out *certmanager.CertificateRequestPolicySubject, s conversion.Scope) error {
+	out.Organizations = *(*[]string)(unsafe.Pointer(&in.Organizations))
+	out.Countries = *(*[]string)(unsafe.Pointer(&in.Countries))
+	out.OrganizationalUnits = *(*[]string)(unsafe.Pointer(&in.OrganizationalUnits))
+	out.Localities = *(*[]string)(unsafe.Pointer(&in.Localities))
+	out.Provinces = *(*[]string)(unsafe.Pointer(&in.Provinces))
+	out.StreetAddresses = *(*[]string)(unsafe.Pointer(&in.StreetAddresses))
+	out.PostalCodes = *(*[]string)(unsafe.Pointer(&in.PostalCodes))
+	out.SerialNumbers = *(*[]string)(unsafe.Pointer(&in.SerialNumbers))
+	return nil
+}
+
+// Convert_v1_CertificateRequestPolicySubject_To_certmanager_CertificateRequestPolicySubject is an autogenerated conversion function.
+func Convert_v1_CertificateRequestPolicySubject_To_certmanager_CertificateRequestPolicySubject(in *v1.CertificateRequestPolicySubject, out *certmanager.CertificateRequestPolicySubject, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRequestPolicySubject_To_certmanager_CertificateRequestPolicySubject(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRequestPolicySubject_To_v1_CertificateRequestPolicySubject(in *certmanager.CertificateRequestPolicySubject, out *v1.CertificateRequestPolicySubject, s conversion.Scope) error {
+	out.Organizations = *(*[]string)(unsafe.Pointer(&in.Organizations))
+	out.Countries = *(*[]string)(unsafe.Pointer(&in.Countries))
+	out.OrganizationalUnits = *(*[]string)(unsafe.Pointer(&in.OrganizationalUnits))
+	out.Localities = *(*[]string)(unsafe.Pointer(&in.Localities))
+	out.Provinces = *(*[]string)(unsafe.Pointer(&in.Provinces))
+	out.StreetAddresses = *(*[]string)(unsafe.Pointer(&in.StreetAddresses))
+	out.PostalCodes = *(*[]string)(unsafe.Pointer(&in.PostalCodes))
+	out.SerialNumbers = *(*[]string)(unsafe.Pointer(&in.SerialNumbers))
+	return nil
+}
+
+// Convert_certmanager_CertificateRequestPolicySubject_To_v1_CertificateRequestPolicySubject is an autogenerated conversion function.
+func Convert_certmanager_CertificateRequestPolicySubject_To_v1_CertificateRequestPolicySubject(in *certmanager.CertificateRequestPolicySubject, out *v1.CertificateRequestPolicySubject, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRequestPolicySubject_To_v1_CertificateRequestPolicySubject(in, out, s)
+}
+
 func autoConvert_v1_CertificateRequestSpec_To_certmanager_CertificateRequestSpec(in *v1.CertificateRequestSpec, out *certmanager.CertificateRequestSpec, s conversion.Scope) error {
 	out.Duration = (*metav1.Duration)(unsafe.Pointer(in.Duration))
 	if err := internalapismetav1.Convert_v1_ObjectReference_To_meta_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
@@ -721,6 +1151,7 @@ func autoConvert_v1_CertificateRequestStatus_To_certmanager_CertificateRequestSt
 	out.Certificate = *(*[]byte)(unsafe.Pointer(&in.Certificate))
 	out.CA = *(*[]byte)(unsafe.Pointer(&in.CA))
 	out.FailureTime = (*metav1.Time)(unsafe.Pointer(in.FailureTime))
+	out.Approvals = *(*[]certmanager.CertificateRequestApproval)(unsafe.Pointer(&in.Approvals))
 	return nil
 }
 
@@ -734,6 +1165,7 @@ func autoConvert_certmanager_CertificateRequestStatus_To_v1_CertificateRequestSt
 	out.Certificate = *(*[]byte)(unsafe.Pointer(&in.Certificate))
 	out.CA = *(*[]byte)(unsafe.Pointer(&in.CA))
 	out.FailureTime = (*metav1.Time)(unsafe.Pointer(in.FailureTime))
+	out.Approvals = *(*[]v1.CertificateRequestApproval)(unsafe.Pointer(&in.Approvals))
 	return nil
 }
 
@@ -742,16 +1174,87 @@ func Convert_certmanager_CertificateRequestStatus_To_v1_CertificateRequestStatus
 	return autoConvert_certmanager_CertificateRequestStatus_To_v1_CertificateRequestStatus(in, out, s)
 }
 
+func autoConvert_v1_CertificateRetryBackoff_To_certmanager_CertificateRetryBackoff(in *v1.CertificateRetryBackoff, out *certmanager.CertificateRetryBackoff, s conversion.Scope) error {
+	out.Min = (*metav1.Duration)(unsafe.Pointer(in.Min))
+	out.Max = (*metav1.Duration)(unsafe.Pointer(in.Max))
+	return nil
+}
+
+// Convert_v1_CertificateRetryBackoff_To_certmanager_CertificateRetryBackoff is an autogenerated conversion function.
+func Convert_v1_CertificateRetryBackoff_To_certmanager_CertificateRetryBackoff(in *v1.CertificateRetryBackoff, out *certmanager.CertificateRetryBackoff, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRetryBackoff_To_certmanager_CertificateRetryBackoff(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRetryBackoff_To_v1_CertificateRetryBackoff(in *certmanager.CertificateRetryBackoff, out *v1.CertificateRetryBackoff, s conversion.Scope) error {
+	out.Min = (*metav1.Duration)(unsafe.Pointer(in.Min))
+	out.Max = (*metav1.Duration)(unsafe.Pointer(in.Max))
+	return nil
+}
+
+// Convert_certmanager_CertificateRetryBackoff_To_v1_CertificateRetryBackoff is an autogenerated conversion function.
+func Convert_certmanager_CertificateRetryBackoff_To_v1_CertificateRetryBackoff(in *certmanager.CertificateRetryBackoff, out *v1.CertificateRetryBackoff, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRetryBackoff_To_v1_CertificateRetryBackoff(in, out, s)
+}
+
+func autoConvert_v1_CertificateSecretTarget_To_certmanager_CertificateSecretTarget(in *v1.CertificateSecretTarget, out *certmanager.CertificateSecretTarget, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1_CertificateSecretTarget_To_certmanager_CertificateSecretTarget is an autogenerated conversion function.
+func Convert_v1_CertificateSecretTarget_To_certmanager_CertificateSecretTarget(in *v1.CertificateSecretTarget, out *certmanager.CertificateSecretTarget, s conversion.Scope) error {
+	return autoConvert_v1_CertificateSecretTarget_To_certmanager_CertificateSecretTarget(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateSecretTarget_To_v1_CertificateSecretTarget(in *certmanager.CertificateSecretTarget, out *v1.CertificateSecretTarget, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_certmanager_CertificateSecretTarget_To_v1_CertificateSecretTarget is an autogenerated conversion function.
+func Convert_certmanager_CertificateSecretTarget_To_v1_CertificateSecretTarget(in *certmanager.CertificateSecretTarget, out *v1.CertificateSecretTarget, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateSecretTarget_To_v1_CertificateSecretTarget(in, out, s)
+}
+
+func autoConvert_v1_CertificateSecretTemplate_To_certmanager_CertificateSecretTemplate(in *v1.CertificateSecretTemplate, out *certmanager.CertificateSecretTemplate, s conversion.Scope) error {
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	return nil
+}
+
+// Convert_v1_CertificateSecretTemplate_To_certmanager_CertificateSecretTemplate is an autogenerated conversion function.
+func Convert_v1_CertificateSecretTemplate_To_certmanager_CertificateSecretTemplate(in *v1.CertificateSecretTemplate, out *certmanager.CertificateSecretTemplate, s conversion.Scope) error {
+	return autoConvert_v1_CertificateSecretTemplate_To_certmanager_CertificateSecretTemplate(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateSecretTemplate_To_v1_CertificateSecretTemplate(in *certmanager.CertificateSecretTemplate, out *v1.CertificateSecretTemplate, s conversion.Scope) error {
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	return nil
+}
+
+// Convert_certmanager_CertificateSecretTemplate_To_v1_CertificateSecretTemplate is an autogenerated conversion function.
+func Convert_certmanager_CertificateSecretTemplate_To_v1_CertificateSecretTemplate(in *certmanager.CertificateSecretTemplate, out *v1.CertificateSecretTemplate, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateSecretTemplate_To_v1_CertificateSecretTemplate(in, out, s)
+}
+
 func autoConvert_v1_CertificateSpec_To_certmanager_CertificateSpec(in *v1.CertificateSpec, out *certmanager.CertificateSpec, s conversion.Scope) error {
 	out.Subject = (*certmanager.X509Subject)(unsafe.Pointer(in.Subject))
 	out.CommonName = in.CommonName
 	out.Duration = (*metav1.Duration)(unsafe.Pointer(in.Duration))
 	out.RenewBefore = (*metav1.Duration)(unsafe.Pointer(in.RenewBefore))
+	out.RenewBeforePercentage = (*int32)(unsafe.Pointer(in.RenewBeforePercentage))
+	out.RenewalJitter = (*metav1.Duration)(unsafe.Pointer(in.RenewalJitter))
+	out.RenewalWindows = *(*[]certmanager.CertificateRenewalWindow)(unsafe.Pointer(&in.RenewalWindows))
+	out.RetryBackoff = (*certmanager.CertificateRetryBackoff)(unsafe.Pointer(in.RetryBackoff))
 	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
 	out.IPAddresses = *(*[]string)(unsafe.Pointer(&in.IPAddresses))
 	// WARNING: in.URIs requires manual conversion: does not exist in peer-type
 	// WARNING: in.EmailAddresses requires manual conversion: does not exist in peer-type
 	out.SecretName = in.SecretName
+	out.SecretTemplate = (*certmanager.CertificateSecretTemplate)(unsafe.Pointer(in.SecretTemplate))
 	if in.Keystores != nil {
 		in, out := &in.Keystores, &out.Keystores
 		*out = new(certmanager.CertificateKeystores)
@@ -761,12 +1264,15 @@ func autoConvert_v1_CertificateSpec_To_certmanager_CertificateSpec(in *v1.Certif
 	} else {
 		out.Keystores = nil
 	}
+	out.AdditionalOutputFormats = *(*[]certmanager.CertificateAdditionalOutputFormat)(unsafe.Pointer(&in.AdditionalOutputFormats))
+	out.AdditionalSecretTargets = *(*[]certmanager.CertificateSecretTarget)(unsafe.Pointer(&in.AdditionalSecretTargets))
 	if err := internalapismetav1.Convert_v1_ObjectReference_To_meta_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
 		return err
 	}
 	out.IsCA = in.IsCA
 	out.Usages = *(*[]certmanager.KeyUsage)(unsafe.Pointer(&in.Usages))
 	out.PrivateKey = (*certmanager.CertificatePrivateKey)(unsafe.Pointer(in.PrivateKey))
+	out.PrivateKeyProfiles = *(*[]certmanager.CertificatePrivateKeyProfile)(unsafe.Pointer(&in.PrivateKeyProfiles))
 	out.EncodeUsagesInRequest = (*bool)(unsafe.Pointer(in.EncodeUsagesInRequest))
 	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
 	return nil
@@ -777,11 +1283,16 @@ func autoConvert_certmanager_CertificateSpec_To_v1_CertificateSpec(in *certmanag
 	out.CommonName = in.CommonName
 	out.Duration = (*metav1.Duration)(unsafe.Pointer(in.Duration))
 	out.RenewBefore = (*metav1.Duration)(unsafe.Pointer(in.RenewBefore))
+	out.RenewBeforePercentage = (*int32)(unsafe.Pointer(in.RenewBeforePercentage))
+	out.RenewalJitter = (*metav1.Duration)(unsafe.Pointer(in.RenewalJitter))
+	out.RenewalWindows = *(*[]v1.CertificateRenewalWindow)(unsafe.Pointer(&in.RenewalWindows))
+	out.RetryBackoff = (*v1.CertificateRetryBackoff)(unsafe.Pointer(in.RetryBackoff))
 	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
 	out.IPAddresses = *(*[]string)(unsafe.Pointer(&in.IPAddresses))
 	// WARNING: in.URISANs requires manual conversion: does not exist in peer-type
 	// WARNING: in.EmailSANs requires manual conversion: does not exist in peer-type
 	out.SecretName = in.SecretName
+	out.SecretTemplate = (*v1.CertificateSecretTemplate)(unsafe.Pointer(in.SecretTemplate))
 	if in.Keystores != nil {
 		in, out := &in.Keystores, &out.Keystores
 		*out = new(v1.CertificateKeystores)
@@ -791,12 +1302,15 @@ func autoConvert_certmanager_CertificateSpec_To_v1_CertificateSpec(in *certmanag
 	} else {
 		out.Keystores = nil
 	}
+	out.AdditionalOutputFormats = *(*[]v1.CertificateAdditionalOutputFormat)(unsafe.Pointer(&in.AdditionalOutputFormats))
+	out.AdditionalSecretTargets = *(*[]v1.CertificateSecretTarget)(unsafe.Pointer(&in.AdditionalSecretTargets))
 	if err := internalapismetav1.Convert_meta_ObjectReference_To_v1_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
 		return err
 	}
 	out.IsCA = in.IsCA
 	out.Usages = *(*[]v1.KeyUsage)(unsafe.Pointer(&in.Usages))
 	out.PrivateKey = (*v1.CertificatePrivateKey)(unsafe.Pointer(in.PrivateKey))
+	out.PrivateKeyProfiles = *(*[]v1.CertificatePrivateKeyProfile)(unsafe.Pointer(&in.PrivateKeyProfiles))
 	out.EncodeUsagesInRequest = (*bool)(unsafe.Pointer(in.EncodeUsagesInRequest))
 	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
 	return nil
@@ -805,9 +1319,11 @@ func autoConvert_certmanager_CertificateSpec_To_v1_CertificateSpec(in *certmanag
 func autoConvert_v1_CertificateStatus_To_certmanager_CertificateStatus(in *v1.CertificateStatus, out *certmanager.CertificateStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]certmanager.CertificateCondition)(unsafe.Pointer(&in.Conditions))
 	out.LastFailureTime = (*metav1.Time)(unsafe.Pointer(in.LastFailureTime))
+	out.FailedIssuanceAttempts = (*int)(unsafe.Pointer(in.FailedIssuanceAttempts))
 	out.NotBefore = (*metav1.Time)(unsafe.Pointer(in.NotBefore))
 	out.NotAfter = (*metav1.Time)(unsafe.Pointer(in.NotAfter))
 	out.RenewalTime = (*metav1.Time)(unsafe.Pointer(in.RenewalTime))
+	out.IssuerCAFingerprint = in.IssuerCAFingerprint
 	out.Revision = (*int)(unsafe.Pointer(in.Revision))
 	out.NextPrivateKeySecretName = (*string)(unsafe.Pointer(in.NextPrivateKeySecretName))
 	return nil
@@ -821,9 +1337,11 @@ func Convert_v1_CertificateStatus_To_certmanager_CertificateStatus(in *v1.Certif
 func autoConvert_certmanager_CertificateStatus_To_v1_CertificateStatus(in *certmanager.CertificateStatus, out *v1.CertificateStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]v1.CertificateCondition)(unsafe.Pointer(&in.Conditions))
 	out.LastFailureTime = (*metav1.Time)(unsafe.Pointer(in.LastFailureTime))
+	out.FailedIssuanceAttempts = (*int)(unsafe.Pointer(in.FailedIssuanceAttempts))
 	out.NotBefore = (*metav1.Time)(unsafe.Pointer(in.NotBefore))
 	out.NotAfter = (*metav1.Time)(unsafe.Pointer(in.NotAfter))
 	out.RenewalTime = (*metav1.Time)(unsafe.Pointer(in.RenewalTime))
+	out.IssuerCAFingerprint = in.IssuerCAFingerprint
 	out.Revision = (*int)(unsafe.Pointer(in.Revision))
 	out.NextPrivateKeySecretName = (*string)(unsafe.Pointer(in.NextPrivateKeySecretName))
 	return nil
@@ -940,6 +1458,26 @@ func Convert_certmanager_Issuer_To_v1_Issuer(in *certmanager.Issuer, out *v1.Iss
 	return autoConvert_certmanager_Issuer_To_v1_Issuer(in, out, s)
 }
 
+func autoConvert_v1_IssuerApproval_To_certmanager_IssuerApproval(in *v1.IssuerApproval, out *certmanager.IssuerApproval, s conversion.Scope) error {
+	out.RequiredApprovals = in.RequiredApprovals
+	return nil
+}
+
+// Convert_v1_IssuerApproval_To_certmanager_IssuerApproval is an autogenerated conversion function.
+func Convert_v1_IssuerApproval_To_certmanager_IssuerApproval(in *v1.IssuerApproval, out *certmanager.IssuerApproval, s conversion.Scope) error {
+	return autoConvert_v1_IssuerApproval_To_certmanager_IssuerApproval(in, out, s)
+}
+
+func autoConvert_certmanager_IssuerApproval_To_v1_IssuerApproval(in *certmanager.IssuerApproval, out *v1.IssuerApproval, s conversion.Scope) error {
+	out.RequiredApprovals = in.RequiredApprovals
+	return nil
+}
+
+// Convert_certmanager_IssuerApproval_To_v1_IssuerApproval is an autogenerated conversion function.
+func Convert_certmanager_IssuerApproval_To_v1_IssuerApproval(in *certmanager.IssuerApproval, out *v1.IssuerApproval, s conversion.Scope) error {
+	return autoConvert_certmanager_IssuerApproval_To_v1_IssuerApproval(in, out, s)
+}
+
 func autoConvert_v1_IssuerCondition_To_certmanager_IssuerCondition(in *v1.IssuerCondition, out *certmanager.IssuerCondition, s conversion.Scope) error {
 	out.Type = certmanager.IssuerConditionType(in.Type)
 	out.Status = meta.ConditionStatus(in.Status)
@@ -1046,6 +1584,34 @@ func Convert_certmanager_IssuerConfig_To_v1_IssuerConfig(in *certmanager.IssuerC
 	return autoConvert_certmanager_IssuerConfig_To_v1_IssuerConfig(in, out, s)
 }
 
+func autoConvert_v1_IssuerConstraints_To_certmanager_IssuerConstraints(in *v1.IssuerConstraints, out *certmanager.IssuerConstraints, s conversion.Scope) error {
+	out.MinDuration = (*metav1.Duration)(unsafe.Pointer(in.MinDuration))
+	out.MaxDuration = (*metav1.Duration)(unsafe.Pointer(in.MaxDuration))
+	out.AllowedPrivateKeys = *(*[]certmanager.IssuerPrivateKeyConstraint)(unsafe.Pointer(&in.AllowedPrivateKeys))
+	out.AllowedDomains = *(*[]string)(unsafe.Pointer(&in.AllowedDomains))
+	out.AllowCA = in.AllowCA
+	return nil
+}
+
+// Convert_v1_IssuerConstraints_To_certmanager_IssuerConstraints is an autogenerated conversion function.
+func Convert_v1_IssuerConstraints_To_certmanager_IssuerConstraints(in *v1.IssuerConstraints, out *certmanager.IssuerConstraints, s conversion.Scope) error {
+	return autoConvert_v1_IssuerConstraints_To_certmanager_IssuerConstraints(in, out, s)
+}
+
+func autoConvert_certmanager_IssuerConstraints_To_v1_IssuerConstraints(in *certmanager.IssuerConstraints, out *v1.IssuerConstraints, s conversion.Scope) error {
+	out.MinDuration = (*metav1.Duration)(unsafe.Pointer(in.MinDuration))
+	out.MaxDuration = (*metav1.Duration)(unsafe.Pointer(in.MaxDuration))
+	out.AllowedPrivateKeys = *(*[]v1.IssuerPrivateKeyConstraint)(unsafe.Pointer(&in.AllowedPrivateKeys))
+	out.AllowedDomains = *(*[]string)(unsafe.Pointer(&in.AllowedDomains))
+	out.AllowCA = in.AllowCA
+	return nil
+}
+
+// Convert_certmanager_IssuerConstraints_To_v1_IssuerConstraints is an autogenerated conversion function.
+func Convert_certmanager_IssuerConstraints_To_v1_IssuerConstraints(in *certmanager.IssuerConstraints, out *v1.IssuerConstraints, s conversion.Scope) error {
+	return autoConvert_certmanager_IssuerConstraints_To_v1_IssuerConstraints(in, out, s)
+}
+
 func autoConvert_v1_IssuerList_To_certmanager_IssuerList(in *v1.IssuerList, out *certmanager.IssuerList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -1088,10 +1654,35 @@ func Convert_certmanager_IssuerList_To_v1_IssuerList(in *certmanager.IssuerList,
 	return autoConvert_certmanager_IssuerList_To_v1_IssuerList(in, out, s)
 }
 
+func autoConvert_v1_IssuerPrivateKeyConstraint_To_certmanager_IssuerPrivateKeyConstraint(in *v1.IssuerPrivateKeyConstraint, out *certmanager.IssuerPrivateKeyConstraint, s conversion.Scope) error {
+	out.Algorithm = certmanager.PrivateKeyAlgorithm(in.Algorithm)
+	out.MinSize = in.MinSize
+	return nil
+}
+
+// Convert_v1_IssuerPrivateKeyConstraint_To_certmanager_IssuerPrivateKeyConstraint is an autogenerated conversion function.
+func Convert_v1_IssuerPrivateKeyConstraint_To_certmanager_IssuerPrivateKeyConstraint(in *v1.IssuerPrivateKeyConstraint, out *certmanager.IssuerPrivateKeyConstraint, s conversion.Scope) error {
+	return autoConvert_v1_IssuerPrivateKeyConstraint_To_certmanager_IssuerPrivateKeyConstraint(in, out, s)
+}
+
+func autoConvert_certmanager_IssuerPrivateKeyConstraint_To_v1_IssuerPrivateKeyConstraint(in *certmanager.IssuerPrivateKeyConstraint, out *v1.IssuerPrivateKeyConstraint, s conversion.Scope) error {
+	out.Algorithm = v1.PrivateKeyAlgorithm(in.Algorithm)
+	out.MinSize = in.MinSize
+	return nil
+}
+
+// Convert_certmanager_IssuerPrivateKeyConstraint_To_v1_IssuerPrivateKeyConstraint is an autogenerated conversion function.
+func Convert_certmanager_IssuerPrivateKeyConstraint_To_v1_IssuerPrivateKeyConstraint(in *certmanager.IssuerPrivateKeyConstraint, out *v1.IssuerPrivateKeyConstraint, s conversion.Scope) error {
+	return autoConvert_certmanager_IssuerPrivateKeyConstraint_To_v1_IssuerPrivateKeyConstraint(in, out, s)
+}
+
 func autoConvert_v1_IssuerSpec_To_certmanager_IssuerSpec(in *v1.IssuerSpec, out *certmanager.IssuerSpec, s conversion.Scope) error {
 	if err := Convert_v1_IssuerConfig_To_certmanager_IssuerConfig(&in.IssuerConfig, &out.IssuerConfig, s); err != nil {
 		return err
 	}
+	out.Approval = (*certmanager.IssuerApproval)(unsafe.Pointer(in.Approval))
+	out.Constraints = (*certmanager.IssuerConstraints)(unsafe.Pointer(in.Constraints))
+	out.NamespaceSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
 	return nil
 }
 
@@ -1104,6 +1695,9 @@ func autoConvert_certmanager_IssuerSpec_To_v1_IssuerSpec(in *certmanager.IssuerS
 	if err := Convert_certmanager_IssuerConfig_To_v1_IssuerConfig(&in.IssuerConfig, &out.IssuerConfig, s); err != nil {
 		return err
 	}
+	out.Approval = (*v1.IssuerApproval)(unsafe.Pointer(in.Approval))
+	out.Constraints = (*v1.IssuerConstraints)(unsafe.Pointer(in.Constraints))
+	out.NamespaceSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
 	return nil
 }
 
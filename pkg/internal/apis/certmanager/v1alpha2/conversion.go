@@ -109,6 +109,77 @@ func Convert_certmanager_CertificatePrivateKey_To_v1alpha2_CertificatePrivateKey
 	return autoConvert_certmanager_CertificatePrivateKey_To_v1alpha2_CertificatePrivateKey(in, out, s)
 }
 
+func Convert_v1alpha2_CertificatePrivateKeyProfile_To_certmanager_CertificatePrivateKeyProfile(in *v1alpha2.CertificatePrivateKeyProfile, out *certmanager.CertificatePrivateKeyProfile, s conversion.Scope) error {
+	if err := autoConvert_v1alpha2_CertificatePrivateKeyProfile_To_certmanager_CertificatePrivateKeyProfile(in, out, s); err != nil {
+		return err
+	}
+
+	out.PrivateKey = certmanager.CertificatePrivateKey{}
+	if in.PrivateKey != nil {
+		if err := Convert_v1alpha2_CertificatePrivateKey_To_certmanager_CertificatePrivateKey(in.PrivateKey, &out.PrivateKey, s); err != nil {
+			return err
+		}
+	}
+
+	switch in.KeyAlgorithm {
+	case v1alpha2.ECDSAKeyAlgorithm:
+		out.PrivateKey.Algorithm = certmanager.ECDSAKeyAlgorithm
+	case v1alpha2.RSAKeyAlgorithm:
+		out.PrivateKey.Algorithm = certmanager.RSAKeyAlgorithm
+	default:
+		out.PrivateKey.Algorithm = certmanager.PrivateKeyAlgorithm(in.KeyAlgorithm)
+	}
+
+	switch in.KeyEncoding {
+	case v1alpha2.PKCS1:
+		out.PrivateKey.Encoding = certmanager.PKCS1
+	case v1alpha2.PKCS8:
+		out.PrivateKey.Encoding = certmanager.PKCS8
+	default:
+		out.PrivateKey.Encoding = certmanager.PrivateKeyEncoding(in.KeyEncoding)
+	}
+
+	out.PrivateKey.Size = in.KeySize
+
+	return nil
+}
+
+func Convert_certmanager_CertificatePrivateKeyProfile_To_v1alpha2_CertificatePrivateKeyProfile(in *certmanager.CertificatePrivateKeyProfile, out *v1alpha2.CertificatePrivateKeyProfile, s conversion.Scope) error {
+	if err := autoConvert_certmanager_CertificatePrivateKeyProfile_To_v1alpha2_CertificatePrivateKeyProfile(in, out, s); err != nil {
+		return err
+	}
+
+	out.PrivateKey = nil
+	if in.PrivateKey.RotationPolicy != "" {
+		out.PrivateKey = &v1alpha2.CertificatePrivateKey{}
+		if err := Convert_certmanager_CertificatePrivateKey_To_v1alpha2_CertificatePrivateKey(&in.PrivateKey, out.PrivateKey, s); err != nil {
+			return err
+		}
+	}
+
+	switch in.PrivateKey.Algorithm {
+	case certmanager.ECDSAKeyAlgorithm:
+		out.KeyAlgorithm = v1alpha2.ECDSAKeyAlgorithm
+	case certmanager.RSAKeyAlgorithm:
+		out.KeyAlgorithm = v1alpha2.RSAKeyAlgorithm
+	default:
+		out.KeyAlgorithm = v1alpha2.KeyAlgorithm(in.PrivateKey.Algorithm)
+	}
+
+	switch in.PrivateKey.Encoding {
+	case certmanager.PKCS1:
+		out.KeyEncoding = v1alpha2.PKCS1
+	case certmanager.PKCS8:
+		out.KeyEncoding = v1alpha2.PKCS8
+	default:
+		out.KeyEncoding = v1alpha2.KeyEncoding(in.PrivateKey.Encoding)
+	}
+
+	out.KeySize = in.PrivateKey.Size
+
+	return nil
+}
+
 func Convert_v1alpha2_CertificateRequestSpec_To_certmanager_CertificateRequestSpec(in *v1alpha2.CertificateRequestSpec, out *certmanager.CertificateRequestSpec, s conversion.Scope) error {
 	if err := autoConvert_v1alpha2_CertificateRequestSpec_To_certmanager_CertificateRequestSpec(in, out, s); err != nil {
 		return err
@@ -126,3 +197,37 @@ func Convert_certmanager_CertificateRequestSpec_To_v1alpha2_CertificateRequestSp
 	out.CSRPEM = in.Request
 	return nil
 }
+
+func Convert_v1alpha2_IssuerPrivateKeyConstraint_To_certmanager_IssuerPrivateKeyConstraint(in *v1alpha2.IssuerPrivateKeyConstraint, out *certmanager.IssuerPrivateKeyConstraint, s conversion.Scope) error {
+	if err := autoConvert_v1alpha2_IssuerPrivateKeyConstraint_To_certmanager_IssuerPrivateKeyConstraint(in, out, s); err != nil {
+		return err
+	}
+
+	switch in.Algorithm {
+	case v1alpha2.ECDSAKeyAlgorithm:
+		out.Algorithm = certmanager.ECDSAKeyAlgorithm
+	case v1alpha2.RSAKeyAlgorithm:
+		out.Algorithm = certmanager.RSAKeyAlgorithm
+	default:
+		out.Algorithm = certmanager.PrivateKeyAlgorithm(in.Algorithm)
+	}
+
+	return nil
+}
+
+func Convert_certmanager_IssuerPrivateKeyConstraint_To_v1alpha2_IssuerPrivateKeyConstraint(in *certmanager.IssuerPrivateKeyConstraint, out *v1alpha2.IssuerPrivateKeyConstraint, s conversion.Scope) error {
+	if err := autoConvert_certmanager_IssuerPrivateKeyConstraint_To_v1alpha2_IssuerPrivateKeyConstraint(in, out, s); err != nil {
+		return err
+	}
+
+	switch in.Algorithm {
+	case certmanager.ECDSAKeyAlgorithm:
+		out.Algorithm = v1alpha2.ECDSAKeyAlgorithm
+	case certmanager.RSAKeyAlgorithm:
+		out.Algorithm = v1alpha2.RSAKeyAlgorithm
+	default:
+		out.Algorithm = v1alpha2.KeyAlgorithm(in.Algorithm)
+	}
+
+	return nil
+}
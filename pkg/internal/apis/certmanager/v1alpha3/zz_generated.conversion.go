@@ -63,6 +63,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.CertificateAdditionalOutputFormat)(nil), (*certmanager.CertificateAdditionalOutputFormat)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_CertificateAdditionalOutputFormat_To_certmanager_CertificateAdditionalOutputFormat(a.(*v1alpha3.CertificateAdditionalOutputFormat), b.(*certmanager.CertificateAdditionalOutputFormat), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateAdditionalOutputFormat)(nil), (*v1alpha3.CertificateAdditionalOutputFormat)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateAdditionalOutputFormat_To_v1alpha3_CertificateAdditionalOutputFormat(a.(*certmanager.CertificateAdditionalOutputFormat), b.(*v1alpha3.CertificateAdditionalOutputFormat), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1alpha3.CertificateCondition)(nil), (*certmanager.CertificateCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_CertificateCondition_To_certmanager_CertificateCondition(a.(*v1alpha3.CertificateCondition), b.(*certmanager.CertificateCondition), scope)
 	}); err != nil {
@@ -98,6 +108,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.CertificateRenewalWindow)(nil), (*certmanager.CertificateRenewalWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_CertificateRenewalWindow_To_certmanager_CertificateRenewalWindow(a.(*v1alpha3.CertificateRenewalWindow), b.(*certmanager.CertificateRenewalWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRenewalWindow)(nil), (*v1alpha3.CertificateRenewalWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRenewalWindow_To_v1alpha3_CertificateRenewalWindow(a.(*certmanager.CertificateRenewalWindow), b.(*v1alpha3.CertificateRenewalWindow), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1alpha3.CertificateRequest)(nil), (*certmanager.CertificateRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_CertificateRequest_To_certmanager_CertificateRequest(a.(*v1alpha3.CertificateRequest), b.(*certmanager.CertificateRequest), scope)
 	}); err != nil {
@@ -108,6 +128,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.CertificateRequestApproval)(nil), (*certmanager.CertificateRequestApproval)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_CertificateRequestApproval_To_certmanager_CertificateRequestApproval(a.(*v1alpha3.CertificateRequestApproval), b.(*certmanager.CertificateRequestApproval), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRequestApproval)(nil), (*v1alpha3.CertificateRequestApproval)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRequestApproval_To_v1alpha3_CertificateRequestApproval(a.(*certmanager.CertificateRequestApproval), b.(*v1alpha3.CertificateRequestApproval), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1alpha3.CertificateRequestCondition)(nil), (*certmanager.CertificateRequestCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_CertificateRequestCondition_To_certmanager_CertificateRequestCondition(a.(*v1alpha3.CertificateRequestCondition), b.(*certmanager.CertificateRequestCondition), scope)
 	}); err != nil {
@@ -138,6 +168,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.CertificateRetryBackoff)(nil), (*certmanager.CertificateRetryBackoff)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_CertificateRetryBackoff_To_certmanager_CertificateRetryBackoff(a.(*v1alpha3.CertificateRetryBackoff), b.(*certmanager.CertificateRetryBackoff), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRetryBackoff)(nil), (*v1alpha3.CertificateRetryBackoff)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRetryBackoff_To_v1alpha3_CertificateRetryBackoff(a.(*certmanager.CertificateRetryBackoff), b.(*v1alpha3.CertificateRetryBackoff), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.CertificateSecretTarget)(nil), (*certmanager.CertificateSecretTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_CertificateSecretTarget_To_certmanager_CertificateSecretTarget(a.(*v1alpha3.CertificateSecretTarget), b.(*certmanager.CertificateSecretTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateSecretTarget)(nil), (*v1alpha3.CertificateSecretTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateSecretTarget_To_v1alpha3_CertificateSecretTarget(a.(*certmanager.CertificateSecretTarget), b.(*v1alpha3.CertificateSecretTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.CertificateSecretTemplate)(nil), (*certmanager.CertificateSecretTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_CertificateSecretTemplate_To_certmanager_CertificateSecretTemplate(a.(*v1alpha3.CertificateSecretTemplate), b.(*certmanager.CertificateSecretTemplate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateSecretTemplate)(nil), (*v1alpha3.CertificateSecretTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateSecretTemplate_To_v1alpha3_CertificateSecretTemplate(a.(*certmanager.CertificateSecretTemplate), b.(*v1alpha3.CertificateSecretTemplate), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1alpha3.CertificateStatus)(nil), (*certmanager.CertificateStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_CertificateStatus_To_certmanager_CertificateStatus(a.(*v1alpha3.CertificateStatus), b.(*certmanager.CertificateStatus), scope)
 	}); err != nil {
@@ -178,6 +238,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.IssuerApproval)(nil), (*certmanager.IssuerApproval)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_IssuerApproval_To_certmanager_IssuerApproval(a.(*v1alpha3.IssuerApproval), b.(*certmanager.IssuerApproval), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.IssuerApproval)(nil), (*v1alpha3.IssuerApproval)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_IssuerApproval_To_v1alpha3_IssuerApproval(a.(*certmanager.IssuerApproval), b.(*v1alpha3.IssuerApproval), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1alpha3.IssuerCondition)(nil), (*certmanager.IssuerCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_IssuerCondition_To_certmanager_IssuerCondition(a.(*v1alpha3.IssuerCondition), b.(*certmanager.IssuerCondition), scope)
 	}); err != nil {
@@ -198,6 +268,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha3.IssuerConstraints)(nil), (*certmanager.IssuerConstraints)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_IssuerConstraints_To_certmanager_IssuerConstraints(a.(*v1alpha3.IssuerConstraints), b.(*certmanager.IssuerConstraints), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.IssuerConstraints)(nil), (*v1alpha3.IssuerConstraints)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_IssuerConstraints_To_v1alpha3_IssuerConstraints(a.(*certmanager.IssuerConstraints), b.(*v1alpha3.IssuerConstraints), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1alpha3.IssuerList)(nil), (*certmanager.IssuerList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_IssuerList_To_certmanager_IssuerList(a.(*v1alpha3.IssuerList), b.(*certmanager.IssuerList), scope)
 	}); err != nil {
@@ -333,6 +413,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*certmanager.CertificatePrivateKeyProfile)(nil), (*v1alpha3.CertificatePrivateKeyProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificatePrivateKeyProfile_To_v1alpha3_CertificatePrivateKeyProfile(a.(*certmanager.CertificatePrivateKeyProfile), b.(*v1alpha3.CertificatePrivateKeyProfile), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*certmanager.CertificatePrivateKey)(nil), (*v1alpha3.CertificatePrivateKey)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_certmanager_CertificatePrivateKey_To_v1alpha3_CertificatePrivateKey(a.(*certmanager.CertificatePrivateKey), b.(*v1alpha3.CertificatePrivateKey), scope)
 	}); err != nil {
@@ -348,11 +433,21 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*certmanager.IssuerPrivateKeyConstraint)(nil), (*v1alpha3.IssuerPrivateKeyConstraint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_IssuerPrivateKeyConstraint_To_v1alpha3_IssuerPrivateKeyConstraint(a.(*certmanager.IssuerPrivateKeyConstraint), b.(*v1alpha3.IssuerPrivateKeyConstraint), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*certmanager.X509Subject)(nil), (*v1alpha3.X509Subject)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_certmanager_X509Subject_To_v1alpha3_X509Subject(a.(*certmanager.X509Subject), b.(*v1alpha3.X509Subject), scope)
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1alpha3.CertificatePrivateKeyProfile)(nil), (*certmanager.CertificatePrivateKeyProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_CertificatePrivateKeyProfile_To_certmanager_CertificatePrivateKeyProfile(a.(*v1alpha3.CertificatePrivateKeyProfile), b.(*certmanager.CertificatePrivateKeyProfile), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1alpha3.CertificateRequestSpec)(nil), (*certmanager.CertificateRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_CertificateRequestSpec_To_certmanager_CertificateRequestSpec(a.(*v1alpha3.CertificateRequestSpec), b.(*certmanager.CertificateRequestSpec), scope)
 	}); err != nil {
@@ -363,6 +458,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1alpha3.IssuerPrivateKeyConstraint)(nil), (*certmanager.IssuerPrivateKeyConstraint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_IssuerPrivateKeyConstraint_To_certmanager_IssuerPrivateKeyConstraint(a.(*v1alpha3.IssuerPrivateKeyConstraint), b.(*certmanager.IssuerPrivateKeyConstraint), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -422,6 +522,26 @@ func Convert_certmanager_Certificate_To_v1alpha3_Certificate(in *certmanager.Cer
 	return autoConvert_certmanager_Certificate_To_v1alpha3_Certificate(in, out, s)
 }
 
+func autoConvert_v1alpha3_CertificateAdditionalOutputFormat_To_certmanager_CertificateAdditionalOutputFormat(in *v1alpha3.CertificateAdditionalOutputFormat, out *certmanager.CertificateAdditionalOutputFormat, s conversion.Scope) error {
+	out.Type = certmanager.CertificateOutputFormatType(in.Type)
+	return nil
+}
+
+// Convert_v1alpha3_CertificateAdditionalOutputFormat_To_certmanager_CertificateAdditionalOutputFormat is an autogenerated conversion function.
+func Convert_v1alpha3_CertificateAdditionalOutputFormat_To_certmanager_CertificateAdditionalOutputFormat(in *v1alpha3.CertificateAdditionalOutputFormat, out *certmanager.CertificateAdditionalOutputFormat, s conversion.Scope) error {
+	return autoConvert_v1alpha3_CertificateAdditionalOutputFormat_To_certmanager_CertificateAdditionalOutputFormat(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateAdditionalOutputFormat_To_v1alpha3_CertificateAdditionalOutputFormat(in *certmanager.CertificateAdditionalOutputFormat, out *v1alpha3.CertificateAdditionalOutputFormat, s conversion.Scope) error {
+	out.Type = v1alpha3.CertificateOutputFormatType(in.Type)
+	return nil
+}
+
+// Convert_certmanager_CertificateAdditionalOutputFormat_To_v1alpha3_CertificateAdditionalOutputFormat is an autogenerated conversion function.
+func Convert_certmanager_CertificateAdditionalOutputFormat_To_v1alpha3_CertificateAdditionalOutputFormat(in *certmanager.CertificateAdditionalOutputFormat, out *v1alpha3.CertificateAdditionalOutputFormat, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateAdditionalOutputFormat_To_v1alpha3_CertificateAdditionalOutputFormat(in, out, s)
+}
+
 func autoConvert_v1alpha3_CertificateCondition_To_certmanager_CertificateCondition(in *v1alpha3.CertificateCondition, out *certmanager.CertificateCondition, s conversion.Scope) error {
 	out.Type = certmanager.CertificateConditionType(in.Type)
 	out.Status = meta.ConditionStatus(in.Status)
@@ -566,6 +686,45 @@ func autoConvert_certmanager_CertificatePrivateKey_To_v1alpha3_CertificatePrivat
 	return nil
 }
 
+func autoConvert_v1alpha3_CertificatePrivateKeyProfile_To_certmanager_CertificatePrivateKeyProfile(in *v1alpha3.CertificatePrivateKeyProfile, out *certmanager.CertificatePrivateKeyProfile, s conversion.Scope) error {
+	out.Name = in.Name
+	// WARNING: in.KeySize requires manual conversion: does not exist in peer-type
+	// WARNING: in.KeyAlgorithm requires manual conversion: does not exist in peer-type
+	// WARNING: in.KeyEncoding requires manual conversion: does not exist in peer-type
+	// WARNING: in.PrivateKey requires manual conversion: inconvertible types (*github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha3.CertificatePrivateKey vs github.com/jetstack/cert-manager/pkg/internal/apis/certmanager.CertificatePrivateKey)
+	return nil
+}
+
+func autoConvert_certmanager_CertificatePrivateKeyProfile_To_v1alpha3_CertificatePrivateKeyProfile(in *certmanager.CertificatePrivateKeyProfile, out *v1alpha3.CertificatePrivateKeyProfile, s conversion.Scope) error {
+	out.Name = in.Name
+	// WARNING: in.PrivateKey requires manual conversion: inconvertible types (github.com/jetstack/cert-manager/pkg/internal/apis/certmanager.CertificatePrivateKey vs *github.com/jetstack/cert-manager/pkg/apis/certmanager/v1alpha3.CertificatePrivateKey)
+	return nil
+}
+
+func autoConvert_v1alpha3_CertificateRenewalWindow_To_certmanager_CertificateRenewalWindow(in *v1alpha3.CertificateRenewalWindow, out *certmanager.CertificateRenewalWindow, s conversion.Scope) error {
+	out.Schedule = in.Schedule
+	out.Duration = in.Duration
+	out.TimeZone = in.TimeZone
+	return nil
+}
+
+// Convert_v1alpha3_CertificateRenewalWindow_To_certmanager_CertificateRenewalWindow is an autogenerated conversion function.
+func Convert_v1alpha3_CertificateRenewalWindow_To_certmanager_CertificateRenewalWindow(in *v1alpha3.CertificateRenewalWindow, out *certmanager.CertificateRenewalWindow, s conversion.Scope) error {
+	return autoConvert_v1alpha3_CertificateRenewalWindow_To_certmanager_CertificateRenewalWindow(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRenewalWindow_To_v1alpha3_CertificateRenewalWindow(in *certmanager.CertificateRenewalWindow, out *v1alpha3.CertificateRenewalWindow, s conversion.Scope) error {
+	out.Schedule = in.Schedule
+	out.Duration = in.Duration
+	out.TimeZone = in.TimeZone
+	return nil
+}
+
+// Convert_certmanager_CertificateRenewalWindow_To_v1alpha3_CertificateRenewalWindow is an autogenerated conversion function.
+func Convert_certmanager_CertificateRenewalWindow_To_v1alpha3_CertificateRenewalWindow(in *certmanager.CertificateRenewalWindow, out *v1alpha3.CertificateRenewalWindow, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRenewalWindow_To_v1alpha3_CertificateRenewalWindow(in, out, s)
+}
+
 func autoConvert_v1alpha3_CertificateRequest_To_certmanager_CertificateRequest(in *v1alpha3.CertificateRequest, out *certmanager.CertificateRequest, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha3_CertificateRequestSpec_To_certmanager_CertificateRequestSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -598,6 +757,32 @@ func Convert_certmanager_CertificateRequest_To_v1alpha3_CertificateRequest(in *c
 	return autoConvert_certmanager_CertificateRequest_To_v1alpha3_CertificateRequest(in, out, s)
 }
 
+func autoConvert_v1alpha3_CertificateRequestApproval_To_certmanager_CertificateRequestApproval(in *v1alpha3.CertificateRequestApproval, out *certmanager.CertificateRequestApproval, s conversion.Scope) error {
+	out.Username = in.Username
+	out.ApprovalTime = (*v1.Time)(unsafe.Pointer(in.ApprovalTime))
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1alpha3_CertificateRequestApproval_To_certmanager_CertificateRequestApproval is an autogenerated conversion function.
+func Convert_v1alpha3_CertificateRequestApproval_To_certmanager_CertificateRequestApproval(in *v1alpha3.CertificateRequestApproval, out *certmanager.CertificateRequestApproval, s conversion.Scope) error {
+	return autoConvert_v1alpha3_CertificateRequestApproval_To_certmanager_CertificateRequestApproval(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRequestApproval_To_v1alpha3_CertificateRequestApproval(in *certmanager.CertificateRequestApproval, out *v1alpha3.CertificateRequestApproval, s conversion.Scope) error {
+	out.Username = in.Username
+	out.ApprovalTime = (*v1.Time)(unsafe.Pointer(in.ApprovalTime))
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_certmanager_CertificateRequestApproval_To_v1alpha3_CertificateRequestApproval is an autogenerated conversion function.
+func Convert_certmanager_CertificateRequestApproval_To_v1alpha3_CertificateRequestApproval(in *certmanager.CertificateRequestApproval, out *v1alpha3.CertificateRequestApproval, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRequestApproval_To_v1alpha3_CertificateRequestApproval(in, out, s)
+}
+
 func autoConvert_v1alpha3_CertificateRequestCondition_To_certmanager_CertificateRequestCondition(in *v1alpha3.CertificateRequestCondition, out *certmanager.CertificateRequestCondition, s conversion.Scope) error {
 	out.Type = certmanager.CertificateRequestConditionType(in.Type)
 	out.Status = meta.ConditionStatus(in.Status)
@@ -703,6 +888,7 @@ func autoConvert_v1alpha3_CertificateRequestStatus_To_certmanager_CertificateReq
 	out.Certificate = *(*[]byte)(unsafe.Pointer(&in.Certificate))
 	out.CA = *(*[]byte)(unsafe.Pointer(&in.CA))
 	out.FailureTime = (*v1.Time)(unsafe.Pointer(in.FailureTime))
+	out.Approvals = *(*[]certmanager.CertificateRequestApproval)(unsafe.Pointer(&in.Approvals))
 	return nil
 }
 
@@ -716,6 +902,7 @@ func autoConvert_certmanager_CertificateRequestStatus_To_v1alpha3_CertificateReq
 	out.Certificate = *(*[]byte)(unsafe.Pointer(&in.Certificate))
 	out.CA = *(*[]byte)(unsafe.Pointer(&in.CA))
 	out.FailureTime = (*v1.Time)(unsafe.Pointer(in.FailureTime))
+	out.Approvals = *(*[]v1alpha3.CertificateRequestApproval)(unsafe.Pointer(&in.Approvals))
 	return nil
 }
 
@@ -724,6 +911,72 @@ func Convert_certmanager_CertificateRequestStatus_To_v1alpha3_CertificateRequest
 	return autoConvert_certmanager_CertificateRequestStatus_To_v1alpha3_CertificateRequestStatus(in, out, s)
 }
 
+func autoConvert_v1alpha3_CertificateRetryBackoff_To_certmanager_CertificateRetryBackoff(in *v1alpha3.CertificateRetryBackoff, out *certmanager.CertificateRetryBackoff, s conversion.Scope) error {
+	out.Min = (*v1.Duration)(unsafe.Pointer(in.Min))
+	out.Max = (*v1.Duration)(unsafe.Pointer(in.Max))
+	return nil
+}
+
+// Convert_v1alpha3_CertificateRetryBackoff_To_certmanager_CertificateRetryBackoff is an autogenerated conversion function.
+func Convert_v1alpha3_CertificateRetryBackoff_To_certmanager_CertificateRetryBackoff(in *v1alpha3.CertificateRetryBackoff, out *certmanager.CertificateRetryBackoff, s conversion.Scope) error {
+	return autoConvert_v1alpha3_CertificateRetryBackoff_To_certmanager_CertificateRetryBackoff(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRetryBackoff_To_v1alpha3_CertificateRetryBackoff(in *certmanager.CertificateRetryBackoff, out *v1alpha3.CertificateRetryBackoff, s conversion.Scope) error {
+	out.Min = (*v1.Duration)(unsafe.Pointer(in.Min))
+	out.Max = (*v1.Duration)(unsafe.Pointer(in.Max))
+	return nil
+}
+
+// Convert_certmanager_CertificateRetryBackoff_To_v1alpha3_CertificateRetryBackoff is an autogenerated conversion function.
+func Convert_certmanager_CertificateRetryBackoff_To_v1alpha3_CertificateRetryBackoff(in *certmanager.CertificateRetryBackoff, out *v1alpha3.CertificateRetryBackoff, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRetryBackoff_To_v1alpha3_CertificateRetryBackoff(in, out, s)
+}
+
+func autoConvert_v1alpha3_CertificateSecretTarget_To_certmanager_CertificateSecretTarget(in *v1alpha3.CertificateSecretTarget, out *certmanager.CertificateSecretTarget, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1alpha3_CertificateSecretTarget_To_certmanager_CertificateSecretTarget is an autogenerated conversion function.
+func Convert_v1alpha3_CertificateSecretTarget_To_certmanager_CertificateSecretTarget(in *v1alpha3.CertificateSecretTarget, out *certmanager.CertificateSecretTarget, s conversion.Scope) error {
+	return autoConvert_v1alpha3_CertificateSecretTarget_To_certmanager_CertificateSecretTarget(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateSecretTarget_To_v1alpha3_CertificateSecretTarget(in *certmanager.CertificateSecretTarget, out *v1alpha3.CertificateSecretTarget, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_certmanager_CertificateSecretTarget_To_v1alpha3_CertificateSecretTarget is an autogenerated conversion function.
+func Convert_certmanager_CertificateSecretTarget_To_v1alpha3_CertificateSecretTarget(in *certmanager.CertificateSecretTarget, out *v1alpha3.CertificateSecretTarget, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateSecretTarget_To_v1alpha3_CertificateSecretTarget(in, out, s)
+}
+
+func autoConvert_v1alpha3_CertificateSecretTemplate_To_certmanager_CertificateSecretTemplate(in *v1alpha3.CertificateSecretTemplate, out *certmanager.CertificateSecretTemplate, s conversion.Scope) error {
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	return nil
+}
+
+// Convert_v1alpha3_CertificateSecretTemplate_To_certmanager_CertificateSecretTemplate is an autogenerated conversion function.
+func Convert_v1alpha3_CertificateSecretTemplate_To_certmanager_CertificateSecretTemplate(in *v1alpha3.CertificateSecretTemplate, out *certmanager.CertificateSecretTemplate, s conversion.Scope) error {
+	return autoConvert_v1alpha3_CertificateSecretTemplate_To_certmanager_CertificateSecretTemplate(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateSecretTemplate_To_v1alpha3_CertificateSecretTemplate(in *certmanager.CertificateSecretTemplate, out *v1alpha3.CertificateSecretTemplate, s conversion.Scope) error {
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	return nil
+}
+
+// Convert_certmanager_CertificateSecretTemplate_To_v1alpha3_CertificateSecretTemplate is an autogenerated conversion function.
+func Convert_certmanager_CertificateSecretTemplate_To_v1alpha3_CertificateSecretTemplate(in *certmanager.CertificateSecretTemplate, out *v1alpha3.CertificateSecretTemplate, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateSecretTemplate_To_v1alpha3_CertificateSecretTemplate(in, out, s)
+}
+
 func autoConvert_v1alpha3_CertificateSpec_To_certmanager_CertificateSpec(in *v1alpha3.CertificateSpec, out *certmanager.CertificateSpec, s conversion.Scope) error {
 	if in.Subject != nil {
 		in, out := &in.Subject, &out.Subject
@@ -737,11 +990,16 @@ func autoConvert_v1alpha3_CertificateSpec_To_certmanager_CertificateSpec(in *v1a
 	out.CommonName = in.CommonName
 	out.Duration = (*v1.Duration)(unsafe.Pointer(in.Duration))
 	out.RenewBefore = (*v1.Duration)(unsafe.Pointer(in.RenewBefore))
+	out.RenewBeforePercentage = (*int32)(unsafe.Pointer(in.RenewBeforePercentage))
+	out.RenewalJitter = (*v1.Duration)(unsafe.Pointer(in.RenewalJitter))
+	out.RenewalWindows = *(*[]certmanager.CertificateRenewalWindow)(unsafe.Pointer(&in.RenewalWindows))
+	out.RetryBackoff = (*certmanager.CertificateRetryBackoff)(unsafe.Pointer(in.RetryBackoff))
 	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
 	out.IPAddresses = *(*[]string)(unsafe.Pointer(&in.IPAddresses))
 	out.URISANs = *(*[]string)(unsafe.Pointer(&in.URISANs))
 	out.EmailSANs = *(*[]string)(unsafe.Pointer(&in.EmailSANs))
 	out.SecretName = in.SecretName
+	out.SecretTemplate = (*certmanager.CertificateSecretTemplate)(unsafe.Pointer(in.SecretTemplate))
 	if in.Keystores != nil {
 		in, out := &in.Keystores, &out.Keystores
 		*out = new(certmanager.CertificateKeystores)
@@ -751,6 +1009,8 @@ func autoConvert_v1alpha3_CertificateSpec_To_certmanager_CertificateSpec(in *v1a
 	} else {
 		out.Keystores = nil
 	}
+	out.AdditionalOutputFormats = *(*[]certmanager.CertificateAdditionalOutputFormat)(unsafe.Pointer(&in.AdditionalOutputFormats))
+	out.AdditionalSecretTargets = *(*[]certmanager.CertificateSecretTarget)(unsafe.Pointer(&in.AdditionalSecretTargets))
 	if err := apismetav1.Convert_v1_ObjectReference_To_meta_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
 		return err
 	}
@@ -768,6 +1028,17 @@ func autoConvert_v1alpha3_CertificateSpec_To_certmanager_CertificateSpec(in *v1a
 	} else {
 		out.PrivateKey = nil
 	}
+	if in.PrivateKeyProfiles != nil {
+		in, out := &in.PrivateKeyProfiles, &out.PrivateKeyProfiles
+		*out = make([]certmanager.CertificatePrivateKeyProfile, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha3_CertificatePrivateKeyProfile_To_certmanager_CertificatePrivateKeyProfile(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.PrivateKeyProfiles = nil
+	}
 	out.EncodeUsagesInRequest = (*bool)(unsafe.Pointer(in.EncodeUsagesInRequest))
 	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
 	return nil
@@ -786,11 +1057,16 @@ func autoConvert_certmanager_CertificateSpec_To_v1alpha3_CertificateSpec(in *cer
 	out.CommonName = in.CommonName
 	out.Duration = (*v1.Duration)(unsafe.Pointer(in.Duration))
 	out.RenewBefore = (*v1.Duration)(unsafe.Pointer(in.RenewBefore))
+	out.RenewBeforePercentage = (*int32)(unsafe.Pointer(in.RenewBeforePercentage))
+	out.RenewalJitter = (*v1.Duration)(unsafe.Pointer(in.RenewalJitter))
+	out.RenewalWindows = *(*[]v1alpha3.CertificateRenewalWindow)(unsafe.Pointer(&in.RenewalWindows))
+	out.RetryBackoff = (*v1alpha3.CertificateRetryBackoff)(unsafe.Pointer(in.RetryBackoff))
 	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
 	out.IPAddresses = *(*[]string)(unsafe.Pointer(&in.IPAddresses))
 	out.URISANs = *(*[]string)(unsafe.Pointer(&in.URISANs))
 	out.EmailSANs = *(*[]string)(unsafe.Pointer(&in.EmailSANs))
 	out.SecretName = in.SecretName
+	out.SecretTemplate = (*v1alpha3.CertificateSecretTemplate)(unsafe.Pointer(in.SecretTemplate))
 	if in.Keystores != nil {
 		in, out := &in.Keystores, &out.Keystores
 		*out = new(v1alpha3.CertificateKeystores)
@@ -800,6 +1076,8 @@ func autoConvert_certmanager_CertificateSpec_To_v1alpha3_CertificateSpec(in *cer
 	} else {
 		out.Keystores = nil
 	}
+	out.AdditionalOutputFormats = *(*[]v1alpha3.CertificateAdditionalOutputFormat)(unsafe.Pointer(&in.AdditionalOutputFormats))
+	out.AdditionalSecretTargets = *(*[]v1alpha3.CertificateSecretTarget)(unsafe.Pointer(&in.AdditionalSecretTargets))
 	if err := apismetav1.Convert_meta_ObjectReference_To_v1_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
 		return err
 	}
@@ -814,6 +1092,17 @@ func autoConvert_certmanager_CertificateSpec_To_v1alpha3_CertificateSpec(in *cer
 	} else {
 		out.PrivateKey = nil
 	}
+	if in.PrivateKeyProfiles != nil {
+		in, out := &in.PrivateKeyProfiles, &out.PrivateKeyProfiles
+		*out = make([]v1alpha3.CertificatePrivateKeyProfile, len(*in))
+		for i := range *in {
+			if err := Convert_certmanager_CertificatePrivateKeyProfile_To_v1alpha3_CertificatePrivateKeyProfile(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.PrivateKeyProfiles = nil
+	}
 	out.EncodeUsagesInRequest = (*bool)(unsafe.Pointer(in.EncodeUsagesInRequest))
 	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
 	return nil
@@ -822,9 +1111,11 @@ func autoConvert_certmanager_CertificateSpec_To_v1alpha3_CertificateSpec(in *cer
 func autoConvert_v1alpha3_CertificateStatus_To_certmanager_CertificateStatus(in *v1alpha3.CertificateStatus, out *certmanager.CertificateStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]certmanager.CertificateCondition)(unsafe.Pointer(&in.Conditions))
 	out.LastFailureTime = (*v1.Time)(unsafe.Pointer(in.LastFailureTime))
+	out.FailedIssuanceAttempts = (*int)(unsafe.Pointer(in.FailedIssuanceAttempts))
 	out.NotBefore = (*v1.Time)(unsafe.Pointer(in.NotBefore))
 	out.NotAfter = (*v1.Time)(unsafe.Pointer(in.NotAfter))
 	out.RenewalTime = (*v1.Time)(unsafe.Pointer(in.RenewalTime))
+	out.IssuerCAFingerprint = in.IssuerCAFingerprint
 	out.Revision = (*int)(unsafe.Pointer(in.Revision))
 	out.NextPrivateKeySecretName = (*string)(unsafe.Pointer(in.NextPrivateKeySecretName))
 	return nil
@@ -838,9 +1129,11 @@ func Convert_v1alpha3_CertificateStatus_To_certmanager_CertificateStatus(in *v1a
 func autoConvert_certmanager_CertificateStatus_To_v1alpha3_CertificateStatus(in *certmanager.CertificateStatus, out *v1alpha3.CertificateStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]v1alpha3.CertificateCondition)(unsafe.Pointer(&in.Conditions))
 	out.LastFailureTime = (*v1.Time)(unsafe.Pointer(in.LastFailureTime))
+	out.FailedIssuanceAttempts = (*int)(unsafe.Pointer(in.FailedIssuanceAttempts))
 	out.NotBefore = (*v1.Time)(unsafe.Pointer(in.NotBefore))
 	out.NotAfter = (*v1.Time)(unsafe.Pointer(in.NotAfter))
 	out.RenewalTime = (*v1.Time)(unsafe.Pointer(in.RenewalTime))
+	out.IssuerCAFingerprint = in.IssuerCAFingerprint
 	out.Revision = (*int)(unsafe.Pointer(in.Revision))
 	out.NextPrivateKeySecretName = (*string)(unsafe.Pointer(in.NextPrivateKeySecretName))
 	return nil
@@ -957,6 +1250,26 @@ func Convert_certmanager_Issuer_To_v1alpha3_Issuer(in *certmanager.Issuer, out *
 	return autoConvert_certmanager_Issuer_To_v1alpha3_Issuer(in, out, s)
 }
 
+func autoConvert_v1alpha3_IssuerApproval_To_certmanager_IssuerApproval(in *v1alpha3.IssuerApproval, out *certmanager.IssuerApproval, s conversion.Scope) error {
+	out.RequiredApprovals = in.RequiredApprovals
+	return nil
+}
+
+// Convert_v1alpha3_IssuerApproval_To_certmanager_IssuerApproval is an autogenerated conversion function.
+func Convert_v1alpha3_IssuerApproval_To_certmanager_IssuerApproval(in *v1alpha3.IssuerApproval, out *certmanager.IssuerApproval, s conversion.Scope) error {
+	return autoConvert_v1alpha3_IssuerApproval_To_certmanager_IssuerApproval(in, out, s)
+}
+
+func autoConvert_certmanager_IssuerApproval_To_v1alpha3_IssuerApproval(in *certmanager.IssuerApproval, out *v1alpha3.IssuerApproval, s conversion.Scope) error {
+	out.RequiredApprovals = in.RequiredApprovals
+	return nil
+}
+
+// Convert_certmanager_IssuerApproval_To_v1alpha3_IssuerApproval is an autogenerated conversion function.
+func Convert_certmanager_IssuerApproval_To_v1alpha3_IssuerApproval(in *certmanager.IssuerApproval, out *v1alpha3.IssuerApproval, s conversion.Scope) error {
+	return autoConvert_certmanager_IssuerApproval_To_v1alpha3_IssuerApproval(in, out, s)
+}
+
 func autoConvert_v1alpha3_IssuerCondition_To_certmanager_IssuerCondition(in *v1alpha3.IssuerCondition, out *certmanager.IssuerCondition, s conversion.Scope) error {
 	out.Type = certmanager.IssuerConditionType(in.Type)
 	out.Status = meta.ConditionStatus(in.Status)
@@ -1063,6 +1376,54 @@ func Convert_certmanager_IssuerConfig_To_v1alpha3_IssuerConfig(in *certmanager.I
 	return autoConvert_certmanager_IssuerConfig_To_v1alpha3_IssuerConfig(in, out, s)
 }
 
+func autoConvert_v1alpha3_IssuerConstraints_To_certmanager_IssuerConstraints(in *v1alpha3.IssuerConstraints, out *certmanager.IssuerConstraints, s conversion.Scope) error {
+	out.MinDuration = (*v1.Duration)(unsafe.Pointer(in.MinDuration))
+	out.MaxDuration = (*v1.Duration)(unsafe.Pointer(in.MaxDuration))
+	if in.AllowedPrivateKeys != nil {
+		in, out := &in.AllowedPrivateKeys, &out.AllowedPrivateKeys
+		*out = make([]certmanager.IssuerPrivateKeyConstraint, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha3_IssuerPrivateKeyConstraint_To_certmanager_IssuerPrivateKeyConstraint(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AllowedPrivateKeys = nil
+	}
+	out.AllowedDomains = *(*[]string)(unsafe.Pointer(&in.AllowedDomains))
+	out.AllowCA = in.AllowCA
+	return nil
+}
+
+// Convert_v1alpha3_IssuerConstraints_To_certmanager_IssuerConstraints is an autogenerated conversion function.
+func Convert_v1alpha3_IssuerConstraints_To_certmanager_IssuerConstraints(in *v1alpha3.IssuerConstraints, out *certmanager.IssuerConstraints, s conversion.Scope) error {
+	return autoConvert_v1alpha3_IssuerConstraints_To_certmanager_IssuerConstraints(in, out, s)
+}
+
+func autoConvert_certmanager_IssuerConstraints_To_v1alpha3_IssuerConstraints(in *certmanager.IssuerConstraints, out *v1alpha3.IssuerConstraints, s conversion.Scope) error {
+	out.MinDuration = (*v1.Duration)(unsafe.Pointer(in.MinDuration))
+	out.MaxDuration = (*v1.Duration)(unsafe.Pointer(in.MaxDuration))
+	if in.AllowedPrivateKeys != nil {
+		in, out := &in.AllowedPrivateKeys, &out.AllowedPrivateKeys
+		*out = make([]v1alpha3.IssuerPrivateKeyConstraint, len(*in))
+		for i := range *in {
+			if err := Convert_certmanager_IssuerPrivateKeyConstraint_To_v1alpha3_IssuerPrivateKeyConstraint(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AllowedPrivateKeys = nil
+	}
+	out.AllowedDomains = *(*[]string)(unsafe.Pointer(&in.AllowedDomains))
+	out.AllowCA = in.AllowCA
+	return nil
+}
+
+// Convert_certmanager_IssuerConstraints_To_v1alpha3_IssuerConstraints is an autogenerated conversion function.
+func Convert_certmanager_IssuerConstraints_To_v1alpha3_IssuerConstraints(in *certmanager.IssuerConstraints, out *v1alpha3.IssuerConstraints, s conversion.Scope) error {
+	return autoConvert_certmanager_IssuerConstraints_To_v1alpha3_IssuerConstraints(in, out, s)
+}
+
 func autoConvert_v1alpha3_IssuerList_To_certmanager_IssuerList(in *v1alpha3.IssuerList, out *certmanager.IssuerList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -1105,10 +1466,33 @@ func Convert_certmanager_IssuerList_To_v1alpha3_IssuerList(in *certmanager.Issue
 	return autoConvert_certmanager_IssuerList_To_v1alpha3_IssuerList(in, out, s)
 }
 
+func autoConvert_v1alpha3_IssuerPrivateKeyConstraint_To_certmanager_IssuerPrivateKeyConstraint(in *v1alpha3.IssuerPrivateKeyConstraint, out *certmanager.IssuerPrivateKeyConstraint, s conversion.Scope) error {
+	out.Algorithm = certmanager.PrivateKeyAlgorithm(in.Algorithm)
+	out.MinSize = in.MinSize
+	return nil
+}
+
+func autoConvert_certmanager_IssuerPrivateKeyConstraint_To_v1alpha3_IssuerPrivateKeyConstraint(in *certmanager.IssuerPrivateKeyConstraint, out *v1alpha3.IssuerPrivateKeyConstraint, s conversion.Scope) error {
+	out.Algorithm = v1alpha3.KeyAlgorithm(in.Algorithm)
+	out.MinSize = in.MinSize
+	return nil
+}
+
 func autoConvert_v1alpha3_IssuerSpec_To_certmanager_IssuerSpec(in *v1alpha3.IssuerSpec, out *certmanager.IssuerSpec, s conversion.Scope) error {
 	if err := Convert_v1alpha3_IssuerConfig_To_certmanager_IssuerConfig(&in.IssuerConfig, &out.IssuerConfig, s); err != nil {
 		return err
 	}
+	out.Approval = (*certmanager.IssuerApproval)(unsafe.Pointer(in.Approval))
+	if in.Constraints != nil {
+		in, out := &in.Constraints, &out.Constraints
+		*out = new(certmanager.IssuerConstraints)
+		if err := Convert_v1alpha3_IssuerConstraints_To_certmanager_IssuerConstraints(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Constraints = nil
+	}
+	out.NamespaceSelector = (*v1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
 	return nil
 }
 
@@ -1121,6 +1505,17 @@ func autoConvert_certmanager_IssuerSpec_To_v1alpha3_IssuerSpec(in *certmanager.I
 	if err := Convert_certmanager_IssuerConfig_To_v1alpha3_IssuerConfig(&in.IssuerConfig, &out.IssuerConfig, s); err != nil {
 		return err
 	}
+	out.Approval = (*v1alpha3.IssuerApproval)(unsafe.Pointer(in.Approval))
+	if in.Constraints != nil {
+		in, out := &in.Constraints, &out.Constraints
+		*out = new(v1alpha3.IssuerConstraints)
+		if err := Convert_certmanager_IssuerConstraints_To_v1alpha3_IssuerConstraints(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Constraints = nil
+	}
+	out.NamespaceSelector = (*v1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
 	return nil
 }
 
@@ -63,6 +63,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.CertificateAdditionalOutputFormat)(nil), (*certmanager.CertificateAdditionalOutputFormat)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CertificateAdditionalOutputFormat_To_certmanager_CertificateAdditionalOutputFormat(a.(*v1beta1.CertificateAdditionalOutputFormat), b.(*certmanager.CertificateAdditionalOutputFormat), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateAdditionalOutputFormat)(nil), (*v1beta1.CertificateAdditionalOutputFormat)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateAdditionalOutputFormat_To_v1beta1_CertificateAdditionalOutputFormat(a.(*certmanager.CertificateAdditionalOutputFormat), b.(*v1beta1.CertificateAdditionalOutputFormat), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1beta1.CertificateCondition)(nil), (*certmanager.CertificateCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_CertificateCondition_To_certmanager_CertificateCondition(a.(*v1beta1.CertificateCondition), b.(*certmanager.CertificateCondition), scope)
 	}); err != nil {
@@ -103,6 +113,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.CertificatePrivateKeyProfile)(nil), (*certmanager.CertificatePrivateKeyProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CertificatePrivateKeyProfile_To_certmanager_CertificatePrivateKeyProfile(a.(*v1beta1.CertificatePrivateKeyProfile), b.(*certmanager.CertificatePrivateKeyProfile), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificatePrivateKeyProfile)(nil), (*v1beta1.CertificatePrivateKeyProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificatePrivateKeyProfile_To_v1beta1_CertificatePrivateKeyProfile(a.(*certmanager.CertificatePrivateKeyProfile), b.(*v1beta1.CertificatePrivateKeyProfile), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.CertificateRenewalWindow)(nil), (*certmanager.CertificateRenewalWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CertificateRenewalWindow_To_certmanager_CertificateRenewalWindow(a.(*v1beta1.CertificateRenewalWindow), b.(*certmanager.CertificateRenewalWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRenewalWindow)(nil), (*v1beta1.CertificateRenewalWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRenewalWindow_To_v1beta1_CertificateRenewalWindow(a.(*certmanager.CertificateRenewalWindow), b.(*v1beta1.CertificateRenewalWindow), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1beta1.CertificateRequest)(nil), (*certmanager.CertificateRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_CertificateRequest_To_certmanager_CertificateRequest(a.(*v1beta1.CertificateRequest), b.(*certmanager.CertificateRequest), scope)
 	}); err != nil {
@@ -113,6 +143,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.CertificateRequestApproval)(nil), (*certmanager.CertificateRequestApproval)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CertificateRequestApproval_To_certmanager_CertificateRequestApproval(a.(*v1beta1.CertificateRequestApproval), b.(*certmanager.CertificateRequestApproval), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRequestApproval)(nil), (*v1beta1.CertificateRequestApproval)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRequestApproval_To_v1beta1_CertificateRequestApproval(a.(*certmanager.CertificateRequestApproval), b.(*v1beta1.CertificateRequestApproval), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1beta1.CertificateRequestCondition)(nil), (*certmanager.CertificateRequestCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_CertificateRequestCondition_To_certmanager_CertificateRequestCondition(a.(*v1beta1.CertificateRequestCondition), b.(*certmanager.CertificateRequestCondition), scope)
 	}); err != nil {
@@ -153,6 +193,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.CertificateRetryBackoff)(nil), (*certmanager.CertificateRetryBackoff)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CertificateRetryBackoff_To_certmanager_CertificateRetryBackoff(a.(*v1beta1.CertificateRetryBackoff), b.(*certmanager.CertificateRetryBackoff), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRetryBackoff)(nil), (*v1beta1.CertificateRetryBackoff)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRetryBackoff_To_v1beta1_CertificateRetryBackoff(a.(*certmanager.CertificateRetryBackoff), b.(*v1beta1.CertificateRetryBackoff), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.CertificateSecretTarget)(nil), (*certmanager.CertificateSecretTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CertificateSecretTarget_To_certmanager_CertificateSecretTarget(a.(*v1beta1.CertificateSecretTarget), b.(*certmanager.CertificateSecretTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateSecretTarget)(nil), (*v1beta1.CertificateSecretTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateSecretTarget_To_v1beta1_CertificateSecretTarget(a.(*certmanager.CertificateSecretTarget), b.(*v1beta1.CertificateSecretTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.CertificateSecretTemplate)(nil), (*certmanager.CertificateSecretTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CertificateSecretTemplate_To_certmanager_CertificateSecretTemplate(a.(*v1beta1.CertificateSecretTemplate), b.(*certmanager.CertificateSecretTemplate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateSecretTemplate)(nil), (*v1beta1.CertificateSecretTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateSecretTemplate_To_v1beta1_CertificateSecretTemplate(a.(*certmanager.CertificateSecretTemplate), b.(*v1beta1.CertificateSecretTemplate), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1beta1.CertificateSpec)(nil), (*certmanager.CertificateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_CertificateSpec_To_certmanager_CertificateSpec(a.(*v1beta1.CertificateSpec), b.(*certmanager.CertificateSpec), scope)
 	}); err != nil {
@@ -203,6 +273,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.IssuerApproval)(nil), (*certmanager.IssuerApproval)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_IssuerApproval_To_certmanager_IssuerApproval(a.(*v1beta1.IssuerApproval), b.(*certmanager.IssuerApproval), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.IssuerApproval)(nil), (*v1beta1.IssuerApproval)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_IssuerApproval_To_v1beta1_IssuerApproval(a.(*certmanager.IssuerApproval), b.(*v1beta1.IssuerApproval), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1beta1.IssuerCondition)(nil), (*certmanager.IssuerCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_IssuerCondition_To_certmanager_IssuerCondition(a.(*v1beta1.IssuerCondition), b.(*certmanager.IssuerCondition), scope)
 	}); err != nil {
@@ -223,6 +303,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.IssuerConstraints)(nil), (*certmanager.IssuerConstraints)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_IssuerConstraints_To_certmanager_IssuerConstraints(a.(*v1beta1.IssuerConstraints), b.(*certmanager.IssuerConstraints), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.IssuerConstraints)(nil), (*v1beta1.IssuerConstraints)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_IssuerConstraints_To_v1beta1_IssuerConstraints(a.(*certmanager.IssuerConstraints), b.(*v1beta1.IssuerConstraints), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1beta1.IssuerList)(nil), (*certmanager.IssuerList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_IssuerList_To_certmanager_IssuerList(a.(*v1beta1.IssuerList), b.(*certmanager.IssuerList), scope)
 	}); err != nil {
@@ -233,6 +323,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.IssuerPrivateKeyConstraint)(nil), (*certmanager.IssuerPrivateKeyConstraint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_IssuerPrivateKeyConstraint_To_certmanager_IssuerPrivateKeyConstraint(a.(*v1beta1.IssuerPrivateKeyConstraint), b.(*certmanager.IssuerPrivateKeyConstraint), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.IssuerPrivateKeyConstraint)(nil), (*v1beta1.IssuerPrivateKeyConstraint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_IssuerPrivateKeyConstraint_To_v1beta1_IssuerPrivateKeyConstraint(a.(*certmanager.IssuerPrivateKeyConstraint), b.(*v1beta1.IssuerPrivateKeyConstraint), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1beta1.IssuerSpec)(nil), (*certmanager.IssuerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_IssuerSpec_To_certmanager_IssuerSpec(a.(*v1beta1.IssuerSpec), b.(*certmanager.IssuerSpec), scope)
 	}); err != nil {
@@ -422,6 +522,26 @@ func Convert_certmanager_Certificate_To_v1beta1_Certificate(in *certmanager.Cert
 	return autoConvert_certmanager_Certificate_To_v1beta1_Certificate(in, out, s)
 }
 
+func autoConvert_v1beta1_CertificateAdditionalOutputFormat_To_certmanager_CertificateAdditionalOutputFormat(in *v1beta1.CertificateAdditionalOutputFormat, out *certmanager.CertificateAdditionalOutputFormat, s conversion.Scope) error {
+	out.Type = certmanager.CertificateOutputFormatType(in.Type)
+	return nil
+}
+
+// Convert_v1beta1_CertificateAdditionalOutputFormat_To_certmanager_CertificateAdditionalOutputFormat is an autogenerated conversion function.
+func Convert_v1beta1_CertificateAdditionalOutputFormat_To_certmanager_CertificateAdditionalOutputFormat(in *v1beta1.CertificateAdditionalOutputFormat, out *certmanager.CertificateAdditionalOutputFormat, s conversion.Scope) error {
+	return autoConvert_v1beta1_CertificateAdditionalOutputFormat_To_certmanager_CertificateAdditionalOutputFormat(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateAdditionalOutputFormat_To_v1beta1_CertificateAdditionalOutputFormat(in *certmanager.CertificateAdditionalOutputFormat, out *v1beta1.CertificateAdditionalOutputFormat, s conversion.Scope) error {
+	out.Type = v1beta1.CertificateOutputFormatType(in.Type)
+	return nil
+}
+
+// Convert_certmanager_CertificateAdditionalOutputFormat_To_v1beta1_CertificateAdditionalOutputFormat is an autogenerated conversion function.
+func Convert_certmanager_CertificateAdditionalOutputFormat_To_v1beta1_CertificateAdditionalOutputFormat(in *certmanager.CertificateAdditionalOutputFormat, out *v1beta1.CertificateAdditionalOutputFormat, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateAdditionalOutputFormat_To_v1beta1_CertificateAdditionalOutputFormat(in, out, s)
+}
+
 func autoConvert_v1beta1_CertificateCondition_To_certmanager_CertificateCondition(in *v1beta1.CertificateCondition, out *certmanager.CertificateCondition, s conversion.Scope) error {
 	out.Type = certmanager.CertificateConditionType(in.Type)
 	out.Status = meta.ConditionStatus(in.Status)
@@ -574,6 +694,56 @@ func Convert_certmanager_CertificatePrivateKey_To_v1beta1_CertificatePrivateKey(
 	return autoConvert_certmanager_CertificatePrivateKey_To_v1beta1_CertificatePrivateKey(in, out, s)
 }
 
+func autoConvert_v1beta1_CertificatePrivateKeyProfile_To_certmanager_CertificatePrivateKeyProfile(in *v1beta1.CertificatePrivateKeyProfile, out *certmanager.CertificatePrivateKeyProfile, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_v1beta1_CertificatePrivateKey_To_certmanager_CertificatePrivateKey(&in.PrivateKey, &out.PrivateKey, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_CertificatePrivateKeyProfile_To_certmanager_CertificatePrivateKeyProfile is an autogenerated conversion function.
+func Convert_v1beta1_CertificatePrivateKeyProfile_To_certmanager_CertificatePrivateKeyProfile(in *v1beta1.CertificatePrivateKeyProfile, out *certmanager.CertificatePrivateKeyProfile, s conversion.Scope) error {
+	return autoConvert_v1beta1_CertificatePrivateKeyProfile_To_certmanager_CertificatePrivateKeyProfile(in, out, s)
+}
+
+func autoConvert_certmanager_CertificatePrivateKeyProfile_To_v1beta1_CertificatePrivateKeyProfile(in *certmanager.CertificatePrivateKeyProfile, out *v1beta1.CertificatePrivateKeyProfile, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_certmanager_CertificatePrivateKey_To_v1beta1_CertificatePrivateKey(&in.PrivateKey, &out.PrivateKey, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_CertificatePrivateKeyProfile_To_v1beta1_CertificatePrivateKeyProfile is an autogenerated conversion function.
+func Convert_certmanager_CertificatePrivateKeyProfile_To_v1beta1_CertificatePrivateKeyProfile(in *certmanager.CertificatePrivateKeyProfile, out *v1beta1.CertificatePrivateKeyProfile, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificatePrivateKeyProfile_To_v1beta1_CertificatePrivateKeyProfile(in, out, s)
+}
+
+func autoConvert_v1beta1_CertificateRenewalWindow_To_certmanager_CertificateRenewalWindow(in *v1beta1.CertificateRenewalWindow, out *certmanager.CertificateRenewalWindow, s conversion.Scope) error {
+	out.Schedule = in.Schedule
+	out.Duration = in.Duration
+	out.TimeZone = in.TimeZone
+	return nil
+}
+
+// Convert_v1beta1_CertificateRenewalWindow_To_certmanager_CertificateRenewalWindow is an autogenerated conversion function.
+func Convert_v1beta1_CertificateRenewalWindow_To_certmanager_CertificateRenewalWindow(in *v1beta1.CertificateRenewalWindow, out *certmanager.CertificateRenewalWindow, s conversion.Scope) error {
+	return autoConvert_v1beta1_CertificateRenewalWindow_To_certmanager_CertificateRenewalWindow(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRenewalWindow_To_v1beta1_CertificateRenewalWindow(in *certmanager.CertificateRenewalWindow, out *v1beta1.CertificateRenewalWindow, s conversion.Scope) error {
+	out.Schedule = in.Schedule
+	out.Duration = in.Duration
+	out.TimeZone = in.TimeZone
+	return nil
+}
+
+// Convert_certmanager_CertificateRenewalWindow_To_v1beta1_CertificateRenewalWindow is an autogenerated conversion function.
+func Convert_certmanager_CertificateRenewalWindow_To_v1beta1_CertificateRenewalWindow(in *certmanager.CertificateRenewalWindow, out *v1beta1.CertificateRenewalWindow, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRenewalWindow_To_v1beta1_CertificateRenewalWindow(in, out, s)
+}
+
 func autoConvert_v1beta1_CertificateRequest_To_certmanager_CertificateRequest(in *v1beta1.CertificateRequest, out *certmanager.CertificateRequest, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_CertificateRequestSpec_To_certmanager_CertificateRequestSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -606,6 +776,32 @@ func Convert_certmanager_CertificateRequest_To_v1beta1_CertificateRequest(in *ce
 	return autoConvert_certmanager_CertificateRequest_To_v1beta1_CertificateRequest(in, out, s)
 }
 
+func autoConvert_v1beta1_CertificateRequestApproval_To_certmanager_CertificateRequestApproval(in *v1beta1.CertificateRequestApproval, out *certmanager.CertificateRequestApproval, s conversion.Scope) error {
+	out.Username = in.Username
+	out.ApprovalTime = (*v1.Time)(unsafe.Pointer(in.ApprovalTime))
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1beta1_CertificateRequestApproval_To_certmanager_CertificateRequestApproval is an autogenerated conversion function.
+func Convert_v1beta1_CertificateRequestApproval_To_certmanager_CertificateRequestApproval(in *v1beta1.CertificateRequestApproval, out *certmanager.CertificateRequestApproval, s conversion.Scope) error {
+	return autoConvert_v1beta1_CertificateRequestApproval_To_certmanager_CertificateRequestApproval(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRequestApproval_To_v1beta1_CertificateRequestApproval(in *certmanager.CertificateRequestApproval, out *v1beta1.CertificateRequestApproval, s conversion.Scope) error {
+	out.Username = in.Username
+	out.ApprovalTime = (*v1.Time)(unsafe.Pointer(in.ApprovalTime))
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_certmanager_CertificateRequestApproval_To_v1beta1_CertificateRequestApproval is an autogenerated conversion function.
+func Convert_certmanager_CertificateRequestApproval_To_v1beta1_CertificateRequestApproval(in *certmanager.CertificateRequestApproval, out *v1beta1.CertificateRequestApproval, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRequestApproval_To_v1beta1_CertificateRequestApproval(in, out, s)
+}
+
 func autoConvert_v1beta1_CertificateRequestCondition_To_certmanager_CertificateRequestCondition(in *v1beta1.CertificateRequestCondition, out *certmanager.CertificateRequestCondition, s conversion.Scope) error {
 	out.Type = certmanager.CertificateRequestConditionType(in.Type)
 	out.Status = meta.ConditionStatus(in.Status)
@@ -721,6 +917,7 @@ func autoConvert_v1beta1_CertificateRequestStatus_To_certmanager_CertificateRequ
 	out.Certificate = *(*[]byte)(unsafe.Pointer(&in.Certificate))
 	out.CA = *(*[]byte)(unsafe.Pointer(&in.CA))
 	out.FailureTime = (*v1.Time)(unsafe.Pointer(in.FailureTime))
+	out.Approvals = *(*[]certmanager.CertificateRequestApproval)(unsafe.Pointer(&in.Approvals))
 	return nil
 }
 
@@ -734,6 +931,7 @@ func autoConvert_certmanager_CertificateRequestStatus_To_v1beta1_CertificateRequ
 	out.Certificate = *(*[]byte)(unsafe.Pointer(&in.Certificate))
 	out.CA = *(*[]byte)(unsafe.Pointer(&in.CA))
 	out.FailureTime = (*v1.Time)(unsafe.Pointer(in.FailureTime))
+	out.Approvals = *(*[]v1beta1.CertificateRequestApproval)(unsafe.Pointer(&in.Approvals))
 	return nil
 }
 
@@ -742,16 +940,87 @@ func Convert_certmanager_CertificateRequestStatus_To_v1beta1_CertificateRequestS
 	return autoConvert_certmanager_CertificateRequestStatus_To_v1beta1_CertificateRequestStatus(in, out, s)
 }
 
+func autoConvert_v1beta1_CertificateRetryBackoff_To_certmanager_CertificateRetryBackoff(in *v1beta1.CertificateRetryBackoff, out *certmanager.CertificateRetryBackoff, s conversion.Scope) error {
+	out.Min = (*v1.Duration)(unsafe.Pointer(in.Min))
+	out.Max = (*v1.Duration)(unsafe.Pointer(in.Max))
+	return nil
+}
+
+// Convert_v1beta1_CertificateRetryBackoff_To_certmanager_CertificateRetryBackoff is an autogenerated conversion function.
+func Convert_v1beta1_CertificateRetryBackoff_To_certmanager_CertificateRetryBackoff(in *v1beta1.CertificateRetryBackoff, out *certmanager.CertificateRetryBackoff, s conversion.Scope) error {
+	return autoConvert_v1beta1_CertificateRetryBackoff_To_certmanager_CertificateRetryBackoff(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRetryBackoff_To_v1beta1_CertificateRetryBackoff(in *certmanager.CertificateRetryBackoff, out *v1beta1.CertificateRetryBackoff, s conversion.Scope) error {
+	out.Min = (*v1.Duration)(unsafe.Pointer(in.Min))
+	out.Max = (*v1.Duration)(unsafe.Pointer(in.Max))
+	return nil
+}
+
+// Convert_certmanager_CertificateRetryBackoff_To_v1beta1_CertificateRetryBackoff is an autogenerated conversion function.
+func Convert_certmanager_CertificateRetryBackoff_To_v1beta1_CertificateRetryBackoff(in *certmanager.CertificateRetryBackoff, out *v1beta1.CertificateRetryBackoff, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRetryBackoff_To_v1beta1_CertificateRetryBackoff(in, out, s)
+}
+
+func autoConvert_v1beta1_CertificateSecretTarget_To_certmanager_CertificateSecretTarget(in *v1beta1.CertificateSecretTarget, out *certmanager.CertificateSecretTarget, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1beta1_CertificateSecretTarget_To_certmanager_CertificateSecretTarget is an autogenerated conversion function.
+func Convert_v1beta1_CertificateSecretTarget_To_certmanager_CertificateSecretTarget(in *v1beta1.CertificateSecretTarget, out *certmanager.CertificateSecretTarget, s conversion.Scope) error {
+	return autoConvert_v1beta1_CertificateSecretTarget_To_certmanager_CertificateSecretTarget(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateSecretTarget_To_v1beta1_CertificateSecretTarget(in *certmanager.CertificateSecretTarget, out *v1beta1.CertificateSecretTarget, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_certmanager_CertificateSecretTarget_To_v1beta1_CertificateSecretTarget is an autogenerated conversion function.
+func Convert_certmanager_CertificateSecretTarget_To_v1beta1_CertificateSecretTarget(in *certmanager.CertificateSecretTarget, out *v1beta1.CertificateSecretTarget, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateSecretTarget_To_v1beta1_CertificateSecretTarget(in, out, s)
+}
+
+func autoConvert_v1beta1_CertificateSecretTemplate_To_certmanager_CertificateSecretTemplate(in *v1beta1.CertificateSecretTemplate, out *certmanager.CertificateSecretTemplate, s conversion.Scope) error {
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	return nil
+}
+
+// Convert_v1beta1_CertificateSecretTemplate_To_certmanager_CertificateSecretTemplate is an autogenerated conversion function.
+func Convert_v1beta1_CertificateSecretTemplate_To_certmanager_CertificateSecretTemplate(in *v1beta1.CertificateSecretTemplate, out *certmanager.CertificateSecretTemplate, s conversion.Scope) error {
+	return autoConvert_v1beta1_CertificateSecretTemplate_To_certmanager_CertificateSecretTemplate(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateSecretTemplate_To_v1beta1_CertificateSecretTemplate(in *certmanager.CertificateSecretTemplate, out *v1beta1.CertificateSecretTemplate, s conversion.Scope) error {
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	return nil
+}
+
+// Convert_certmanager_CertificateSecretTemplate_To_v1beta1_CertificateSecretTemplate is an autogenerated conversion function.
+func Convert_certmanager_CertificateSecretTemplate_To_v1beta1_CertificateSecretTemplate(in *certmanager.CertificateSecretTemplate, out *v1beta1.CertificateSecretTemplate, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateSecretTemplate_To_v1beta1_CertificateSecretTemplate(in, out, s)
+}
+
 func autoConvert_v1beta1_CertificateSpec_To_certmanager_CertificateSpec(in *v1beta1.CertificateSpec, out *certmanager.CertificateSpec, s conversion.Scope) error {
 	out.Subject = (*certmanager.X509Subject)(unsafe.Pointer(in.Subject))
 	out.CommonName = in.CommonName
 	out.Duration = (*v1.Duration)(unsafe.Pointer(in.Duration))
 	out.RenewBefore = (*v1.Duration)(unsafe.Pointer(in.RenewBefore))
+	out.RenewBeforePercentage = (*int32)(unsafe.Pointer(in.RenewBeforePercentage))
+	out.RenewalJitter = (*v1.Duration)(unsafe.Pointer(in.RenewalJitter))
+	out.RenewalWindows = *(*[]certmanager.CertificateRenewalWindow)(unsafe.Pointer(&in.RenewalWindows))
+	out.RetryBackoff = (*certmanager.CertificateRetryBackoff)(unsafe.Pointer(in.RetryBackoff))
 	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
 	out.IPAddresses = *(*[]string)(unsafe.Pointer(&in.IPAddresses))
 	out.URISANs = *(*[]string)(unsafe.Pointer(&in.URISANs))
 	out.EmailSANs = *(*[]string)(unsafe.Pointer(&in.EmailSANs))
 	out.SecretName = in.SecretName
+	out.SecretTemplate = (*certmanager.CertificateSecretTemplate)(unsafe.Pointer(in.SecretTemplate))
 	if in.Keystores != nil {
 		in, out := &in.Keystores, &out.Keystores
 		*out = new(certmanager.CertificateKeystores)
@@ -761,12 +1030,15 @@ func autoConvert_v1beta1_CertificateSpec_To_certmanager_CertificateSpec(in *v1be
 	} else {
 		out.Keystores = nil
 	}
+	out.AdditionalOutputFormats = *(*[]certmanager.CertificateAdditionalOutputFormat)(unsafe.Pointer(&in.AdditionalOutputFormats))
+	out.AdditionalSecretTargets = *(*[]certmanager.CertificateSecretTarget)(unsafe.Pointer(&in.AdditionalSecretTargets))
 	if err := apismetav1.Convert_v1_ObjectReference_To_meta_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
 		return err
 	}
 	out.IsCA = in.IsCA
 	out.Usages = *(*[]certmanager.KeyUsage)(unsafe.Pointer(&in.Usages))
 	out.PrivateKey = (*certmanager.CertificatePrivateKey)(unsafe.Pointer(in.PrivateKey))
+	out.PrivateKeyProfiles = *(*[]certmanager.CertificatePrivateKeyProfile)(unsafe.Pointer(&in.PrivateKeyProfiles))
 	out.EncodeUsagesInRequest = (*bool)(unsafe.Pointer(in.EncodeUsagesInRequest))
 	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
 	return nil
@@ -782,11 +1054,16 @@ func autoConvert_certmanager_CertificateSpec_To_v1beta1_CertificateSpec(in *cert
 	out.CommonName = in.CommonName
 	out.Duration = (*v1.Duration)(unsafe.Pointer(in.Duration))
 	out.RenewBefore = (*v1.Duration)(unsafe.Pointer(in.RenewBefore))
+	out.RenewBeforePercentage = (*int32)(unsafe.Pointer(in.RenewBeforePercentage))
+	out.RenewalJitter = (*v1.Duration)(unsafe.Pointer(in.RenewalJitter))
+	out.RenewalWindows = *(*[]v1beta1.CertificateRenewalWindow)(unsafe.Pointer(&in.RenewalWindows))
+	out.RetryBackoff = (*v1beta1.CertificateRetryBackoff)(unsafe.Pointer(in.RetryBackoff))
 	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
 	out.IPAddresses = *(*[]string)(unsafe.Pointer(&in.IPAddresses))
 	out.URISANs = *(*[]string)(unsafe.Pointer(&in.URISANs))
 	out.EmailSANs = *(*[]string)(unsafe.Pointer(&in.EmailSANs))
 	out.SecretName = in.SecretName
+	out.SecretTemplate = (*v1beta1.CertificateSecretTemplate)(unsafe.Pointer(in.SecretTemplate))
 	if in.Keystores != nil {
 		in, out := &in.Keystores, &out.Keystores
 		*out = new(v1beta1.CertificateKeystores)
@@ -796,12 +1073,15 @@ func autoConvert_certmanager_CertificateSpec_To_v1beta1_CertificateSpec(in *cert
 	} else {
 		out.Keystores = nil
 	}
+	out.AdditionalOutputFormats = *(*[]v1beta1.CertificateAdditionalOutputFormat)(unsafe.Pointer(&in.AdditionalOutputFormats))
+	out.AdditionalSecretTargets = *(*[]v1beta1.CertificateSecretTarget)(unsafe.Pointer(&in.AdditionalSecretTargets))
 	if err := apismetav1.Convert_meta_ObjectReference_To_v1_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
 		return err
 	}
 	out.IsCA = in.IsCA
 	out.Usages = *(*[]v1beta1.KeyUsage)(unsafe.Pointer(&in.Usages))
 	out.PrivateKey = (*v1beta1.CertificatePrivateKey)(unsafe.Pointer(in.PrivateKey))
+	out.PrivateKeyProfiles = *(*[]v1beta1.CertificatePrivateKeyProfile)(unsafe.Pointer(&in.PrivateKeyProfiles))
 	out.EncodeUsagesInRequest = (*bool)(unsafe.Pointer(in.EncodeUsagesInRequest))
 	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
 	return nil
@@ -815,9 +1095,11 @@ func Convert_certmanager_CertificateSpec_To_v1beta1_CertificateSpec(in *certmana
 func autoConvert_v1beta1_CertificateStatus_To_certmanager_CertificateStatus(in *v1beta1.CertificateStatus, out *certmanager.CertificateStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]certmanager.CertificateCondition)(unsafe.Pointer(&in.Conditions))
 	out.LastFailureTime = (*v1.Time)(unsafe.Pointer(in.LastFailureTime))
+	out.FailedIssuanceAttempts = (*int)(unsafe.Pointer(in.FailedIssuanceAttempts))
 	out.NotBefore = (*v1.Time)(unsafe.Pointer(in.NotBefore))
 	out.NotAfter = (*v1.Time)(unsafe.Pointer(in.NotAfter))
 	out.RenewalTime = (*v1.Time)(unsafe.Pointer(in.RenewalTime))
+	out.IssuerCAFingerprint = in.IssuerCAFingerprint
 	out.Revision = (*int)(unsafe.Pointer(in.Revision))
 	out.NextPrivateKeySecretName = (*string)(unsafe.Pointer(in.NextPrivateKeySecretName))
 	return nil
@@ -831,9 +1113,11 @@ func Convert_v1beta1_CertificateStatus_To_certmanager_CertificateStatus(in *v1be
 func autoConvert_certmanager_CertificateStatus_To_v1beta1_CertificateStatus(in *certmanager.CertificateStatus, out *v1beta1.CertificateStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]v1beta1.CertificateCondition)(unsafe.Pointer(&in.Conditions))
 	out.LastFailureTime = (*v1.Time)(unsafe.Pointer(in.LastFailureTime))
+	out.FailedIssuanceAttempts = (*int)(unsafe.Pointer(in.FailedIssuanceAttempts))
 	out.NotBefore = (*v1.Time)(unsafe.Pointer(in.NotBefore))
 	out.NotAfter = (*v1.Time)(unsafe.Pointer(in.NotAfter))
 	out.RenewalTime = (*v1.Time)(unsafe.Pointer(in.RenewalTime))
+	out.IssuerCAFingerprint = in.IssuerCAFingerprint
 	out.Revision = (*int)(unsafe.Pointer(in.Revision))
 	out.NextPrivateKeySecretName = (*string)(unsafe.Pointer(in.NextPrivateKeySecretName))
 	return nil
@@ -950,6 +1234,26 @@ func Convert_certmanager_Issuer_To_v1beta1_Issuer(in *certmanager.Issuer, out *v
 	return autoConvert_certmanager_Issuer_To_v1beta1_Issuer(in, out, s)
 }
 
+func autoConvert_v1beta1_IssuerApproval_To_certmanager_IssuerApproval(in *v1beta1.IssuerApproval, out *certmanager.IssuerApproval, s conversion.Scope) error {
+	out.RequiredApprovals = in.RequiredApprovals
+	return nil
+}
+
+// Convert_v1beta1_IssuerApproval_To_certmanager_IssuerApproval is an autogenerated conversion function.
+func Convert_v1beta1_IssuerApproval_To_certmanager_IssuerApproval(in *v1beta1.IssuerApproval, out *certmanager.IssuerApproval, s conversion.Scope) error {
+	return autoConvert_v1beta1_IssuerApproval_To_certmanager_IssuerApproval(in, out, s)
+}
+
+func autoConvert_certmanager_IssuerApproval_To_v1beta1_IssuerApproval(in *certmanager.IssuerApproval, out *v1beta1.IssuerApproval, s conversion.Scope) error {
+	out.RequiredApprovals = in.RequiredApprovals
+	return nil
+}
+
+// Convert_certmanager_IssuerApproval_To_v1beta1_IssuerApproval is an autogenerated conversion function.
+func Convert_certmanager_IssuerApproval_To_v1beta1_IssuerApproval(in *certmanager.IssuerApproval, out *v1beta1.IssuerApproval, s conversion.Scope) error {
+	return autoConvert_certmanager_IssuerApproval_To_v1beta1_IssuerApproval(in, out, s)
+}
+
 func autoConvert_v1beta1_IssuerCondition_To_certmanager_IssuerCondition(in *v1beta1.IssuerCondition, out *certmanager.IssuerCondition, s conversion.Scope) error {
 	out.Type = certmanager.IssuerConditionType(in.Type)
 	out.Status = meta.ConditionStatus(in.Status)
@@ -1056,6 +1360,34 @@ func Convert_certmanager_IssuerConfig_To_v1beta1_IssuerConfig(in *certmanager.Is
 	return autoConvert_certmanager_IssuerConfig_To_v1beta1_IssuerConfig(in, out, s)
 }
 
+func autoConvert_v1beta1_IssuerConstraints_To_certmanager_IssuerConstraints(in *v1beta1.IssuerConstraints, out *certmanager.IssuerConstraints, s conversion.Scope) error {
+	out.MinDuration = (*v1.Duration)(unsafe.Pointer(in.MinDuration))
+	out.MaxDuration = (*v1.Duration)(unsafe.Pointer(in.MaxDuration))
+	out.AllowedPrivateKeys = *(*[]certmanager.IssuerPrivateKeyConstraint)(unsafe.Pointer(&in.AllowedPrivateKeys))
+	out.AllowedDomains = *(*[]string)(unsafe.Pointer(&in.AllowedDomains))
+	out.AllowCA = in.AllowCA
+	return nil
+}
+
+// Convert_v1beta1_IssuerConstraints_To_certmanager_IssuerConstraints is an autogenerated conversion function.
+func Convert_v1beta1_IssuerConstraints_To_certmanager_IssuerConstraints(in *v1beta1.IssuerConstraints, out *certmanager.IssuerConstraints, s conversion.Scope) error {
+	return autoConvert_v1beta1_IssuerConstraints_To_certmanager_IssuerConstraints(in, out, s)
+}
+
+func autoConvert_certmanager_IssuerConstraints_To_v1beta1_IssuerConstraints(in *certmanager.IssuerConstraints, out *v1beta1.IssuerConstraints, s conversion.Scope) error {
+	out.MinDuration = (*v1.Duration)(unsafe.Pointer(in.MinDuration))
+	out.MaxDuration = (*v1.Duration)(unsafe.Pointer(in.MaxDuration))
+	out.AllowedPrivateKeys = *(*[]v1beta1.IssuerPrivateKeyConstraint)(unsafe.Pointer(&in.AllowedPrivateKeys))
+	out.AllowedDomains = *(*[]string)(unsafe.Pointer(&in.AllowedDomains))
+	out.AllowCA = in.AllowCA
+	return nil
+}
+
+// Convert_certmanager_IssuerConstraints_To_v1beta1_IssuerConstraints is an autogenerated conversion function.
+func Convert_certmanager_IssuerConstraints_To_v1beta1_IssuerConstraints(in *certmanager.IssuerConstraints, out *v1beta1.IssuerConstraints, s conversion.Scope) error {
+	return autoConvert_certmanager_IssuerConstraints_To_v1beta1_IssuerConstraints(in, out, s)
+}
+
 func autoConvert_v1beta1_IssuerList_To_certmanager_IssuerList(in *v1beta1.IssuerList, out *certmanager.IssuerList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -1098,10 +1430,35 @@ func Convert_certmanager_IssuerList_To_v1beta1_IssuerList(in *certmanager.Issuer
 	return autoConvert_certmanager_IssuerList_To_v1beta1_IssuerList(in, out, s)
 }
 
+func autoConvert_v1beta1_IssuerPrivateKeyConstraint_To_certmanager_IssuerPrivateKeyConstraint(in *v1beta1.IssuerPrivateKeyConstraint, out *certmanager.IssuerPrivateKeyConstraint, s conversion.Scope) error {
+	out.Algorithm = certmanager.PrivateKeyAlgorithm(in.Algorithm)
+	out.MinSize = in.MinSize
+	return nil
+}
+
+// Convert_v1beta1_IssuerPrivateKeyConstraint_To_certmanager_IssuerPrivateKeyConstraint is an autogenerated conversion function.
+func Convert_v1beta1_IssuerPrivateKeyConstraint_To_certmanager_IssuerPrivateKeyConstraint(in *v1beta1.IssuerPrivateKeyConstraint, out *certmanager.IssuerPrivateKeyConstraint, s conversion.Scope) error {
+	return autoConvert_v1beta1_IssuerPrivateKeyConstraint_To_certmanager_IssuerPrivateKeyConstraint(in, out, s)
+}
+
+func autoConvert_certmanager_IssuerPrivateKeyConstraint_To_v1beta1_IssuerPrivateKeyConstraint(in *certmanager.IssuerPrivateKeyConstraint, out *v1beta1.IssuerPrivateKeyConstraint, s conversion.Scope) error {
+	out.Algorithm = v1beta1.PrivateKeyAlgorithm(in.Algorithm)
+	out.MinSize = in.MinSize
+	return nil
+}
+
+// Convert_certmanager_IssuerPrivateKeyConstraint_To_v1beta1_IssuerPrivateKeyConstraint is an autogenerated conversion function.
+func Convert_certmanager_IssuerPrivateKeyConstraint_To_v1beta1_IssuerPrivateKeyConstraint(in *certmanager.IssuerPrivateKeyConstraint, out *v1beta1.IssuerPrivateKeyConstraint, s conversion.Scope) error {
+	return autoConvert_certmanager_IssuerPrivateKeyConstraint_To_v1beta1_IssuerPrivateKeyConstraint(in, out, s)
+}
+
 func autoConvert_v1beta1_IssuerSpec_To_certmanager_IssuerSpec(in *v1beta1.IssuerSpec, out *certmanager.IssuerSpec, s conversion.Scope) error {
 	if err := Convert_v1beta1_IssuerConfig_To_certmanager_IssuerConfig(&in.IssuerConfig, &out.IssuerConfig, s); err != nil {
 		return err
 	}
+	out.Approval = (*certmanager.IssuerApproval)(unsafe.Pointer(in.Approval))
+	out.Constraints = (*certmanager.IssuerConstraints)(unsafe.Pointer(in.Constraints))
+	out.NamespaceSelector = (*v1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
 	return nil
 }
 
@@ -1114,6 +1471,9 @@ func autoConvert_certmanager_IssuerSpec_To_v1beta1_IssuerSpec(in *certmanager.Is
 	if err := Convert_certmanager_IssuerConfig_To_v1beta1_IssuerConfig(&in.IssuerConfig, &out.IssuerConfig, s); err != nil {
 		return err
 	}
+	out.Approval = (*v1beta1.IssuerApproval)(unsafe.Pointer(in.Approval))
+	out.Constraints = (*v1beta1.IssuerConstraints)(unsafe.Pointer(in.Constraints))
+	out.NamespaceSelector = (*v1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
 	return nil
 }
 
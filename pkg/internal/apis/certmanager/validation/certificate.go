@@ -20,9 +20,14 @@ import (
 	"fmt"
 	"net"
 	"net/mail"
+	"strings"
+	"time"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/runtime"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/jetstack/cert-manager/pkg/api/util"
@@ -30,6 +35,7 @@ import (
 	"github.com/jetstack/cert-manager/pkg/internal/api/validation"
 	internalcmapi "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
 	cmmeta "github.com/jetstack/cert-manager/pkg/internal/apis/meta"
+	"github.com/jetstack/cert-manager/pkg/util/cron"
 )
 
 // Validation functions for cert-manager Certificate types
@@ -60,23 +66,13 @@ func ValidateCertificateSpec(crt *internalcmapi.CertificateSpec, fldPath *field.
 	}
 
 	if crt.PrivateKey != nil {
-		switch crt.PrivateKey.Algorithm {
-		case "", internalcmapi.RSAKeyAlgorithm:
-			if crt.PrivateKey.Size > 0 && (crt.PrivateKey.Size < 2048 || crt.PrivateKey.Size > 8192) {
-				el = append(el, field.Invalid(fldPath.Child("privateKey", "size"), crt.PrivateKey.Size, "must be between 2048 & 8192 for rsa keyAlgorithm"))
-			}
-		case internalcmapi.ECDSAKeyAlgorithm:
-			if crt.PrivateKey.Size > 0 && crt.PrivateKey.Size != 256 && crt.PrivateKey.Size != 384 && crt.PrivateKey.Size != 521 {
-				el = append(el, field.NotSupported(fldPath.Child("privateKey", "size"), crt.PrivateKey.Size, []string{"256", "384", "521"}))
-			}
-		case internalcmapi.Ed25519KeyAlgorithm:
-			break
-		default:
-			el = append(el, field.Invalid(fldPath.Child("privateKey", "algorithm"), crt.PrivateKey.Algorithm, "must be either empty or one of rsa or ecdsa"))
-		}
+		el = append(el, validatePrivateKey(crt.PrivateKey, fldPath.Child("privateKey"))...)
+	}
+	if len(crt.PrivateKeyProfiles) > 0 {
+		el = append(el, validatePrivateKeyProfiles(crt.PrivateKeyProfiles, fldPath.Child("privateKeyProfiles"))...)
 	}
 
-	if crt.Duration != nil || crt.RenewBefore != nil {
+	if crt.Duration != nil || crt.RenewBefore != nil || crt.RenewBeforePercentage != nil || crt.RenewalJitter != nil {
 		el = append(el, ValidateDuration(crt, fldPath)...)
 	}
 	if len(crt.Usages) > 0 {
@@ -85,6 +81,21 @@ func ValidateCertificateSpec(crt *internalcmapi.CertificateSpec, fldPath *field.
 	if crt.RevisionHistoryLimit != nil && *crt.RevisionHistoryLimit < 1 {
 		el = append(el, field.Invalid(fldPath.Child("revisionHistoryLimit"), *crt.RevisionHistoryLimit, "must not be less than 1"))
 	}
+	if crt.SecretTemplate != nil {
+		el = append(el, validateSecretTemplate(crt.SecretTemplate, fldPath.Child("secretTemplate"))...)
+	}
+	if len(crt.RenewalWindows) > 0 {
+		el = append(el, validateRenewalWindows(crt.RenewalWindows, fldPath.Child("renewalWindows"))...)
+	}
+	if crt.RetryBackoff != nil {
+		el = append(el, validateRetryBackoff(crt.RetryBackoff, fldPath.Child("retryBackoff"))...)
+	}
+	if len(crt.AdditionalOutputFormats) > 0 {
+		el = append(el, validateAdditionalOutputFormats(crt.AdditionalOutputFormats, fldPath.Child("additionalOutputFormats"))...)
+	}
+	if len(crt.AdditionalSecretTargets) > 0 {
+		el = append(el, validateAdditionalSecretTargets(crt.AdditionalSecretTargets, fldPath.Child("additionalSecretTargets"))...)
+	}
 
 	return el
 }
@@ -121,6 +132,156 @@ func validateIssuerRef(issuerRef cmmeta.ObjectReference, fldPath *field.Path) fi
 	return el
 }
 
+// validateSecretTemplate ensures the labels and annotations are valid, and
+// that annotations do not overwrite those set by cert-manager.
+func validatePrivateKey(pk *internalcmapi.CertificatePrivateKey, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	switch pk.Algorithm {
+	case "", internalcmapi.RSAKeyAlgorithm:
+		if pk.Size > 0 && (pk.Size < 2048 || pk.Size > 8192) {
+			el = append(el, field.Invalid(fldPath.Child("size"), pk.Size, "must be between 2048 & 8192 for rsa keyAlgorithm"))
+		}
+	case internalcmapi.ECDSAKeyAlgorithm:
+		if pk.Size > 0 && pk.Size != 256 && pk.Size != 384 && pk.Size != 521 {
+			el = append(el, field.NotSupported(fldPath.Child("size"), pk.Size, []string{"256", "384", "521"}))
+		}
+	case internalcmapi.Ed25519KeyAlgorithm:
+		break
+	default:
+		el = append(el, field.Invalid(fldPath.Child("algorithm"), pk.Algorithm, "must be either empty or one of rsa or ecdsa"))
+	}
+	return el
+}
+
+// validatePrivateKeyProfiles ensures that each private key profile has a
+// unique name that can be used in the keys of the Secret's data, and valid
+// private key options.
+func validatePrivateKeyProfiles(profiles []internalcmapi.CertificatePrivateKeyProfile, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	seen := make(map[string]bool)
+	for i, profile := range profiles {
+		if len(profile.Name) == 0 {
+			el = append(el, field.Required(fldPath.Index(i).Child("name"), "must be specified"))
+		} else {
+			for _, msg := range utilvalidation.IsDNS1123Label(profile.Name) {
+				el = append(el, field.Invalid(fldPath.Index(i).Child("name"), profile.Name, msg))
+			}
+			if seen[profile.Name] {
+				el = append(el, field.Duplicate(fldPath.Index(i).Child("name"), profile.Name))
+			}
+			seen[profile.Name] = true
+		}
+		el = append(el, validatePrivateKey(&profile.PrivateKey, fldPath.Index(i).Child("privateKey"))...)
+	}
+	return el
+}
+
+func validateSecretTemplate(tmpl *internalcmapi.CertificateSecretTemplate, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	el = append(el, metav1validation.ValidateLabels(tmpl.Labels, fldPath.Child("labels"))...)
+	el = append(el, apivalidation.ValidateAnnotations(tmpl.Annotations, fldPath.Child("annotations"))...)
+	for k := range tmpl.Annotations {
+		if strings.HasPrefix(k, "cert-manager.io/") {
+			el = append(el, field.Invalid(fldPath.Child("annotations"), k, "cert-manager.io/* annotations are not allowed"))
+		}
+	}
+	return el
+}
+
+// validateRenewalWindows ensures that each renewal window has a valid cron
+// schedule and time zone, and stays open for at least a minute.
+func validateRenewalWindows(windows []internalcmapi.CertificateRenewalWindow, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	for i, w := range windows {
+		if len(w.Schedule) == 0 {
+			el = append(el, field.Required(fldPath.Index(i).Child("schedule"), "must be specified"))
+		} else if _, err := cron.Parse(w.Schedule); err != nil {
+			el = append(el, field.Invalid(fldPath.Index(i).Child("schedule"), w.Schedule, err.Error()))
+		}
+		if w.Duration.Duration < time.Minute {
+			el = append(el, field.Invalid(fldPath.Index(i).Child("duration"), w.Duration.Duration, "renewal window duration must be at least 1m"))
+		}
+		if len(w.TimeZone) > 0 {
+			if _, err := time.LoadLocation(w.TimeZone); err != nil {
+				el = append(el, field.Invalid(fldPath.Index(i).Child("timeZone"), w.TimeZone, err.Error()))
+			}
+		}
+	}
+	return el
+}
+
+// validateRetryBackoff ensures that the retry backoff bounds are positive and
+// that max is not less than min.
+func validateRetryBackoff(backoff *internalcmapi.CertificateRetryBackoff, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if backoff.Min != nil && backoff.Min.Duration <= 0 {
+		el = append(el, field.Invalid(fldPath.Child("min"), backoff.Min.Duration, "retry backoff min must be greater than 0"))
+	}
+	if backoff.Max != nil && backoff.Max.Duration <= 0 {
+		el = append(el, field.Invalid(fldPath.Child("max"), backoff.Max.Duration, "retry backoff max must be greater than 0"))
+	}
+	if len(el) == 0 && backoff.Min != nil && backoff.Max != nil && backoff.Max.Duration < backoff.Min.Duration {
+		el = append(el, field.Invalid(fldPath.Child("max"), backoff.Max.Duration, fmt.Sprintf("retry backoff max must not be less than min (%s)", backoff.Min.Duration)))
+	}
+	return el
+}
+
+var supportedOutputFormatTypes = []string{
+	string(internalcmapi.CertificateOutputFormatCombinedPEM),
+	string(internalcmapi.CertificateOutputFormatDER),
+	string(internalcmapi.CertificateOutputFormatFullChainPEM),
+}
+
+// validateAdditionalOutputFormats ensures that each output format type is
+// supported and appears at most once.
+func validateAdditionalOutputFormats(formats []internalcmapi.CertificateAdditionalOutputFormat, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	seen := make(map[internalcmapi.CertificateOutputFormatType]bool)
+	for i, f := range formats {
+		switch f.Type {
+		case internalcmapi.CertificateOutputFormatCombinedPEM,
+			internalcmapi.CertificateOutputFormatDER,
+			internalcmapi.CertificateOutputFormatFullChainPEM:
+		default:
+			el = append(el, field.NotSupported(fldPath.Index(i).Child("type"), f.Type, supportedOutputFormatTypes))
+			continue
+		}
+		if seen[f.Type] {
+			el = append(el, field.Duplicate(fldPath.Index(i).Child("type"), f.Type))
+		}
+		seen[f.Type] = true
+	}
+	return el
+}
+
+// validateAdditionalSecretTargets ensures that each additional secret target
+// names a valid Secret and namespace, and that no target appears twice.
+func validateAdditionalSecretTargets(targets []internalcmapi.CertificateSecretTarget, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	seen := make(map[internalcmapi.CertificateSecretTarget]bool)
+	for i, target := range targets {
+		if len(target.Namespace) == 0 {
+			el = append(el, field.Required(fldPath.Index(i).Child("namespace"), "must be specified"))
+		} else {
+			for _, msg := range utilvalidation.IsDNS1123Label(target.Namespace) {
+				el = append(el, field.Invalid(fldPath.Index(i).Child("namespace"), target.Namespace, msg))
+			}
+		}
+		if len(target.Name) == 0 {
+			el = append(el, field.Required(fldPath.Index(i).Child("name"), "must be specified"))
+		} else {
+			for _, msg := range utilvalidation.IsDNS1123Subdomain(target.Name) {
+				el = append(el, field.Invalid(fldPath.Index(i).Child("name"), target.Name, msg))
+			}
+		}
+		if seen[target] {
+			el = append(el, field.Duplicate(fldPath.Index(i), target))
+		}
+		seen[target] = true
+	}
+	return el
+}
+
 func validateIPAddresses(a *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
 	if len(a.IPAddresses) <= 0 {
 		return nil
@@ -180,5 +341,16 @@ func ValidateDuration(crt *internalcmapi.CertificateSpec, fldPath *field.Path) f
 	if crt.RenewBefore != nil && crt.RenewBefore.Duration >= duration {
 		el = append(el, field.Invalid(fldPath.Child("renewBefore"), crt.RenewBefore.Duration, fmt.Sprintf("certificate duration %s must be greater than renewBefore %s", duration, crt.RenewBefore.Duration)))
 	}
+	if crt.RenewBeforePercentage != nil {
+		if crt.RenewBefore != nil {
+			el = append(el, field.Forbidden(fldPath.Child("renewBeforePercentage"), "cannot be set together with renewBefore"))
+		}
+		if p := *crt.RenewBeforePercentage; p < 1 || p > 99 {
+			el = append(el, field.Invalid(fldPath.Child("renewBeforePercentage"), p, "certificate renewBeforePercentage must be between 1 and 99"))
+		}
+	}
+	if crt.RenewalJitter != nil && crt.RenewalJitter.Duration < 0 {
+		el = append(el, field.Invalid(fldPath.Child("renewalJitter"), crt.RenewalJitter.Duration, "certificate renewalJitter must not be negative"))
+	}
 	return el
 }
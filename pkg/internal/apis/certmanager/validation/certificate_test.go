@@ -563,6 +563,241 @@ func TestValidateCertificate(t *testing.T) {
 				field.Invalid(fldPath.Child("revisionHistoryLimit"), int32(0), "must not be less than 1"),
 			},
 		},
+		"valid certificate with secretTemplate": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "abc",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					SecretTemplate: &internalcmapi.CertificateSecretTemplate{
+						Labels:      map[string]string{"app.kubernetes.io/name": "abc"},
+						Annotations: map[string]string{"example.com/backup": "true"},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid certificate with cert-manager.io annotation in secretTemplate": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "abc",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					SecretTemplate: &internalcmapi.CertificateSecretTemplate{
+						Annotations: map[string]string{"cert-manager.io/certificate-name": "xyz"},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("secretTemplate", "annotations"), "cert-manager.io/certificate-name", "cert-manager.io/* annotations are not allowed"),
+			},
+		},
+		"invalid certificate with invalid label value in secretTemplate": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "abc",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					SecretTemplate: &internalcmapi.CertificateSecretTemplate{
+						Labels: map[string]string{"app": "not a valid value"},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("secretTemplate", "labels"), "not a valid value", "a valid label must be an empty string or consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyValue',  or 'my_value',  or '12345', regex used for validation is '(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])?')"),
+			},
+		},
+		"valid certificate with renewalWindows": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "abc",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					RenewalWindows: []internalcmapi.CertificateRenewalWindow{
+						{Schedule: "0 3 * * SUN", Duration: metav1.Duration{Duration: time.Hour}, TimeZone: "Europe/London"},
+						{Schedule: "@daily", Duration: metav1.Duration{Duration: time.Minute}},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid certificate with renewalWindows": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "abc",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					RenewalWindows: []internalcmapi.CertificateRenewalWindow{
+						{Duration: metav1.Duration{Duration: time.Hour}},
+						{Schedule: "0 3 * *", Duration: metav1.Duration{Duration: time.Second}, TimeZone: "Mars/Olympus_Mons"},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Required(fldPath.Child("renewalWindows").Index(0).Child("schedule"), "must be specified"),
+				field.Invalid(fldPath.Child("renewalWindows").Index(1).Child("schedule"), "0 3 * *", `expected 5 fields, found 4: "0 3 * *"`),
+				field.Invalid(fldPath.Child("renewalWindows").Index(1).Child("duration"), time.Second, "renewal window duration must be at least 1m"),
+				field.Invalid(fldPath.Child("renewalWindows").Index(1).Child("timeZone"), "Mars/Olympus_Mons", "unknown time zone Mars/Olympus_Mons"),
+			},
+		},
+		"valid certificate with retryBackoff": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "abc",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					RetryBackoff: &internalcmapi.CertificateRetryBackoff{
+						Min: &metav1.Duration{Duration: time.Minute},
+						Max: &metav1.Duration{Duration: time.Hour},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid certificate with non-positive retryBackoff": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "abc",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					RetryBackoff: &internalcmapi.CertificateRetryBackoff{
+						Min: &metav1.Duration{Duration: 0},
+						Max: &metav1.Duration{Duration: -time.Hour},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("retryBackoff", "min"), time.Duration(0), "retry backoff min must be greater than 0"),
+				field.Invalid(fldPath.Child("retryBackoff", "max"), -time.Hour, "retry backoff max must be greater than 0"),
+			},
+		},
+		"invalid certificate with retryBackoff max less than min": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "abc",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					RetryBackoff: &internalcmapi.CertificateRetryBackoff{
+						Min: &metav1.Duration{Duration: time.Hour},
+						Max: &metav1.Duration{Duration: time.Minute},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("retryBackoff", "max"), time.Minute, "retry backoff max must not be less than min (1h0m0s)"),
+			},
+		},
+		"valid certificate with privateKeyProfiles": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "abc",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKeyProfiles: []internalcmapi.CertificatePrivateKeyProfile{
+						{Name: "ecdsa", PrivateKey: internalcmapi.CertificatePrivateKey{Algorithm: internalcmapi.ECDSAKeyAlgorithm, Size: 384}},
+						{Name: "ed25519", PrivateKey: internalcmapi.CertificatePrivateKey{Algorithm: internalcmapi.Ed25519KeyAlgorithm}},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid certificate with invalid and duplicate privateKeyProfiles": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "abc",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKeyProfiles: []internalcmapi.CertificatePrivateKeyProfile{
+						{Name: "ecdsa", PrivateKey: internalcmapi.CertificatePrivateKey{Algorithm: internalcmapi.ECDSAKeyAlgorithm}},
+						{Name: "ecdsa", PrivateKey: internalcmapi.CertificatePrivateKey{Algorithm: internalcmapi.ECDSAKeyAlgorithm, Size: 128}},
+						{Name: "", PrivateKey: internalcmapi.CertificatePrivateKey{Algorithm: internalcmapi.RSAKeyAlgorithm, Size: 1024}},
+						{Name: "Not_Valid", PrivateKey: internalcmapi.CertificatePrivateKey{Algorithm: "dsa"}},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Duplicate(fldPath.Child("privateKeyProfiles").Index(1).Child("name"), "ecdsa"),
+				field.NotSupported(fldPath.Child("privateKeyProfiles").Index(1).Child("privateKey", "size"), 128, []string{"256", "384", "521"}),
+				field.Required(fldPath.Child("privateKeyProfiles").Index(2).Child("name"), "must be specified"),
+				field.Invalid(fldPath.Child("privateKeyProfiles").Index(2).Child("privateKey", "size"), 1024, "must be between 2048 & 8192 for rsa keyAlgorithm"),
+				field.Invalid(fldPath.Child("privateKeyProfiles").Index(3).Child("name"), "Not_Valid", "a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?')"),
+				field.Invalid(fldPath.Child("privateKeyProfiles").Index(3).Child("privateKey", "algorithm"), internalcmapi.PrivateKeyAlgorithm("dsa"), "must be either empty or one of rsa or ecdsa"),
+			},
+		},
+		"valid certificate with additionalSecretTargets": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "abc",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					AdditionalSecretTargets: []internalcmapi.CertificateSecretTarget{
+						{Namespace: "team-a", Name: "abc"},
+						{Namespace: "team-b", Name: "abc"},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid certificate with invalid and duplicate additionalSecretTargets": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "abc",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					AdditionalSecretTargets: []internalcmapi.CertificateSecretTarget{
+						{Namespace: "team-a", Name: "abc"},
+						{Namespace: "team-a", Name: "abc"},
+						{Namespace: "", Name: "Not_Valid"},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Duplicate(fldPath.Child("additionalSecretTargets").Index(1), internalcmapi.CertificateSecretTarget{Namespace: "team-a", Name: "abc"}),
+				field.Required(fldPath.Child("additionalSecretTargets").Index(2).Child("namespace"), "must be specified"),
+				field.Invalid(fldPath.Child("additionalSecretTargets").Index(2).Child("name"), "Not_Valid", "a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')"),
+			},
+		},
+		"valid certificate with additionalOutputFormats": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "abc",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					AdditionalOutputFormats: []internalcmapi.CertificateAdditionalOutputFormat{
+						{Type: internalcmapi.CertificateOutputFormatCombinedPEM},
+						{Type: internalcmapi.CertificateOutputFormatDER},
+						{Type: internalcmapi.CertificateOutputFormatFullChainPEM},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid certificate with unsupported and duplicate additionalOutputFormats": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "abc",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					AdditionalOutputFormats: []internalcmapi.CertificateAdditionalOutputFormat{
+						{Type: internalcmapi.CertificateOutputFormatDER},
+						{Type: "PKCS7"},
+						{Type: internalcmapi.CertificateOutputFormatDER},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.NotSupported(fldPath.Child("additionalOutputFormats").Index(1).Child("type"), internalcmapi.CertificateOutputFormatType("PKCS7"), []string{"CombinedPEM", "DER", "FullChainPEM"}),
+				field.Duplicate(fldPath.Child("additionalOutputFormats").Index(2).Child("type"), internalcmapi.CertificateOutputFormatDER),
+			},
+		},
 		"v1alpha2 certificate created": {
 			cfg: &internalcmapi.Certificate{
 				Spec: internalcmapi.CertificateSpec{
@@ -756,6 +991,51 @@ func TestValidateDuration(t *testing.T) {
 			},
 			errs: []*field.Error{field.Invalid(fldPath.Child("duration"), usefulDurations["half hour"].Duration, fmt.Sprintf("certificate duration must be greater than %s", cmapi.MinimumCertificateDuration))},
 		},
+		"valid renewBeforePercentage and renewalJitter": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					RenewBeforePercentage: int32Ptr(25),
+					RenewalJitter:         usefulDurations["one hour"],
+					CommonName:            "testcn",
+					SecretName:            "abc",
+					IssuerRef:             validIssuerRef,
+				},
+			},
+		},
+		"renewBeforePercentage is out of range": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					RenewBeforePercentage: int32Ptr(100),
+					CommonName:            "testcn",
+					SecretName:            "abc",
+					IssuerRef:             validIssuerRef,
+				},
+			},
+			errs: []*field.Error{field.Invalid(fldPath.Child("renewBeforePercentage"), int32(100), "certificate renewBeforePercentage must be between 1 and 99")},
+		},
+		"renewBeforePercentage is set together with renewBefore": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					RenewBefore:           usefulDurations["one hour"],
+					RenewBeforePercentage: int32Ptr(25),
+					CommonName:            "testcn",
+					SecretName:            "abc",
+					IssuerRef:             validIssuerRef,
+				},
+			},
+			errs: []*field.Error{field.Forbidden(fldPath.Child("renewBeforePercentage"), "cannot be set together with renewBefore")},
+		},
+		"renewalJitter is negative": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					RenewalJitter: &metav1.Duration{Duration: -time.Hour},
+					CommonName:    "testcn",
+					SecretName:    "abc",
+					IssuerRef:     validIssuerRef,
+				},
+			},
+			errs: []*field.Error{field.Invalid(fldPath.Child("renewalJitter"), -time.Hour, "certificate renewalJitter must not be negative")},
+		},
 	}
 	for n, s := range scenarios {
 		t.Run(n, func(t *testing.T) {
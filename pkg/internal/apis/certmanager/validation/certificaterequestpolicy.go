@@ -0,0 +1,104 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/jetstack/cert-manager/pkg/api/util"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/jetstack/cert-manager/pkg/internal/api/validation"
+	internalcmapi "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
+	"github.com/jetstack/cert-manager/pkg/util/expression"
+)
+
+// Validation functions for cert-manager CertificateRequestPolicy types.
+
+func ValidateCertificateRequestPolicy(a *admissionv1.AdmissionRequest, obj runtime.Object) (field.ErrorList, validation.WarningList) {
+	policy := obj.(*internalcmapi.CertificateRequestPolicy)
+	return ValidateCertificateRequestPolicySpec(&policy.Spec, field.NewPath("spec")), nil
+}
+
+func ValidateUpdateCertificateRequestPolicy(a *admissionv1.AdmissionRequest, oldObj, obj runtime.Object) (field.ErrorList, validation.WarningList) {
+	policy := obj.(*internalcmapi.CertificateRequestPolicy)
+	return ValidateCertificateRequestPolicySpec(&policy.Spec, field.NewPath("spec")), nil
+}
+
+func ValidateCertificateRequestPolicySpec(spec *internalcmapi.CertificateRequestPolicySpec, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	for i, u := range spec.AllowedUsages {
+		_, kok := util.KeyUsageType(cmapi.KeyUsage(u))
+		_, ekok := util.ExtKeyUsageType(cmapi.KeyUsage(u))
+		if !kok && !ekok {
+			el = append(el, field.Invalid(fldPath.Child("allowedUsages").Index(i), u, "unknown keyusage"))
+		}
+	}
+
+	if spec.MinDuration != nil && spec.MinDuration.Duration <= 0 {
+		el = append(el, field.Invalid(fldPath.Child("minDuration"), spec.MinDuration.Duration, "must be greater than 0"))
+	}
+	if spec.MaxDuration != nil && spec.MaxDuration.Duration <= 0 {
+		el = append(el, field.Invalid(fldPath.Child("maxDuration"), spec.MaxDuration.Duration, "must be greater than 0"))
+	}
+	if len(el) == 0 && spec.MinDuration != nil && spec.MaxDuration != nil && spec.MaxDuration.Duration < spec.MinDuration.Duration {
+		el = append(el, field.Invalid(fldPath.Child("maxDuration"), spec.MaxDuration.Duration, fmt.Sprintf("must not be less than minDuration (%s)", spec.MinDuration.Duration)))
+	}
+
+	for i, pk := range spec.AllowedPrivateKeys {
+		el = append(el, validatePolicyPrivateKey(pk, fldPath.Child("allowedPrivateKeys").Index(i))...)
+	}
+
+	for i, expr := range spec.Expressions {
+		if _, err := expression.Compile(expr); err != nil {
+			el = append(el, field.Invalid(fldPath.Child("expressions").Index(i), expr, err.Error()))
+		}
+	}
+
+	return el
+}
+
+func validatePolicyPrivateKey(pk internalcmapi.CertificateRequestPolicyPrivateKey, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	switch pk.Algorithm {
+	case internalcmapi.RSAKeyAlgorithm, internalcmapi.ECDSAKeyAlgorithm:
+	case internalcmapi.Ed25519KeyAlgorithm:
+		if pk.MinSize != 0 || pk.MaxSize != 0 {
+			el = append(el, field.Forbidden(fldPath, "minSize and maxSize must not be set for Ed25519 private keys"))
+		}
+	case "":
+		el = append(el, field.Required(fldPath.Child("algorithm"), "must be specified"))
+	default:
+		el = append(el, field.NotSupported(fldPath.Child("algorithm"), pk.Algorithm, []string{
+			string(internalcmapi.RSAKeyAlgorithm), string(internalcmapi.ECDSAKeyAlgorithm), string(internalcmapi.Ed25519KeyAlgorithm),
+		}))
+	}
+	if pk.MinSize < 0 {
+		el = append(el, field.Invalid(fldPath.Child("minSize"), pk.MinSize, "must not be negative"))
+	}
+	if pk.MaxSize < 0 {
+		el = append(el, field.Invalid(fldPath.Child("maxSize"), pk.MaxSize, "must not be negative"))
+	}
+	if pk.MinSize > 0 && pk.MaxSize > 0 && pk.MaxSize < pk.MinSize {
+		el = append(el, field.Invalid(fldPath.Child("maxSize"), pk.MaxSize, fmt.Sprintf("must not be less than minSize (%d)", pk.MinSize)))
+	}
+	return el
+}
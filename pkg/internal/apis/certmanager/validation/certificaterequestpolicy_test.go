@@ -0,0 +1,110 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
+)
+
+func TestValidateCertificateRequestPolicySpec(t *testing.T) {
+	fldPath := field.NewPath("spec")
+	scenarios := map[string]struct {
+		spec cmapi.CertificateRequestPolicySpec
+		errs field.ErrorList
+	}{
+		"valid policy": {
+			spec: cmapi.CertificateRequestPolicySpec{
+				IssuerRefs:      []cmapi.CertificateRequestPolicyIssuerRef{{Name: "*", Kind: "ClusterIssuer"}},
+				AllowedDNSNames: []string{"*.example.com"},
+				AllowedUsages:   []cmapi.KeyUsage{cmapi.UsageServerAuth, cmapi.UsageDigitalSignature},
+				MinDuration:     &metav1.Duration{Duration: time.Hour},
+				MaxDuration:     &metav1.Duration{Duration: time.Hour * 24},
+				AllowedPrivateKeys: []cmapi.CertificateRequestPolicyPrivateKey{
+					{Algorithm: cmapi.RSAKeyAlgorithm, MinSize: 2048, MaxSize: 4096},
+					{Algorithm: cmapi.Ed25519KeyAlgorithm},
+				},
+			},
+			errs: field.ErrorList{},
+		},
+		"invalid usages and durations": {
+			spec: cmapi.CertificateRequestPolicySpec{
+				AllowedUsages: []cmapi.KeyUsage{"unknown"},
+				MinDuration:   &metav1.Duration{Duration: time.Hour * 24},
+				MaxDuration:   &metav1.Duration{Duration: time.Hour},
+			},
+			errs: field.ErrorList{
+				field.Invalid(fldPath.Child("allowedUsages").Index(0), cmapi.KeyUsage("unknown"), "unknown keyusage"),
+			},
+		},
+		"maxDuration less than minDuration": {
+			spec: cmapi.CertificateRequestPolicySpec{
+				MinDuration: &metav1.Duration{Duration: time.Hour * 24},
+				MaxDuration: &metav1.Duration{Duration: time.Hour},
+			},
+			errs: field.ErrorList{
+				field.Invalid(fldPath.Child("maxDuration"), time.Hour, "must not be less than minDuration (24h0m0s)"),
+			},
+		},
+		"invalid private keys": {
+			spec: cmapi.CertificateRequestPolicySpec{
+				AllowedPrivateKeys: []cmapi.CertificateRequestPolicyPrivateKey{
+					{MinSize: 4096, MaxSize: 2048},
+					{Algorithm: cmapi.Ed25519KeyAlgorithm, MinSize: 256},
+					{Algorithm: "DSA"},
+				},
+			},
+			errs: field.ErrorList{
+				field.Required(fldPath.Child("allowedPrivateKeys").Index(0).Child("algorithm"), "must be specified"),
+				field.Invalid(fldPath.Child("allowedPrivateKeys").Index(0).Child("maxSize"), 2048, "must not be less than minSize (4096)"),
+				field.Forbidden(fldPath.Child("allowedPrivateKeys").Index(1), "minSize and maxSize must not be set for Ed25519 private keys"),
+				field.NotSupported(fldPath.Child("allowedPrivateKeys").Index(2).Child("algorithm"), cmapi.PrivateKeyAlgorithm("DSA"), []string{"RSA", "ECDSA", "Ed25519"}),
+			},
+		},
+		"valid expressions": {
+			spec: cmapi.CertificateRequestPolicySpec{
+				Expressions: []string{
+					"request.dnsNames.all(n, n.endsWith(request.namespace + '.svc'))",
+					"'admins' in request.groups || !request.isCA",
+				},
+			},
+			errs: field.ErrorList{},
+		},
+		"invalid expression": {
+			spec: cmapi.CertificateRequestPolicySpec{
+				Expressions: []string{"true", "size(request.dnsNames)"},
+			},
+			errs: field.ErrorList{
+				field.Invalid(fldPath.Child("expressions").Index(1), "size(request.dnsNames)", "expression must evaluate to a bool, not int"),
+			},
+		},
+	}
+	for name, s := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			errs := ValidateCertificateRequestPolicySpec(&s.spec, fldPath)
+			if !reflect.DeepEqual(errs, s.errs) {
+				t.Errorf("expected errors %v, got %v", s.errs, errs)
+			}
+		})
+	}
+}
@@ -98,6 +98,42 @@ func TestValidateClusterIssuer(t *testing.T) {
 					"ClusterIssuer"),
 			},
 		},
+		"ClusterIssuer with a namespaceSelector": {
+			cfg: &cmapi.ClusterIssuer{
+				Spec: cmapi.IssuerSpec{
+					IssuerConfig: baseIssuerConfig.IssuerConfig,
+					NamespaceSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"env": "prod"},
+					},
+				},
+			},
+			a: &admissionv1.AdmissionRequest{
+				RequestKind: &metav1.GroupVersionKind{Group: "cert-manager.io",
+					Version: "v1",
+					Kind:    "ClusterIssuer"},
+			},
+			expectedE: []*field.Error{},
+		},
+		"ClusterIssuer with an invalid namespaceSelector": {
+			cfg: &cmapi.ClusterIssuer{
+				Spec: cmapi.IssuerSpec{
+					IssuerConfig: baseIssuerConfig.IssuerConfig,
+					NamespaceSelector: &metav1.LabelSelector{
+						MatchExpressions: []metav1.LabelSelectorRequirement{
+							{Key: "env", Operator: metav1.LabelSelectorOpIn},
+						},
+					},
+				},
+			},
+			a: &admissionv1.AdmissionRequest{
+				RequestKind: &metav1.GroupVersionKind{Group: "cert-manager.io",
+					Version: "v1",
+					Kind:    "ClusterIssuer"},
+			},
+			expectedE: []*field.Error{
+				field.Required(field.NewPath("spec", "namespaceSelector", "matchExpressions").Index(0).Child("values"), "must be specified when `operator` is 'In' or 'NotIn'"),
+			},
+		},
 	}
 
 	for n, s := range scenarios {
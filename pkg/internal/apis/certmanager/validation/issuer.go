@@ -23,6 +23,7 @@ import (
 
 	admissionv1 "k8s.io/api/admission/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
@@ -38,6 +39,7 @@ import (
 func ValidateIssuer(a *admissionv1.AdmissionRequest, obj runtime.Object) (field.ErrorList, validation.WarningList) {
 	iss := obj.(*certmanager.Issuer)
 	allErrs, warnings := ValidateIssuerSpec(&iss.Spec, field.NewPath("spec"))
+	allErrs = append(allErrs, validateIssuerNamespaceSelector(&iss.Spec, field.NewPath("spec"))...)
 	warnings = append(warnings, validateAPIVersion(a.RequestKind)...)
 	return allErrs, warnings
 }
@@ -45,13 +47,75 @@ func ValidateIssuer(a *admissionv1.AdmissionRequest, obj runtime.Object) (field.
 func ValidateUpdateIssuer(a *admissionv1.AdmissionRequest, oldObj, obj runtime.Object) (field.ErrorList, validation.WarningList) {
 	iss := obj.(*certmanager.Issuer)
 	allErrs, warnings := ValidateIssuerSpec(&iss.Spec, field.NewPath("spec"))
+	allErrs = append(allErrs, validateIssuerNamespaceSelector(&iss.Spec, field.NewPath("spec"))...)
 	// Admission request should never be nil
 	warnings = append(warnings, validateAPIVersion(a.RequestKind)...)
 	return allErrs, warnings
 }
 
 func ValidateIssuerSpec(iss *certmanager.IssuerSpec, fldPath *field.Path) (field.ErrorList, validation.WarningList) {
-	return ValidateIssuerConfig(&iss.IssuerConfig, fldPath)
+	el, warnings := ValidateIssuerConfig(&iss.IssuerConfig, fldPath)
+	if iss.Approval != nil {
+		el = append(el, ValidateIssuerApproval(iss.Approval, fldPath.Child("approval"))...)
+	}
+	if iss.Constraints != nil {
+		el = append(el, ValidateIssuerConstraints(iss.Constraints, fldPath.Child("constraints"))...)
+	}
+	if iss.NamespaceSelector != nil {
+		el = append(el, metav1validation.ValidateLabelSelector(iss.NamespaceSelector, fldPath.Child("namespaceSelector"))...)
+	}
+	return el, warnings
+}
+
+// validateIssuerNamespaceSelector forbids a namespaceSelector on namespaced
+// Issuers, which can only ever be referenced from their own namespace.
+func validateIssuerNamespaceSelector(iss *certmanager.IssuerSpec, fldPath *field.Path) field.ErrorList {
+	if iss.NamespaceSelector == nil {
+		return nil
+	}
+	return field.ErrorList{field.Forbidden(fldPath.Child("namespaceSelector"), "may only be set on ClusterIssuers")}
+}
+
+func ValidateIssuerConstraints(constraints *certmanager.IssuerConstraints, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if constraints.MinDuration != nil && constraints.MinDuration.Duration <= 0 {
+		el = append(el, field.Invalid(fldPath.Child("minDuration"), constraints.MinDuration.Duration, "must be greater than 0"))
+	}
+	if constraints.MaxDuration != nil && constraints.MaxDuration.Duration <= 0 {
+		el = append(el, field.Invalid(fldPath.Child("maxDuration"), constraints.MaxDuration.Duration, "must be greater than 0"))
+	}
+	if constraints.MinDuration != nil && constraints.MaxDuration != nil && constraints.MinDuration.Duration > constraints.MaxDuration.Duration {
+		el = append(el, field.Invalid(fldPath.Child("minDuration"), constraints.MinDuration.Duration, "must not be greater than maxDuration"))
+	}
+
+	for i, key := range constraints.AllowedPrivateKeys {
+		keyPath := fldPath.Child("allowedPrivateKeys").Index(i)
+		switch key.Algorithm {
+		case certmanager.RSAKeyAlgorithm, certmanager.ECDSAKeyAlgorithm, certmanager.Ed25519KeyAlgorithm:
+		default:
+			el = append(el, field.NotSupported(keyPath.Child("algorithm"), key.Algorithm,
+				[]string{string(certmanager.RSAKeyAlgorithm), string(certmanager.ECDSAKeyAlgorithm), string(certmanager.Ed25519KeyAlgorithm)}))
+		}
+		if key.MinSize < 0 {
+			el = append(el, field.Invalid(keyPath.Child("minSize"), key.MinSize, "must not be negative"))
+		}
+	}
+
+	for i, domain := range constraints.AllowedDomains {
+		if len(domain) == 0 {
+			el = append(el, field.Required(fldPath.Child("allowedDomains").Index(i), "domain must not be empty"))
+		}
+	}
+
+	return el
+}
+
+func ValidateIssuerApproval(approval *certmanager.IssuerApproval, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if approval.RequiredApprovals < 1 {
+		el = append(el, field.Invalid(fldPath.Child("requiredApprovals"), approval.RequiredApprovals, "must be at least 1"))
+	}
+	return el
 }
 
 func ValidateIssuerConfig(iss *certmanager.IssuerConfig, fldPath *field.Path) (field.ErrorList, validation.WarningList) {
@@ -429,6 +493,37 @@ func ValidateACMEChallengeSolverDNS01(p *cmacme.ACMEChallengeSolverDNS01, fldPat
 				}
 
 			}
+			for i, ns := range p.RFC2136.FailoverNameservers {
+				if _, err := util.ValidNameserver(ns); err != nil {
+					el = append(el, field.Invalid(fldPath.Child("rfc2136", "failoverNameservers").Index(i), ns, "nameserver must be set in the form host:port where host is an IPv4 address, an enclosed IPv6 address or a hostname and port is an optional port number."))
+				}
+			}
+			if p.RFC2136.SIG0 != nil {
+				if len(p.RFC2136.TSIGKeyName) > 0 || len(p.RFC2136.TSIGSecret.Name) > 0 {
+					el = append(el, field.Forbidden(fldPath.Child("rfc2136", "sig0"), "may not be specified when using TSIG"))
+				}
+				if len(p.RFC2136.SIG0.PublicKey) == 0 {
+					el = append(el, field.Required(fldPath.Child("rfc2136", "sig0", "publicKey"), ""))
+				}
+				el = append(el, ValidateSecretKeySelector(&p.RFC2136.SIG0.PrivateKey, fldPath.Child("rfc2136", "sig0", "privateKeySecretRef"))...)
+			}
+		}
+	}
+	if p.ExternalDNS != nil {
+		if numProviders > 0 {
+			el = append(el, field.Forbidden(fldPath.Child("externalDNS"), "may not specify more than one provider type"))
+		} else {
+			numProviders++
+		}
+	}
+	if p.Embedded != nil {
+		if numProviders > 0 {
+			el = append(el, field.Forbidden(fldPath.Child("embedded"), "may not specify more than one provider type"))
+		} else {
+			numProviders++
+			if p.CNAMEStrategy == cmacme.FollowStrategy {
+				el = append(el, field.Invalid(fldPath.Child("cnameStrategy"), p.CNAMEStrategy, "CNAMEs cannot be followed when using the embedded DNS server"))
+			}
 		}
 	}
 	if p.Webhook != nil {
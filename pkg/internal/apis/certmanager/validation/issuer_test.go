@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -437,6 +438,67 @@ func TestValidateIssuerSpec(t *testing.T) {
 				field.Invalid(fldPath.Child("ca", "ocspServer").Index(0), "", `must be a valid URL, e.g., http://ocsp.int-x3.letsencrypt.org`),
 			},
 		},
+		"valid required approvals": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					CA: &cmapi.CAIssuer{
+						SecretName: "valid",
+					},
+				},
+				Approval: &cmapi.IssuerApproval{RequiredApprovals: 2},
+			},
+			errs: []*field.Error{},
+		},
+		"invalid required approvals": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					CA: &cmapi.CAIssuer{
+						SecretName: "valid",
+					},
+				},
+				Approval: &cmapi.IssuerApproval{},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("approval", "requiredApprovals"), 0, "must be at least 1"),
+			},
+		},
+		"valid constraints": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					CA: &cmapi.CAIssuer{
+						SecretName: "valid",
+					},
+				},
+				Constraints: &cmapi.IssuerConstraints{
+					MinDuration:        &metav1.Duration{Duration: time.Hour},
+					MaxDuration:        &metav1.Duration{Duration: time.Hour * 24},
+					AllowedPrivateKeys: []cmapi.IssuerPrivateKeyConstraint{{Algorithm: cmapi.RSAKeyAlgorithm, MinSize: 3072}},
+					AllowedDomains:     []string{"example.com"},
+				},
+			},
+			errs: []*field.Error{},
+		},
+		"invalid constraints": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					CA: &cmapi.CAIssuer{
+						SecretName: "valid",
+					},
+				},
+				Constraints: &cmapi.IssuerConstraints{
+					MinDuration:        &metav1.Duration{Duration: time.Hour * 48},
+					MaxDuration:        &metav1.Duration{Duration: time.Hour * 24},
+					AllowedPrivateKeys: []cmapi.IssuerPrivateKeyConstraint{{Algorithm: "DSA", MinSize: -1}},
+					AllowedDomains:     []string{""},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("constraints", "minDuration"), time.Hour*48, "must not be greater than maxDuration"),
+				field.NotSupported(fldPath.Child("constraints", "allowedPrivateKeys").Index(0).Child("algorithm"), cmapi.PrivateKeyAlgorithm("DSA"), []string{"RSA", "ECDSA", "Ed25519"}),
+				field.Invalid(fldPath.Child("constraints", "allowedPrivateKeys").Index(0).Child("minSize"), -1, "must not be negative"),
+				field.Required(fldPath.Child("constraints", "allowedDomains").Index(0), "domain must not be empty"),
+			},
+		},
 	}
 	for n, s := range scenarios {
 		t.Run(n, func(t *testing.T) {
@@ -846,6 +908,58 @@ func TestValidateACMEIssuerDNS01Config(t *testing.T) {
 			},
 			errs: []*field.Error{},
 		},
+		"rfc2136 provider with failover nameservers": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				RFC2136: &cmacme.ACMEIssuerDNS01ProviderRFC2136{
+					Nameserver:          "127.0.0.1",
+					FailoverNameservers: []string{"127.0.0.2:53", ":53"},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("rfc2136", "failoverNameservers").Index(1), ":53", "nameserver must be set in the form host:port where host is an IPv4 address, an enclosed IPv6 address or a hostname and port is an optional port number."),
+			},
+		},
+		"rfc2136 provider with SIG(0)": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				RFC2136: &cmacme.ACMEIssuerDNS01ProviderRFC2136{
+					Nameserver: "127.0.0.1",
+					SIG0: &cmacme.ACMEIssuerDNS01ProviderRFC2136SIG0{
+						PublicKey:  "example.com. IN KEY 256 3 13 abcd",
+						PrivateKey: validSecretKeyRef,
+					},
+				},
+			},
+			errs: []*field.Error{},
+		},
+		"rfc2136 provider with SIG(0) missing fields": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				RFC2136: &cmacme.ACMEIssuerDNS01ProviderRFC2136{
+					Nameserver: "127.0.0.1",
+					SIG0:       &cmacme.ACMEIssuerDNS01ProviderRFC2136SIG0{},
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("rfc2136", "sig0", "publicKey"), ""),
+				field.Required(fldPath.Child("rfc2136", "sig0", "privateKeySecretRef", "name"), "secret name is required"),
+				field.Required(fldPath.Child("rfc2136", "sig0", "privateKeySecretRef", "key"), "secret key is required"),
+			},
+		},
+		"rfc2136 provider with SIG(0) and TSIG": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				RFC2136: &cmacme.ACMEIssuerDNS01ProviderRFC2136{
+					Nameserver:  "127.0.0.1",
+					TSIGKeyName: "abc",
+					TSIGSecret:  validSecretKeyRef,
+					SIG0: &cmacme.ACMEIssuerDNS01ProviderRFC2136SIG0{
+						PublicKey:  "example.com. IN KEY 256 3 13 abcd",
+						PrivateKey: validSecretKeyRef,
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("rfc2136", "sig0"), "may not be specified when using TSIG"),
+			},
+		},
 		"rfc2136 provider with unenclosed IPv6 nameserver": {
 			cfg: &cmacme.ACMEChallengeSolverDNS01{
 				RFC2136: &cmacme.ACMEIssuerDNS01ProviderRFC2136{
@@ -943,6 +1057,36 @@ func TestValidateACMEIssuerDNS01Config(t *testing.T) {
 				field.Required(fldPath.Child("rfc2136", "tsigKeyName"), ""),
 			},
 		},
+		"valid externalDNS provider": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				ExternalDNS: &cmacme.ACMEIssuerDNS01ProviderExternalDNS{},
+			},
+		},
+		"externalDNS provider configured alongside another provider": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				Route53: &cmacme.ACMEIssuerDNS01ProviderRoute53{
+					Region: "us-west-2",
+				},
+				ExternalDNS: &cmacme.ACMEIssuerDNS01ProviderExternalDNS{},
+			},
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("externalDNS"), "may not specify more than one provider type"),
+			},
+		},
+		"valid embedded provider": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				Embedded: &cmacme.ACMEIssuerDNS01ProviderEmbedded{},
+			},
+		},
+		"embedded provider with Follow CNAME strategy": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				CNAMEStrategy: cmacme.FollowStrategy,
+				Embedded:      &cmacme.ACMEIssuerDNS01ProviderEmbedded{},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("cnameStrategy"), cmacme.CNAMEStrategy(cmacme.FollowStrategy), "CNAMEs cannot be followed when using the embedded DNS server"),
+			},
+		},
 		"multiple providers configured": {
 			cfg: &cmacme.ACMEChallengeSolverDNS01{
 				CloudDNS: &cmacme.ACMEIssuerDNS01ProviderCloudDNS{
@@ -1200,6 +1344,24 @@ func TestValidateIssuer(t *testing.T) {
 					"Issuer"),
 			},
 		},
+		"Issuer with a namespaceSelector": {
+			cfg: &cmapi.Issuer{
+				Spec: cmapi.IssuerSpec{
+					IssuerConfig: baseIssuerConfig.IssuerConfig,
+					NamespaceSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"env": "prod"},
+					},
+				},
+			},
+			a: &admissionv1.AdmissionRequest{
+				RequestKind: &metav1.GroupVersionKind{Group: "cert-manager.io",
+					Version: "v1",
+					Kind:    "Issuer"},
+			},
+			expectedE: []*field.Error{
+				field.Forbidden(field.NewPath("spec", "namespaceSelector"), "may only be set on ClusterIssuers"),
+			},
+		},
 	}
 
 	for n, s := range scenarios {
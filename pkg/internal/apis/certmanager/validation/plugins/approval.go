@@ -33,17 +33,20 @@ import (
 
 	"github.com/jetstack/cert-manager/pkg/apis/certmanager"
 	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmclient "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
 	internalcmapi "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
 	"github.com/jetstack/cert-manager/pkg/internal/apis/certmanager/validation/util"
 )
 
 // approval is responsible for reviewing whether users attempting to approve or
-// deny a CertificateRequest have sufficient permissions to do so.
+// deny a CertificateRequest have sufficient permissions to do so, and whether
+// the quorum of approvals required by the issuer has been reached.
 type approval struct {
 	scheme *runtime.Scheme
 
 	sarclient      authzclient.SubjectAccessReviewInterface
 	discoverclient discovery.DiscoveryInterface
+	cmclient       cmclient.Interface
 }
 
 type signerResource struct {
@@ -63,9 +66,10 @@ func newApproval(scheme *runtime.Scheme) *approval {
 	}
 }
 
-func (a *approval) Init(client kubernetes.Interface) {
+func (a *approval) Init(client kubernetes.Interface, cmClient cmclient.Interface) {
 	a.sarclient = client.AuthorizationV1().SubjectAccessReviews()
 	a.discoverclient = client.Discovery()
+	a.cmclient = cmClient
 }
 
 // Validate will review whether the client is able to approve or deny the given
@@ -73,7 +77,9 @@ func (a *approval) Init(client kubernetes.Interface) {
 // performed if the client is attempting to approve/deny the request. An error
 // will be returned if the SubjectAccessReview fails, or if they do not have
 // permissions to perform the approval/denial. The request will also fail if
-// the referenced signer doesn't exist in this cluster.
+// the referenced signer doesn't exist in this cluster, or if the request is
+// being approved before the number of approvals required by the issuer has
+// been recorded.
 func (a *approval) Validate(ctx context.Context, req *admissionv1.AdmissionRequest, oldObj, obj runtime.Object) *field.Error {
 	// Only perform validation on UPDATE operations
 	if req.Operation != admissionv1.Update {
@@ -86,7 +92,7 @@ func (a *approval) Validate(ctx context.Context, req *admissionv1.AdmissionReque
 	}
 
 	// Error if the clients are not initialised
-	if a.sarclient == nil || a.discoverclient == nil {
+	if a.sarclient == nil || a.discoverclient == nil || a.cmclient == nil {
 		return internalError(errors.New("approval validation not initialised"))
 	}
 
@@ -144,9 +150,49 @@ func (a *approval) Validate(ctx context.Context, req *admissionv1.AdmissionReque
 			fmt.Sprintf("user %q does not have permissions to set approved/denied conditions for issuer %v", req.UserInfo.Username, newCR.Spec.IssuerRef))
 	}
 
+	// If the request is being marked as Approved, ensure that it has been
+	// approved by as many distinct users as the issuer requires.
+	if isApproving(oldCR, newCR) {
+		required, err := a.requiredApprovals(ctx, newCR)
+		if err != nil {
+			return internalError(err)
+		}
+
+		if approvers := countApprovers(newCR); approvers < required {
+			return field.Forbidden(field.NewPath("status.conditions"),
+				fmt.Sprintf("issuer %v requires approval by %d distinct users but the request has been approved by %d", newCR.Spec.IssuerRef, required, approvers))
+		}
+	}
+
 	return nil
 }
 
+// requiredApprovals returns the number of distinct users that must approve
+// the request before it may be marked as Approved, as configured on the
+// referenced cert-manager Issuer or ClusterIssuer. Requests for any other
+// signer, or for an issuer that does not exist, do not require a quorum.
+func (a *approval) requiredApprovals(ctx context.Context, cr *internalcmapi.CertificateRequest) (int, error) {
+	spec, err := issuerSpec(ctx, a.cmclient, cr.Namespace, cr.Spec.IssuerRef)
+	if err != nil {
+		return 0, err
+	}
+
+	if spec == nil || spec.Approval == nil {
+		return 0, nil
+	}
+	return spec.Approval.RequiredApprovals, nil
+}
+
+// countApprovers returns the number of distinct users that have recorded an
+// approval of the request.
+func countApprovers(cr *internalcmapi.CertificateRequest) int {
+	usernames := make(map[string]bool)
+	for _, approval := range cr.Status.Approvals {
+		usernames[approval.Username] = true
+	}
+	return len(usernames)
+}
+
 // reviewRequest will perform a SubjectAccessReview with the UserInfo fields of
 // the client against the issuer of the CertificateRequest. A client must have
 // the "approve" verb, for the resource "signer", at the Cluster scope, for the
@@ -188,15 +234,16 @@ func (a *approval) reviewRequest(ctx context.Context, req *admissionv1.Admission
 }
 
 // isApprovalRequest will return true if the request is given a new approved or
-// denied condition. This check is strictly concerned with these conditions
-// being _added_. We do this to reduce the number of SAR calls made, since
-// removal or changing of these conditions will be rejected elsewhere in the
-// validation chain locally.
+// denied condition, or records new approvals. This check is strictly
+// concerned with these conditions and approvals being _added_. We do this to
+// reduce the number of SAR calls made, since removal or changing of these
+// will be rejected elsewhere in the validation chain locally.
 func isApprovalRequest(oldCR, newCR *internalcmapi.CertificateRequest) bool {
-	oldCRApproving := util.GetCertificateRequestCondition(oldCR.Status.Conditions, internalcmapi.CertificateRequestConditionApproved)
-	newCRApproving := util.GetCertificateRequestCondition(newCR.Status.Conditions, internalcmapi.CertificateRequestConditionApproved)
+	if isApproving(oldCR, newCR) {
+		return true
+	}
 
-	if oldCRApproving == nil && newCRApproving != nil {
+	if len(newCR.Status.Approvals) > len(oldCR.Status.Approvals) {
 		return true
 	}
 
@@ -210,6 +257,15 @@ func isApprovalRequest(oldCR, newCR *internalcmapi.CertificateRequest) bool {
 	return false
 }
 
+// isApproving will return true if the request is given a new approved
+// condition.
+func isApproving(oldCR, newCR *internalcmapi.CertificateRequest) bool {
+	oldCRApproving := util.GetCertificateRequestCondition(oldCR.Status.Conditions, internalcmapi.CertificateRequestConditionApproved)
+	newCRApproving := util.GetCertificateRequestCondition(newCR.Status.Conditions, internalcmapi.CertificateRequestConditionApproved)
+
+	return oldCRApproving == nil && newCRApproving != nil
+}
+
 // signerResourceNames returns a slice of the signer resource names that this
 // signer can be represented as, given the request.
 func (a *approval) signerResourceNames(signer *signerResource) []string {
@@ -32,6 +32,8 @@ import (
 	kubefake "k8s.io/client-go/kubernetes/fake"
 	coretesting "k8s.io/client-go/testing"
 
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmfake "github.com/jetstack/cert-manager/pkg/client/clientset/versioned/fake"
 	internalcmapi "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
 	pluginsfake "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager/validation/plugins/fake"
 	internalcmmeta "github.com/jetstack/cert-manager/pkg/internal/apis/meta"
@@ -83,6 +85,50 @@ func TestValidate(t *testing.T) {
 		},
 	}
 
+	quorumIssuer := &cmapi.Issuer{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "ca-issuer"},
+		Spec: cmapi.IssuerSpec{
+			IssuerConfig: cmapi.IssuerConfig{CA: &cmapi.CAIssuer{SecretName: "ca"}},
+			Approval:     &cmapi.IssuerApproval{RequiredApprovals: 2},
+		},
+	}
+	quorumCR := func(usernames ...string) *internalcmapi.CertificateRequest {
+		cr := &internalcmapi.CertificateRequest{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test"},
+			Spec: internalcmapi.CertificateRequestSpec{
+				IssuerRef: internalcmmeta.ObjectReference{Name: "ca-issuer"},
+			},
+		}
+		for _, username := range usernames {
+			cr.Status.Approvals = append(cr.Status.Approvals, internalcmapi.CertificateRequestApproval{Username: username})
+		}
+		return cr
+	}
+	approvedQuorumCR := func(usernames ...string) *internalcmapi.CertificateRequest {
+		cr := quorumCR(usernames...)
+		cr.Status.Conditions = approvedCR.Status.Conditions
+		return cr
+	}
+	sarAllowed := func(t *testing.T) coretesting.ReactionFunc {
+		return func(action coretesting.Action) (bool, runtime.Object, error) {
+			return true, &authzv1.SubjectAccessReview{
+				Status: authzv1.SubjectAccessReviewStatus{
+					Allowed: true,
+				},
+			}, nil
+		}
+	}
+	updateRequest := &admissionv1.AdmissionRequest{
+		UserInfo: authnv1.UserInfo{
+			Username: "user-1",
+		},
+		Operation: admissionv1.Update,
+		RequestKind: &metav1.GroupVersionKind{
+			Group: "cert-manager.io",
+			Kind:  "CertificateRequest",
+		},
+	}
+
 	expNoSARReaction := func(t *testing.T) coretesting.ReactionFunc {
 		return func(_ coretesting.Action) (bool, runtime.Object, error) {
 			t.Fatal("unexpected call")
@@ -96,6 +142,7 @@ func TestValidate(t *testing.T) {
 
 		sarreaction    func(t *testing.T) coretesting.ReactionFunc
 		discoverclient func(t *testing.T) discovery.DiscoveryInterface
+		issuers        []runtime.Object
 
 		expErr *field.Error
 	}{
@@ -268,6 +315,34 @@ func TestValidate(t *testing.T) {
 			},
 			expErr: nil,
 		},
+		"if an approval is recorded for an issuer requiring a quorum, exit nil": {
+			req:            updateRequest,
+			oldCR:          quorumCR(),
+			newCR:          quorumCR("user-1"),
+			sarreaction:    sarAllowed,
+			discoverclient: expNoDiscovery,
+			issuers:        []runtime.Object{quorumIssuer},
+			expErr:         nil,
+		},
+		"if the request is approved before the issuer's quorum is reached, error": {
+			req:            updateRequest,
+			oldCR:          quorumCR("user-2"),
+			newCR:          approvedQuorumCR("user-2"),
+			sarreaction:    sarAllowed,
+			discoverclient: expNoDiscovery,
+			issuers:        []runtime.Object{quorumIssuer},
+			expErr: field.Forbidden(field.NewPath("status.conditions"),
+				"issuer {ca-issuer  } requires approval by 2 distinct users but the request has been approved by 1"),
+		},
+		"if the request is approved once the issuer's quorum is reached, exit nil": {
+			req:            updateRequest,
+			oldCR:          quorumCR("user-2"),
+			newCR:          approvedQuorumCR("user-2", "user-1"),
+			sarreaction:    sarAllowed,
+			discoverclient: expNoDiscovery,
+			issuers:        []runtime.Object{quorumIssuer},
+			expErr:         nil,
+		},
 	}
 
 	for name, test := range tests {
@@ -279,6 +354,7 @@ func TestValidate(t *testing.T) {
 				scheme:         webhook.Scheme,
 				sarclient:      client.AuthorizationV1().SubjectAccessReviews(),
 				discoverclient: test.discoverclient(t),
+				cmclient:       cmfake.NewSimpleClientset(test.issuers...),
 			}
 
 			err := a.Validate(context.TODO(), test.req, test.oldCR, test.newCR)
@@ -508,6 +584,15 @@ func TestIsApprovalRequest(t *testing.T) {
 			newCR: deniedCR,
 			expIs: false,
 		},
+		"if an approval is recorded, return true": {
+			oldCR: baseCR,
+			newCR: &internalcmapi.CertificateRequest{
+				Status: internalcmapi.CertificateRequestStatus{
+					Approvals: []internalcmapi.CertificateRequestApproval{{Username: "user-1"}},
+				},
+			},
+			expIs: true,
+		},
 	}
 
 	for name, test := range tests {
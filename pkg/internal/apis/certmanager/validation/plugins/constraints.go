@@ -0,0 +1,103 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmclient "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
+	internalcmapi "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+// constraints is responsible for rejecting Certificates that violate the
+// constraints of the issuer that they reference.
+type constraints struct {
+	scheme *runtime.Scheme
+
+	cmclient cmclient.Interface
+}
+
+func newConstraints(scheme *runtime.Scheme) *constraints {
+	return &constraints{
+		scheme: scheme,
+	}
+}
+
+func (c *constraints) Init(_ kubernetes.Interface, cmClient cmclient.Interface) {
+	c.cmclient = cmClient
+}
+
+// Validate will review whether a created or updated Certificate satisfies the
+// constraints of the issuer it references. Updates that do not change the
+// spec of the Certificate are not reviewed, so that Certificates created
+// before the constraints were set may still be updated.
+func (c *constraints) Validate(ctx context.Context, req *admissionv1.AdmissionRequest, oldObj, obj runtime.Object) *field.Error {
+	if req.Operation != admissionv1.Create && req.Operation != admissionv1.Update {
+		return nil
+	}
+
+	// Only Validate over Certificate resources
+	if req.RequestKind.Group != certmanager.GroupName || req.RequestKind.Kind != cmapi.CertificateKind {
+		return nil
+	}
+
+	fldPath := field.NewPath("spec")
+
+	// Error if the client is not initialised
+	if c.cmclient == nil {
+		return field.InternalError(fldPath, errors.New("constraints validation not initialised"))
+	}
+
+	crt := obj.(*internalcmapi.Certificate)
+	if req.Operation == admissionv1.Update && apiequality.Semantic.DeepEqual(oldObj.(*internalcmapi.Certificate).Spec, crt.Spec) {
+		return nil
+	}
+
+	spec, err := issuerSpec(ctx, c.cmclient, crt.Namespace, crt.Spec.IssuerRef)
+	if err != nil {
+		return field.InternalError(fldPath, err)
+	}
+	if spec == nil || spec.Constraints == nil {
+		return nil
+	}
+
+	v1crt := new(cmapi.Certificate)
+	if err := c.scheme.Convert(crt, v1crt, nil); err != nil {
+		return field.InternalError(fldPath, err)
+	}
+
+	// Every private key profile of the Certificate is issued by the same
+	// issuer, so each must satisfy its constraints.
+	for _, request := range pki.ConstrainedRequestsForCertificate(v1crt) {
+		if err := pki.CheckIssuerConstraints(spec.Constraints, request); err != nil {
+			return field.Forbidden(fldPath, fmt.Sprintf("certificate violates the constraints of issuer %v: %v", crt.Spec.IssuerRef, err))
+		}
+	}
+
+	return nil
+}
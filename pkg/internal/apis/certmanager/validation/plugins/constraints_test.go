@@ -0,0 +1,143 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmfake "github.com/jetstack/cert-manager/pkg/client/clientset/versioned/fake"
+	internalcmapi "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
+	internalcmmeta "github.com/jetstack/cert-manager/pkg/internal/apis/meta"
+	"github.com/jetstack/cert-manager/pkg/webhook"
+)
+
+func TestConstraintsValidate(t *testing.T) {
+	constrainedIssuer := &cmapi.Issuer{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "ca-issuer"},
+		Spec: cmapi.IssuerSpec{
+			IssuerConfig: cmapi.IssuerConfig{CA: &cmapi.CAIssuer{SecretName: "ca"}},
+			Constraints: &cmapi.IssuerConstraints{
+				MaxDuration:        &metav1.Duration{Duration: time.Hour * 24 * 90},
+				AllowedDomains:     []string{"example.com"},
+				AllowedPrivateKeys: []cmapi.IssuerPrivateKeyConstraint{{Algorithm: cmapi.RSAKeyAlgorithm, MinSize: 2048}},
+			},
+		},
+	}
+	certificate := func(issuerName string, dnsNames ...string) *internalcmapi.Certificate {
+		return &internalcmapi.Certificate{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test"},
+			Spec: internalcmapi.CertificateSpec{
+				SecretName: "test",
+				DNSNames:   dnsNames,
+				IssuerRef:  internalcmmeta.ObjectReference{Name: issuerName},
+			},
+		}
+	}
+	request := func(op admissionv1.Operation, kind string) *admissionv1.AdmissionRequest {
+		return &admissionv1.AdmissionRequest{
+			Operation: op,
+			RequestKind: &metav1.GroupVersionKind{
+				Group: "cert-manager.io",
+				Kind:  kind,
+			},
+		}
+	}
+	caCertificate := certificate("ca-issuer", "example.com")
+	caCertificate.Spec.IsCA = true
+	commonNameCertificate := certificate("ca-issuer")
+	commonNameCertificate.Spec.CommonName = "foo.org"
+	profileCertificate := certificate("ca-issuer", "example.com")
+	profileCertificate.Spec.PrivateKeyProfiles = []internalcmapi.CertificatePrivateKeyProfile{
+		{Name: "ecdsa", PrivateKey: internalcmapi.CertificatePrivateKey{Algorithm: internalcmapi.ECDSAKeyAlgorithm}},
+	}
+
+	tests := map[string]struct {
+		req    *admissionv1.AdmissionRequest
+		oldCrt *internalcmapi.Certificate
+		crt    *internalcmapi.Certificate
+		expErr *field.Error
+	}{
+		"if the request is not for a Certificate, exit nil": {
+			req: request(admissionv1.Create, "CertificateRequest"),
+			crt: certificate("ca-issuer", "foo.org"),
+		},
+		"if the issuer has no constraints, exit nil": {
+			req: request(admissionv1.Create, "Certificate"),
+			crt: certificate("other-issuer", "foo.org"),
+		},
+		"if the Certificate satisfies the constraints, exit nil": {
+			req: request(admissionv1.Create, "Certificate"),
+			crt: certificate("ca-issuer", "example.com", "www.example.com"),
+		},
+		"if the Certificate violates the constraints, error": {
+			req: request(admissionv1.Create, "Certificate"),
+			crt: caCertificate,
+			expErr: field.Forbidden(field.NewPath("spec"),
+				"certificate violates the constraints of issuer {ca-issuer  }: CA certificates are not allowed"),
+		},
+		"if an update violates the constraints, error": {
+			req:    request(admissionv1.Update, "Certificate"),
+			oldCrt: certificate("ca-issuer", "example.com"),
+			crt:    certificate("ca-issuer", "foo.org"),
+			expErr: field.Forbidden(field.NewPath("spec"),
+				`certificate violates the constraints of issuer {ca-issuer  }: DNS name "foo.org" is not within the allowed domains`),
+		},
+		"if the Certificate's common name violates the constraints, error": {
+			req: request(admissionv1.Create, "Certificate"),
+			crt: commonNameCertificate,
+			expErr: field.Forbidden(field.NewPath("spec"),
+				`certificate violates the constraints of issuer {ca-issuer  }: common name "foo.org" is not within the allowed domains`),
+		},
+		"if a private key profile violates the constraints, error": {
+			req: request(admissionv1.Create, "Certificate"),
+			crt: profileCertificate,
+			expErr: field.Forbidden(field.NewPath("spec"),
+				"certificate violates the constraints of issuer {ca-issuer  }: ECDSA private key of size 256 is not allowed"),
+		},
+		"if an update does not change the spec, exit nil": {
+			req:    request(admissionv1.Update, "Certificate"),
+			oldCrt: certificate("ca-issuer", "foo.org"),
+			crt:    certificate("ca-issuer", "foo.org"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := newConstraints(webhook.Scheme)
+			c.cmclient = cmfake.NewSimpleClientset(constrainedIssuer,
+				&cmapi.Issuer{ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "other-issuer"}})
+
+			var oldObj runtime.Object
+			if test.oldCrt != nil {
+				oldObj = test.oldCrt
+			}
+			err := c.Validate(context.TODO(), test.req, oldObj, test.crt)
+			if !reflect.DeepEqual(test.expErr, err) {
+				t.Errorf("unexpected error, exp=%#+v got=%#+v", test.expErr, err)
+			}
+		})
+	}
+}
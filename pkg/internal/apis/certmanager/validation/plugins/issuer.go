@@ -0,0 +1,63 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmclient "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
+	internalcmmeta "github.com/jetstack/cert-manager/pkg/internal/apis/meta"
+)
+
+// issuerSpec returns the spec of the cert-manager Issuer or ClusterIssuer
+// referenced by a resource in the given namespace. nil is returned if the
+// reference is not to a cert-manager issuer, or if the issuer does not exist.
+func issuerSpec(ctx context.Context, client cmclient.Interface, namespace string, ref internalcmmeta.ObjectReference) (*cmapi.IssuerSpec, error) {
+	if len(ref.Group) > 0 && ref.Group != certmanager.GroupName {
+		return nil, nil
+	}
+
+	switch ref.Kind {
+	case "", cmapi.IssuerKind:
+		iss, err := client.CertmanagerV1().Issuers(namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return &iss.Spec, nil
+
+	case cmapi.ClusterIssuerKind:
+		iss, err := client.CertmanagerV1().ClusterIssuers().Get(ctx, ref.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return &iss.Spec, nil
+
+	default:
+		return nil, nil
+	}
+}
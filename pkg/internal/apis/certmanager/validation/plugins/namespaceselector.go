@@ -0,0 +1,123 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/kubernetes"
+
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmclient "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
+	internalcmapi "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
+	internalcmmeta "github.com/jetstack/cert-manager/pkg/internal/apis/meta"
+)
+
+// namespaceSelector is responsible for rejecting Certificates and
+// CertificateRequests that reference a ClusterIssuer whose namespaceSelector
+// does not select the namespace of the resource.
+type namespaceSelector struct {
+	client   kubernetes.Interface
+	cmclient cmclient.Interface
+}
+
+func newNamespaceSelector() *namespaceSelector {
+	return new(namespaceSelector)
+}
+
+func (n *namespaceSelector) Init(client kubernetes.Interface, cmClient cmclient.Interface) {
+	n.client = client
+	n.cmclient = cmClient
+}
+
+// Validate will review whether a Certificate or CertificateRequest that is
+// created, or whose issuerRef is updated, references a ClusterIssuer that may
+// be used from the resource's namespace.
+func (n *namespaceSelector) Validate(ctx context.Context, req *admissionv1.AdmissionRequest, oldObj, obj runtime.Object) *field.Error {
+	if req.Operation != admissionv1.Create && req.Operation != admissionv1.Update {
+		return nil
+	}
+
+	if req.RequestKind.Group != certmanager.GroupName {
+		return nil
+	}
+
+	fldPath := field.NewPath("spec", "issuerRef")
+
+	var namespace string
+	var ref, oldRef internalcmmeta.ObjectReference
+	switch req.RequestKind.Kind {
+	case cmapi.CertificateKind:
+		crt := obj.(*internalcmapi.Certificate)
+		namespace, ref = crt.Namespace, crt.Spec.IssuerRef
+		if oldObj != nil {
+			oldRef = oldObj.(*internalcmapi.Certificate).Spec.IssuerRef
+		}
+	case cmapi.CertificateRequestKind:
+		cr := obj.(*internalcmapi.CertificateRequest)
+		namespace, ref = cr.Namespace, cr.Spec.IssuerRef
+		if oldObj != nil {
+			oldRef = oldObj.(*internalcmapi.CertificateRequest).Spec.IssuerRef
+		}
+	default:
+		return nil
+	}
+
+	if req.Operation == admissionv1.Update && ref == oldRef {
+		return nil
+	}
+
+	if ref.Kind != cmapi.ClusterIssuerKind {
+		return nil
+	}
+
+	// Error if the clients are not initialised
+	if n.client == nil || n.cmclient == nil {
+		return field.InternalError(fldPath, errors.New("namespaceSelector validation not initialised"))
+	}
+
+	spec, err := issuerSpec(ctx, n.cmclient, namespace, ref)
+	if err != nil {
+		return field.InternalError(fldPath, err)
+	}
+	if spec == nil || spec.NamespaceSelector == nil {
+		return nil
+	}
+
+	ns, err := n.client.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+	if err != nil {
+		return field.InternalError(fldPath, err)
+	}
+
+	ok, err := apiutil.IssuerSelectsNamespace(spec, ns.Labels)
+	if err != nil {
+		return field.InternalError(fldPath, err)
+	}
+	if !ok {
+		return field.Forbidden(fldPath, fmt.Sprintf("ClusterIssuer %q may not be used from namespace %q", ref.Name, namespace))
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/kubernetes/fake"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmfake "github.com/jetstack/cert-manager/pkg/client/clientset/versioned/fake"
+	internalcmapi "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
+	internalcmmeta "github.com/jetstack/cert-manager/pkg/internal/apis/meta"
+)
+
+func TestNamespaceSelectorValidate(t *testing.T) {
+	prodIssuer := &cmapi.ClusterIssuer{
+		ObjectMeta: metav1.ObjectMeta{Name: "prod"},
+		Spec: cmapi.IssuerSpec{
+			IssuerConfig: cmapi.IssuerConfig{CA: &cmapi.CAIssuer{SecretName: "ca"}},
+			NamespaceSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"env": "prod"},
+			},
+		},
+	}
+	openIssuer := &cmapi.ClusterIssuer{
+		ObjectMeta: metav1.ObjectMeta{Name: "open"},
+		Spec: cmapi.IssuerSpec{
+			IssuerConfig: cmapi.IssuerConfig{CA: &cmapi.CAIssuer{SecretName: "ca"}},
+		},
+	}
+	prodNamespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "prod-ns", Labels: map[string]string{"env": "prod"}},
+	}
+	sandboxNamespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "sandbox-ns", Labels: map[string]string{"env": "sandbox"}},
+	}
+
+	certificate := func(namespace, issuerName string) *internalcmapi.Certificate {
+		return &internalcmapi.Certificate{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "test"},
+			Spec: internalcmapi.CertificateSpec{
+				SecretName: "test",
+				IssuerRef:  internalcmmeta.ObjectReference{Name: issuerName, Kind: "ClusterIssuer"},
+			},
+		}
+	}
+	certificateRequest := func(namespace, issuerName string) *internalcmapi.CertificateRequest {
+		return &internalcmapi.CertificateRequest{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "test"},
+			Spec: internalcmapi.CertificateRequestSpec{
+				IssuerRef: internalcmmeta.ObjectReference{Name: issuerName, Kind: "ClusterIssuer"},
+			},
+		}
+	}
+	request := func(op admissionv1.Operation, kind string) *admissionv1.AdmissionRequest {
+		return &admissionv1.AdmissionRequest{
+			Operation: op,
+			RequestKind: &metav1.GroupVersionKind{
+				Group: "cert-manager.io",
+				Kind:  kind,
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		req    *admissionv1.AdmissionRequest
+		oldObj runtime.Object
+		obj    runtime.Object
+		expErr *field.Error
+	}{
+		"if the request is not for a Certificate or CertificateRequest, exit nil": {
+			req: request(admissionv1.Create, "Issuer"),
+			obj: &internalcmapi.Issuer{},
+		},
+		"if the ClusterIssuer has no namespaceSelector, exit nil": {
+			req: request(admissionv1.Create, "Certificate"),
+			obj: certificate("sandbox-ns", "open"),
+		},
+		"if the ClusterIssuer does not exist, exit nil": {
+			req: request(admissionv1.Create, "Certificate"),
+			obj: certificate("sandbox-ns", "does-not-exist"),
+		},
+		"if the namespace is selected by the ClusterIssuer, exit nil": {
+			req: request(admissionv1.Create, "Certificate"),
+			obj: certificate("prod-ns", "prod"),
+		},
+		"if a Certificate's namespace is not selected by the ClusterIssuer, error": {
+			req:    request(admissionv1.Create, "Certificate"),
+			obj:    certificate("sandbox-ns", "prod"),
+			expErr: field.Forbidden(field.NewPath("spec", "issuerRef"), `ClusterIssuer "prod" may not be used from namespace "sandbox-ns"`),
+		},
+		"if a CertificateRequest's namespace is not selected by the ClusterIssuer, error": {
+			req:    request(admissionv1.Create, "CertificateRequest"),
+			obj:    certificateRequest("sandbox-ns", "prod"),
+			expErr: field.Forbidden(field.NewPath("spec", "issuerRef"), `ClusterIssuer "prod" may not be used from namespace "sandbox-ns"`),
+		},
+		"if an update changes the issuerRef to an unselected ClusterIssuer, error": {
+			req:    request(admissionv1.Update, "Certificate"),
+			oldObj: certificate("sandbox-ns", "open"),
+			obj:    certificate("sandbox-ns", "prod"),
+			expErr: field.Forbidden(field.NewPath("spec", "issuerRef"), `ClusterIssuer "prod" may not be used from namespace "sandbox-ns"`),
+		},
+		"if an update does not change the issuerRef, exit nil": {
+			req:    request(admissionv1.Update, "Certificate"),
+			oldObj: certificate("sandbox-ns", "prod"),
+			obj:    certificate("sandbox-ns", "prod"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			n := newNamespaceSelector()
+			n.Init(fake.NewSimpleClientset(prodNamespace, sandboxNamespace), cmfake.NewSimpleClientset(prodIssuer, openIssuer))
+
+			err := n.Validate(context.TODO(), test.req, test.oldObj, test.obj)
+			if !reflect.DeepEqual(test.expErr, err) {
+				t.Errorf("unexpected error, exp=%#+v got=%#+v", test.expErr, err)
+			}
+		})
+	}
+}
@@ -23,16 +23,21 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/kubernetes"
+
+	cmclient "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
 )
 
 // Plugin is an admission plugin that will run during admission webhook events.
 type Plugin interface {
-	Init(client kubernetes.Interface)
+	Init(client kubernetes.Interface, cmClient cmclient.Interface)
 	Validate(ctx context.Context, admissionSpec *admissionv1.AdmissionRequest, oldObj, obj runtime.Object) *field.Error
 }
 
 func All(scheme *runtime.Scheme) []Plugin {
 	return []Plugin{
 		newApproval(scheme),
+		newConstraints(scheme),
+		newNamespaceSelector(),
+		newSecretConflict(),
 	}
 }
@@ -0,0 +1,89 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/jetstack/cert-manager/pkg/apis/certmanager"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmclient "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
+	internalcmapi "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
+)
+
+// secretConflict is responsible for rejecting Certificates whose
+// spec.secretName is already the spec.secretName of another Certificate in
+// the same namespace. Such Certificates would overwrite each other's Secret
+// forever.
+type secretConflict struct {
+	cmclient cmclient.Interface
+}
+
+func newSecretConflict() *secretConflict {
+	return new(secretConflict)
+}
+
+func (s *secretConflict) Init(_ kubernetes.Interface, cmClient cmclient.Interface) {
+	s.cmclient = cmClient
+}
+
+// Validate will review whether a created Certificate, or an updated
+// Certificate whose secretName has changed, targets a Secret that is already
+// the target of another Certificate.
+func (s *secretConflict) Validate(ctx context.Context, req *admissionv1.AdmissionRequest, oldObj, obj runtime.Object) *field.Error {
+	if req.Operation != admissionv1.Create && req.Operation != admissionv1.Update {
+		return nil
+	}
+
+	// Only Validate over Certificate resources
+	if req.RequestKind.Group != certmanager.GroupName || req.RequestKind.Kind != cmapi.CertificateKind {
+		return nil
+	}
+
+	fldPath := field.NewPath("spec", "secretName")
+
+	crt := obj.(*internalcmapi.Certificate)
+	if req.Operation == admissionv1.Update && oldObj.(*internalcmapi.Certificate).Spec.SecretName == crt.Spec.SecretName {
+		return nil
+	}
+
+	// Error if the client is not initialised
+	if s.cmclient == nil {
+		return field.InternalError(fldPath, errors.New("secretName conflict validation not initialised"))
+	}
+
+	crts, err := s.cmclient.CertmanagerV1().Certificates(crt.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return field.InternalError(fldPath, err)
+	}
+
+	for _, other := range crts.Items {
+		if other.Name != crt.Name && other.Spec.SecretName == crt.Spec.SecretName {
+			return field.Invalid(fldPath, crt.Spec.SecretName, fmt.Sprintf("is already the secretName of Certificate %q", other.Name))
+		}
+	}
+
+	return nil
+}
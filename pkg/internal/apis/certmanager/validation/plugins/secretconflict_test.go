@@ -0,0 +1,106 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmfake "github.com/jetstack/cert-manager/pkg/client/clientset/versioned/fake"
+	internalcmapi "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
+)
+
+func TestSecretConflictValidate(t *testing.T) {
+	existing := &cmapi.Certificate{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "existing"},
+		Spec:       cmapi.CertificateSpec{SecretName: "tls"},
+	}
+	certificate := func(namespace, name, secretName string) *internalcmapi.Certificate {
+		return &internalcmapi.Certificate{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec:       internalcmapi.CertificateSpec{SecretName: secretName},
+		}
+	}
+	request := func(op admissionv1.Operation, kind string) *admissionv1.AdmissionRequest {
+		return &admissionv1.AdmissionRequest{
+			Operation: op,
+			RequestKind: &metav1.GroupVersionKind{
+				Group: "cert-manager.io",
+				Kind:  kind,
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		req    *admissionv1.AdmissionRequest
+		oldCrt *internalcmapi.Certificate
+		crt    *internalcmapi.Certificate
+		expErr *field.Error
+	}{
+		"if the request is not for a Certificate, exit nil": {
+			req: request(admissionv1.Create, "CertificateRequest"),
+			crt: certificate("testns", "new", "tls"),
+		},
+		"if no other Certificate uses the secretName, exit nil": {
+			req: request(admissionv1.Create, "Certificate"),
+			crt: certificate("testns", "new", "other-tls"),
+		},
+		"if a Certificate in another namespace uses the secretName, exit nil": {
+			req: request(admissionv1.Create, "Certificate"),
+			crt: certificate("otherns", "new", "tls"),
+		},
+		"if another Certificate uses the secretName, error": {
+			req:    request(admissionv1.Create, "Certificate"),
+			crt:    certificate("testns", "new", "tls"),
+			expErr: field.Invalid(field.NewPath("spec", "secretName"), "tls", `is already the secretName of Certificate "existing"`),
+		},
+		"if an update changes the secretName to one already in use, error": {
+			req:    request(admissionv1.Update, "Certificate"),
+			oldCrt: certificate("testns", "new", "other-tls"),
+			crt:    certificate("testns", "new", "tls"),
+			expErr: field.Invalid(field.NewPath("spec", "secretName"), "tls", `is already the secretName of Certificate "existing"`),
+		},
+		"if an update does not change the secretName, exit nil": {
+			req:    request(admissionv1.Update, "Certificate"),
+			oldCrt: certificate("testns", "existing", "tls"),
+			crt:    certificate("testns", "existing", "tls"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := newSecretConflict()
+			s.Init(nil, cmfake.NewSimpleClientset(existing))
+
+			var oldObj runtime.Object
+			if test.oldCrt != nil {
+				oldObj = test.oldCrt
+			}
+			err := s.Validate(context.TODO(), test.req, oldObj, test.crt)
+			if !reflect.DeepEqual(test.expErr, err) {
+				t.Errorf("unexpected error, exp=%#+v got=%#+v", test.expErr, err)
+			}
+		})
+	}
+}
@@ -36,6 +36,13 @@ func AddToValidationRegistry(reg *validation.Registry) error {
 		return err
 	}
 
+	if err := reg.AddValidateFunc(&cmapi.CertificateRequestPolicy{}, ValidateCertificateRequestPolicy); err != nil {
+		return err
+	}
+	if err := reg.AddValidateUpdateFunc(&cmapi.CertificateRequestPolicy{}, ValidateUpdateCertificateRequestPolicy); err != nil {
+		return err
+	}
+
 	if err := reg.AddValidateFunc(&cmapi.ClusterIssuer{}, ValidateClusterIssuer); err != nil {
 		return err
 	}
@@ -0,0 +1,205 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trust
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmmeta "github.com/jetstack/cert-manager/pkg/internal/apis/meta"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// A Bundle distributes a bundle of trusted CA certificates to a ConfigMap in
+// each of the namespaces that it targets.
+//
+// The certificates of all of the Bundle's sources are concatenated and
+// de-duplicated, and written to a ConfigMap with the same name as the Bundle.
+// ConfigMaps that already exist and were not written for the Bundle are
+// never overwritten.
+type Bundle struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	// Desired state of the Bundle resource.
+	Spec BundleSpec
+
+	// Status of the Bundle. This is set and managed automatically.
+	Status BundleStatus
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BundleList is a list of Bundles
+type BundleList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+
+	Items []Bundle
+}
+
+const (
+	// BundleDefaultKey is the default key of the entries that PEM encoded
+	// certificates are read from and written to.
+	BundleDefaultKey = "ca.crt"
+
+	// BundleDefaultJKSKey is the default key of the ConfigMap entry that a
+	// JKS truststore is written to.
+	BundleDefaultJKSKey = "truststore.jks"
+
+	// BundleDefaultPKCS12Key is the default key of the ConfigMap entry that a
+	// PKCS#12 truststore is written to.
+	BundleDefaultPKCS12Key = "truststore.p12"
+
+	// BundleDefaultTruststorePassword is the default password of JKS and
+	// PKCS#12 truststores.
+	BundleDefaultTruststorePassword = "changeit"
+)
+
+// BundleSpec describes where the certificates of a Bundle are read from, and
+// where they are written to.
+type BundleSpec struct {
+	// Sources of the CA certificates in the bundle.
+	Sources []BundleSource
+
+	// Target describes how the bundle is written to each namespace.
+	Target BundleTarget
+}
+
+// BundleSource is a source of PEM encoded CA certificates. Exactly one of its
+// fields must be set.
+type BundleSource struct {
+	// Secret is a key of a Secret containing PEM encoded certificates, such as
+	// the Secret of a CA Issuer.
+	Secret *BundleSourceObjectKeySelector
+
+	// ConfigMap is a key of a ConfigMap containing PEM encoded certificates.
+	ConfigMap *BundleSourceObjectKeySelector
+
+	// InlinePEM is a string of PEM encoded certificates.
+	InlinePEM string
+
+	// Issuer is a CA Issuer or ClusterIssuer, whose root CA certificate is
+	// added to the bundle.
+	Issuer *BundleSourceIssuerRef
+}
+
+// BundleSourceObjectKeySelector selects a key of a namespaced resource.
+type BundleSourceObjectKeySelector struct {
+	// Namespace of the resource.
+	Namespace string
+
+	// Name of the resource.
+	Name string
+
+	// Key of the entry in the resource's data to read certificates from.
+	// Defaults to 'ca.crt'.
+	Key string
+}
+
+// BundleSourceIssuerRef is a reference to a cert-manager Issuer or
+// ClusterIssuer.
+type BundleSourceIssuerRef struct {
+	// Name of the issuer.
+	Name string
+
+	// Kind of the issuer, either 'Issuer' or 'ClusterIssuer'.
+	// Defaults to 'Issuer'.
+	Kind string
+
+	// Namespace of the Issuer. Must be set for Issuers, and must not be set for
+	// ClusterIssuers.
+	Namespace string
+}
+
+// BundleTarget describes the ConfigMap that a Bundle is written to in each
+// namespace.
+type BundleTarget struct {
+	// Key of the ConfigMap entry that the PEM encoded bundle is written to.
+	// Defaults to 'ca.crt'.
+	Key string
+
+	// NamespaceSelector selects the namespaces that the bundle is written to.
+	// If not set, the bundle is written to all namespaces.
+	NamespaceSelector *metav1.LabelSelector
+
+	// JKS, if set, also writes the bundle to the ConfigMap as a JKS
+	// truststore.
+	JKS *BundleTargetKeystore
+
+	// PKCS12, if set, also writes the bundle to the ConfigMap as a PKCS#12
+	// truststore.
+	PKCS12 *BundleTargetKeystore
+}
+
+// BundleTargetKeystore describes a truststore entry of a Bundle's ConfigMap.
+type BundleTargetKeystore struct {
+	// Key of the ConfigMap binary data entry that the truststore is written
+	// to. Defaults to 'truststore.jks' for JKS truststores and
+	// 'truststore.p12' for PKCS#12 truststores.
+	Key string
+
+	// PasswordSecretRef is a reference to a key in a Secret resource in the
+	// cluster resource namespace containing the password used to protect the
+	// integrity of the truststore. Defaults to the password 'changeit' if not
+	// set.
+	PasswordSecretRef *cmmeta.SecretKeySelector
+}
+
+// BundleStatus defines the observed state of a Bundle.
+type BundleStatus struct {
+	// List of status conditions to indicate the status of a Bundle.
+	// Known condition types are `Ready`.
+	Conditions []BundleCondition
+}
+
+// BundleCondition contains condition information for a Bundle.
+type BundleCondition struct {
+	// Type of the condition, known values are (`Ready`).
+	Type BundleConditionType
+
+	// Status of the condition, one of (`True`, `False`, `Unknown`).
+	Status cmmeta.ConditionStatus
+
+	// LastTransitionTime is the timestamp corresponding to the last status
+	// change of this condition.
+	LastTransitionTime *metav1.Time
+
+	// Reason is a brief machine readable explanation for the condition's last
+	// transition.
+	Reason string
+
+	// Message is a human readable description of the details of the last
+	// transition, complementing reason.
+	Message string
+
+	// If set, this represents the .metadata.generation that the condition was
+	// set based upon.
+	// For instance, if .metadata.generation is currently 12, but the
+	// .status.condition[x].observedGeneration is 9, the condition is out of date
+	// with respect to the current state of the Bundle.
+	ObservedGeneration int64
+}
+
+// BundleConditionType represents a Bundle condition value.
+type BundleConditionType string
+
+const (
+	// BundleConditionReady indicates that the bundle has been built from all
+	// of its sources and written to all of the namespaces that it targets.
+	BundleConditionReady BundleConditionType = "Ready"
+)
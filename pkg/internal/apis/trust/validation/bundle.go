@@ -0,0 +1,165 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/jetstack/cert-manager/pkg/internal/api/validation"
+	internalcmapi "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
+	cmvalidation "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager/validation"
+	trustapi "github.com/jetstack/cert-manager/pkg/internal/apis/trust"
+	"github.com/jetstack/cert-manager/pkg/util/pki"
+)
+
+// Validation functions for trust Bundle types.
+
+func ValidateBundle(a *admissionv1.AdmissionRequest, obj runtime.Object) (field.ErrorList, validation.WarningList) {
+	bundle := obj.(*trustapi.Bundle)
+	return ValidateBundleSpec(&bundle.Spec, field.NewPath("spec")), nil
+}
+
+func ValidateUpdateBundle(a *admissionv1.AdmissionRequest, oldObj, obj runtime.Object) (field.ErrorList, validation.WarningList) {
+	bundle := obj.(*trustapi.Bundle)
+	return ValidateBundleSpec(&bundle.Spec, field.NewPath("spec")), nil
+}
+
+func ValidateBundleSpec(spec *trustapi.BundleSpec, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	if len(spec.Sources) == 0 {
+		el = append(el, field.Required(fldPath.Child("sources"), "at least one source must be specified"))
+	}
+	for i, source := range spec.Sources {
+		el = append(el, validateBundleSource(source, fldPath.Child("sources").Index(i))...)
+	}
+
+	el = append(el, validateBundleTarget(spec.Target, fldPath.Child("target"))...)
+
+	return el
+}
+
+func validateBundleSource(source trustapi.BundleSource, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	numSources := 0
+	if source.Secret != nil {
+		numSources++
+		el = append(el, validateBundleSourceObjectKeySelector(source.Secret, fldPath.Child("secret"))...)
+	}
+	if source.ConfigMap != nil {
+		numSources++
+		el = append(el, validateBundleSourceObjectKeySelector(source.ConfigMap, fldPath.Child("configMap"))...)
+	}
+	if len(source.InlinePEM) > 0 {
+		numSources++
+		certs, err := pki.DecodeX509CertificateChainBytes([]byte(source.InlinePEM))
+		if err != nil {
+			el = append(el, field.Invalid(fldPath.Child("inlinePEM"), "<inline PEM>", err.Error()))
+		} else if len(certs) == 0 {
+			el = append(el, field.Invalid(fldPath.Child("inlinePEM"), "<inline PEM>", "no certificates found"))
+		}
+	}
+	if source.Issuer != nil {
+		numSources++
+		el = append(el, validateBundleSourceIssuerRef(source.Issuer, fldPath.Child("issuer"))...)
+	}
+
+	if numSources != 1 {
+		el = append(el, field.Invalid(fldPath, "", "exactly one of secret, configMap, inlinePEM or issuer must be specified"))
+	}
+
+	return el
+}
+
+func validateBundleSourceObjectKeySelector(sel *trustapi.BundleSourceObjectKeySelector, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if len(sel.Namespace) == 0 {
+		el = append(el, field.Required(fldPath.Child("namespace"), "must be specified"))
+	}
+	if len(sel.Name) == 0 {
+		el = append(el, field.Required(fldPath.Child("name"), "must be specified"))
+	}
+	return el
+}
+
+func validateBundleSourceIssuerRef(ref *trustapi.BundleSourceIssuerRef, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if len(ref.Name) == 0 {
+		el = append(el, field.Required(fldPath.Child("name"), "must be specified"))
+	}
+	switch ref.Kind {
+	case "", internalcmapi.IssuerKind:
+		if len(ref.Namespace) == 0 {
+			el = append(el, field.Required(fldPath.Child("namespace"), "must be specified for Issuers"))
+		}
+	case internalcmapi.ClusterIssuerKind:
+		if len(ref.Namespace) > 0 {
+			el = append(el, field.Forbidden(fldPath.Child("namespace"), "must not be specified for ClusterIssuers"))
+		}
+	default:
+		el = append(el, field.NotSupported(fldPath.Child("kind"), ref.Kind, []string{internalcmapi.IssuerKind, internalcmapi.ClusterIssuerKind}))
+	}
+	return el
+}
+
+func validateBundleTarget(target trustapi.BundleTarget, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	if target.NamespaceSelector != nil {
+		el = append(el, metav1validation.ValidateLabelSelector(target.NamespaceSelector, fldPath.Child("namespaceSelector"))...)
+	}
+
+	keys := map[string]bool{bundleTargetKey(target): true}
+	if target.JKS != nil {
+		key := target.JKS.Key
+		if len(key) == 0 {
+			key = trustapi.BundleDefaultJKSKey
+		}
+		if keys[key] {
+			el = append(el, field.Duplicate(fldPath.Child("jks", "key"), key))
+		}
+		keys[key] = true
+		if target.JKS.PasswordSecretRef != nil {
+			el = append(el, cmvalidation.ValidateSecretKeySelector(target.JKS.PasswordSecretRef, fldPath.Child("jks", "passwordSecretRef"))...)
+		}
+	}
+	if target.PKCS12 != nil {
+		key := target.PKCS12.Key
+		if len(key) == 0 {
+			key = trustapi.BundleDefaultPKCS12Key
+		}
+		if keys[key] {
+			el = append(el, field.Duplicate(fldPath.Child("pkcs12", "key"), key))
+		}
+		if target.PKCS12.PasswordSecretRef != nil {
+			el = append(el, cmvalidation.ValidateSecretKeySelector(target.PKCS12.PasswordSecretRef, fldPath.Child("pkcs12", "passwordSecretRef"))...)
+		}
+	}
+
+	return el
+}
+
+func bundleTargetKey(target trustapi.BundleTarget) string {
+	if len(target.Key) > 0 {
+		return target.Key
+	}
+	return trustapi.BundleDefaultKey
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	cmapi "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager"
+	cmmeta "github.com/jetstack/cert-manager/pkg/internal/apis/meta"
+	trustapi "github.com/jetstack/cert-manager/pkg/internal/apis/trust"
+)
+
+func TestValidateBundleSpec(t *testing.T) {
+	fldPath := field.NewPath("spec")
+	scenarios := map[string]struct {
+		spec trustapi.BundleSpec
+		errs field.ErrorList
+	}{
+		"valid bundle": {
+			spec: trustapi.BundleSpec{
+				Sources: []trustapi.BundleSource{
+					{Secret: &trustapi.BundleSourceObjectKeySelector{Namespace: "cert-manager", Name: "root-ca"}},
+					{ConfigMap: &trustapi.BundleSourceObjectKeySelector{Namespace: "default", Name: "partner-ca", Key: "ca.pem"}},
+					{Issuer: &trustapi.BundleSourceIssuerRef{Name: "internal-ca", Kind: cmapi.ClusterIssuerKind}},
+					{Issuer: &trustapi.BundleSourceIssuerRef{Name: "team-ca", Namespace: "team"}},
+				},
+				Target: trustapi.BundleTarget{
+					NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"trust": "enabled"}},
+					JKS:               &trustapi.BundleTargetKeystore{},
+					PKCS12: &trustapi.BundleTargetKeystore{
+						PasswordSecretRef: &cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "truststore"}, Key: "password"},
+					},
+				},
+			},
+			errs: field.ErrorList{},
+		},
+		"no sources": {
+			spec: trustapi.BundleSpec{},
+			errs: field.ErrorList{
+				field.Required(fldPath.Child("sources"), "at least one source must be specified"),
+			},
+		},
+		"source with none or multiple fields set": {
+			spec: trustapi.BundleSpec{
+				Sources: []trustapi.BundleSource{
+					{},
+					{
+						Secret:    &trustapi.BundleSourceObjectKeySelector{Namespace: "cert-manager", Name: "root-ca"},
+						ConfigMap: &trustapi.BundleSourceObjectKeySelector{Namespace: "cert-manager", Name: "root-ca"},
+					},
+				},
+			},
+			errs: field.ErrorList{
+				field.Invalid(fldPath.Child("sources").Index(0), "", "exactly one of secret, configMap, inlinePEM or issuer must be specified"),
+				field.Invalid(fldPath.Child("sources").Index(1), "", "exactly one of secret, configMap, inlinePEM or issuer must be specified"),
+			},
+		},
+		"invalid sources": {
+			spec: trustapi.BundleSpec{
+				Sources: []trustapi.BundleSource{
+					{Secret: &trustapi.BundleSourceObjectKeySelector{}},
+					{InlinePEM: "not a certificate"},
+					{Issuer: &trustapi.BundleSourceIssuerRef{Name: "ca"}},
+					{Issuer: &trustapi.BundleSourceIssuerRef{Name: "ca", Kind: cmapi.ClusterIssuerKind, Namespace: "team"}},
+					{Issuer: &trustapi.BundleSourceIssuerRef{Name: "ca", Kind: "AWSPCAIssuer"}},
+				},
+			},
+			errs: field.ErrorList{
+				field.Required(fldPath.Child("sources").Index(0).Child("secret", "namespace"), "must be specified"),
+				field.Required(fldPath.Child("sources").Index(0).Child("secret", "name"), "must be specified"),
+				field.Invalid(fldPath.Child("sources").Index(1).Child("inlinePEM"), "<inline PEM>", "error decoding certificate PEM block"),
+				field.Required(fldPath.Child("sources").Index(2).Child("issuer", "namespace"), "must be specified for Issuers"),
+				field.Forbidden(fldPath.Child("sources").Index(3).Child("issuer", "namespace"), "must not be specified for ClusterIssuers"),
+				field.NotSupported(fldPath.Child("sources").Index(4).Child("issuer", "kind"), "AWSPCAIssuer", []string{"Issuer", "ClusterIssuer"}),
+			},
+		},
+		"duplicate target keys": {
+			spec: trustapi.BundleSpec{
+				Sources: []trustapi.BundleSource{
+					{Secret: &trustapi.BundleSourceObjectKeySelector{Namespace: "cert-manager", Name: "root-ca"}},
+				},
+				Target: trustapi.BundleTarget{
+					Key:    "truststore.jks",
+					JKS:    &trustapi.BundleTargetKeystore{},
+					PKCS12: &trustapi.BundleTargetKeystore{Key: "truststore.jks"},
+				},
+			},
+			errs: field.ErrorList{
+				field.Duplicate(fldPath.Child("target", "jks", "key"), "truststore.jks"),
+				field.Duplicate(fldPath.Child("target", "pkcs12", "key"), "truststore.jks"),
+			},
+		},
+		"invalid truststore password references": {
+			spec: trustapi.BundleSpec{
+				Sources: []trustapi.BundleSource{
+					{Secret: &trustapi.BundleSourceObjectKeySelector{Namespace: "cert-manager", Name: "root-ca"}},
+				},
+				Target: trustapi.BundleTarget{
+					JKS:    &trustapi.BundleTargetKeystore{PasswordSecretRef: &cmmeta.SecretKeySelector{}},
+					PKCS12: &trustapi.BundleTargetKeystore{PasswordSecretRef: &cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "truststore"}}},
+				},
+			},
+			errs: field.ErrorList{
+				field.Required(fldPath.Child("target", "jks", "passwordSecretRef", "name"), "secret name is required"),
+				field.Required(fldPath.Child("target", "jks", "passwordSecretRef", "key"), "secret key is required"),
+				field.Required(fldPath.Child("target", "pkcs12", "passwordSecretRef", "key"), "secret key is required"),
+			},
+		},
+	}
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			errs := ValidateBundleSpec(&s.spec, fldPath)
+			if len(errs) != len(s.errs) {
+				t.Errorf("Expected %v but got %v", s.errs, errs)
+				return
+			}
+			for i, e := range errs {
+				expectedErr := s.errs[i]
+				if !reflect.DeepEqual(e, expectedErr) {
+					t.Errorf("Expected %v but got %v", expectedErr, e)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jetstack/cert-manager/pkg/acme/webhook"
+	whapi "github.com/jetstack/cert-manager/pkg/acme/webhook/apis/acme/v1alpha1"
+	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/util"
+)
+
+// batchSolver is implemented by solvers using the old solver interface that
+// are able to apply several TXT record changes in a single API request.
+type batchSolver interface {
+	solver
+	ChangeRecords(changes []util.TXTChange) error
+}
+
+// batchWebhookSolver is implemented by webhook based solvers that are able to
+// apply several TXT record changes for challenges sharing the same config and
+// zone as ch in a single API request.
+type batchWebhookSolver interface {
+	webhook.Solver
+	ChangeRecords(ch *whapi.ChallengeRequest, changes []util.TXTChange) error
+}
+
+// resultTTL is the amount of time that the result of applying a change is
+// kept for after it has been applied. Results that are not collected within
+// this time, e.g. because the challenge was deleted, are discarded.
+const resultTTL = 10 * time.Minute
+
+// ChangePendingError is returned when a TXT record change has been queued to
+// be applied together with other changes, but has not been applied yet. The
+// change should be submitted again after RetryAfter to collect the result of
+// applying it.
+type ChangePendingError struct {
+	RetryAfter time.Duration
+}
+
+func (e *ChangePendingError) Error() string {
+	return fmt.Sprintf("DNS01 record change is queued to be applied, retrying in %v", e.RetryAfter)
+}
+
+// changeBatcher merges TXT record changes submitted within a short window of
+// each other into a single call to a DNS provider. Changes are only merged if
+// they are submitted with the same key, which should identify the provider,
+// its configuration and the zone being changed.
+//
+// Submitting a change does not block until it has been applied, so that the
+// size of a batch is not limited by the number of workers submitting changes.
+// Instead, the result of applying a change is returned when the same change
+// is submitted again after it has been applied.
+type changeBatcher struct {
+	window time.Duration
+
+	lock    sync.Mutex
+	pending map[string]*changeBatch
+	results map[changeKey]*changeResult
+}
+
+// changeKey identifies a change submitted with a particular batch key.
+type changeKey struct {
+	key    string
+	change util.TXTChange
+}
+
+// changeBatch is a set of changes waiting to be applied.
+type changeBatch struct {
+	changes []util.TXTChange
+	apply   func([]util.TXTChange) error
+	flushAt time.Time
+}
+
+// changeResult is the state of a submitted change. applied is false until the
+// batch containing the change has been applied, after which err holds the
+// result of applying the change.
+type changeResult struct {
+	batch     *changeBatch
+	applied   bool
+	appliedAt time.Time
+	err       error
+}
+
+func newChangeBatcher(window time.Duration) *changeBatcher {
+	return &changeBatcher{
+		window:  window,
+		pending: make(map[string]*changeBatch),
+		results: make(map[changeKey]*changeResult),
+	}
+}
+
+// Submit adds change to the pending batch for key, starting a new batch if
+// there is not one, and returns a ChangePendingError. The apply function of
+// the first change submitted to a batch is used to apply the whole batch.
+// Once the batch has been applied, submitting the same change again returns
+// the result of applying it.
+func (b *changeBatcher) Submit(key string, change util.TXTChange, apply func([]util.TXTChange) error) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	ck := changeKey{key: key, change: change}
+	if res, ok := b.results[ck]; ok {
+		if !res.applied {
+			return b.pendingError(res.batch)
+		}
+		delete(b.results, ck)
+		return res.err
+	}
+
+	batch, ok := b.pending[key]
+	if !ok {
+		batch = &changeBatch{apply: apply, flushAt: time.Now().Add(b.window)}
+		b.pending[key] = batch
+		time.AfterFunc(b.window, func() { b.flush(key, batch) })
+	}
+	batch.changes = append(batch.changes, change)
+	b.results[ck] = &changeResult{batch: batch}
+
+	return b.pendingError(batch)
+}
+
+// pendingError returns an error asking for a change in batch to be submitted
+// again once the batch has been applied. Another window is allowed for the
+// DNS provider to apply the batch.
+func (b *changeBatcher) pendingError(batch *changeBatch) error {
+	retryAfter := time.Until(batch.flushAt)
+	if retryAfter < 0 {
+		retryAfter = 0
+	}
+	return &ChangePendingError{RetryAfter: retryAfter + b.window}
+}
+
+func (b *changeBatcher) flush(key string, batch *changeBatch) {
+	b.lock.Lock()
+	if b.pending[key] == batch {
+		delete(b.pending, key)
+	}
+	b.lock.Unlock()
+
+	errs := applyChanges(batch.apply, batch.changes)
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	now := time.Now()
+	for i, change := range batch.changes {
+		if res, ok := b.results[changeKey{key: key, change: change}]; ok && res.batch == batch {
+			res.applied, res.appliedAt, res.err = true, now, errs[i]
+		}
+	}
+	for ck, res := range b.results {
+		if res.applied && now.Sub(res.appliedAt) > resultTTL {
+			delete(b.results, ck)
+		}
+	}
+}
+
+// applyChanges applies changes in a single call to apply. If that fails and
+// there is more than one change, each change is applied on its own so that a
+// change that the DNS provider rejects does not cause the others to fail.
+func applyChanges(apply func([]util.TXTChange) error, changes []util.TXTChange) []error {
+	errs := make([]error, len(changes))
+	if err := apply(changes); err == nil || len(changes) == 1 {
+		for i := range errs {
+			errs[i] = err
+		}
+		return errs
+	}
+	for i, change := range changes {
+		errs[i] = apply([]util.TXTChange{change})
+	}
+	return errs
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/util"
+)
+
+type recordingApplier struct {
+	lock    sync.Mutex
+	batches map[string][][]util.TXTChange
+}
+
+func (r *recordingApplier) applyFor(key string) func([]util.TXTChange) error {
+	return func(changes []util.TXTChange) error {
+		r.lock.Lock()
+		defer r.lock.Unlock()
+		r.batches[key] = append(r.batches[key], changes)
+		return nil
+	}
+}
+
+func (r *recordingApplier) batchCount(key string) int {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return len(r.batches[key])
+}
+
+// submitAll submits all changes, and submits them again once they have been
+// applied to collect the result of applying each of them.
+func submitAll(t *testing.T, b *changeBatcher, a *recordingApplier, keys []string, changes []util.TXTChange) []error {
+	errs := make([]error, len(changes))
+	for i := range changes {
+		err := b.Submit(keys[i], changes[i], a.applyFor(keys[i]))
+		if _, ok := err.(*ChangePendingError); !ok {
+			t.Fatalf("expected change %d to be pending, got %v", i, err)
+		}
+	}
+	for i := range changes {
+		err := wait.PollImmediate(5*time.Millisecond, time.Second, func() (bool, error) {
+			errs[i] = b.Submit(keys[i], changes[i], a.applyFor(keys[i]))
+			_, pending := errs[i].(*ChangePendingError)
+			return !pending, nil
+		})
+		if err != nil {
+			t.Fatalf("timed out waiting for change %d to be applied", i)
+		}
+	}
+	return errs
+}
+
+func testChange(action util.ChangeAction, value string) util.TXTChange {
+	return util.TXTChange{Action: action, Domain: "example.com", FQDN: "_acme-challenge.example.com.", Value: value}
+}
+
+func TestChangeBatcher(t *testing.T) {
+	b := newChangeBatcher(50 * time.Millisecond)
+	a := &recordingApplier{batches: make(map[string][][]util.TXTChange)}
+
+	var keys []string
+	var changes []util.TXTChange
+	for i := 0; i < 10; i++ {
+		keys = append(keys, "zone-a")
+		changes = append(changes, testChange(util.ChangeActionPresent, fmt.Sprintf("key%d", i)))
+	}
+	// changes with a different key are applied separately
+	keys = append(keys, "zone-b")
+	changes = append(changes, testChange(util.ChangeActionCleanUp, "key0"))
+
+	for i, err := range submitAll(t, b, a, keys, changes) {
+		if err != nil {
+			t.Errorf("unexpected error for change %d: %v", i, err)
+		}
+	}
+
+	if len(a.batches["zone-a"]) != 1 {
+		t.Fatalf("expected 1 batch for zone-a, got %d", len(a.batches["zone-a"]))
+	}
+	var values []string
+	for _, c := range a.batches["zone-a"][0] {
+		values = append(values, c.Value)
+	}
+	sort.Strings(values)
+	expected := []string{"key0", "key1", "key2", "key3", "key4", "key5", "key6", "key7", "key8", "key9"}
+	if fmt.Sprint(values) != fmt.Sprint(expected) {
+		t.Errorf("expected batch values %v, got %v", expected, values)
+	}
+	if len(a.batches["zone-b"]) != 1 || len(a.batches["zone-b"][0]) != 1 {
+		t.Errorf("expected a single change in a single batch for zone-b, got %v", a.batches["zone-b"])
+	}
+
+	// once a batch has been applied, new changes start a new batch
+	submitAll(t, b, a, []string{"zone-a"}, []util.TXTChange{testChange(util.ChangeActionCleanUp, "key0")})
+	if len(a.batches["zone-a"]) != 2 {
+		t.Errorf("expected 2 batches for zone-a, got %d", len(a.batches["zone-a"]))
+	}
+}
+
+func TestChangeBatcherDuplicateChange(t *testing.T) {
+	b := newChangeBatcher(10 * time.Millisecond)
+	a := &recordingApplier{batches: make(map[string][][]util.TXTChange)}
+	change := testChange(util.ChangeActionPresent, "key0")
+
+	// submitting a change again before it has been applied does not add it
+	// to the batch a second time
+	b.Submit("zone", change, a.applyFor("zone"))
+	submitAll(t, b, a, []string{"zone"}, []util.TXTChange{change})
+
+	if len(a.batches["zone"]) != 1 || len(a.batches["zone"][0]) != 1 {
+		t.Errorf("expected a single change in a single batch, got %v", a.batches["zone"])
+	}
+}
+
+func TestChangeBatcherError(t *testing.T) {
+	b := newChangeBatcher(10 * time.Millisecond)
+	a := &recordingApplier{batches: make(map[string][][]util.TXTChange)}
+	// the provider rejects any request that contains the change for key1
+	apply := func(changes []util.TXTChange) error {
+		a.applyFor("zone")(changes)
+		for _, c := range changes {
+			if c.Value == "key1" {
+				return fmt.Errorf("invalid record")
+			}
+		}
+		return nil
+	}
+
+	changes := []util.TXTChange{
+		testChange(util.ChangeActionPresent, "key0"),
+		testChange(util.ChangeActionPresent, "key1"),
+		testChange(util.ChangeActionPresent, "key2"),
+	}
+	for _, c := range changes {
+		b.Submit("zone", c, apply)
+	}
+	if err := wait.PollImmediate(5*time.Millisecond, time.Second, func() (bool, error) {
+		return a.batchCount("zone") == 4, nil
+	}); err != nil {
+		t.Fatalf("expected the batch to be retried one change at a time, got batches %v", a.batches["zone"])
+	}
+
+	for i, c := range changes {
+		err := b.Submit("zone", c, apply)
+		if c.Value == "key1" {
+			if err == nil || err.Error() != "invalid record" {
+				t.Errorf("expected the error of change %d to be returned, got %v", i, err)
+			}
+		} else if err != nil {
+			t.Errorf("unexpected error for change %d: %v", i, err)
+		}
+	}
+}
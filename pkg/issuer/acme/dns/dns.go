@@ -39,6 +39,8 @@ import (
 	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/clouddns"
 	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/cloudflare"
 	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/digitalocean"
+	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/embedded"
+	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/externaldns"
 	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/rfc2136"
 	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/route53"
 	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/util"
@@ -73,6 +75,10 @@ type Solver struct {
 	secretLister            corev1listers.SecretLister
 	dnsProviderConstructors dnsProviderConstructors
 	webhookSolvers          map[string]webhook.Solver
+
+	// batcher merges changes for solvers that support applying several
+	// changes at once. It is nil if batching is disabled.
+	batcher *changeBatcher
 }
 
 // Present performs the work to configure DNS to resolve a DNS01 challenge.
@@ -86,6 +92,9 @@ func (s *Solver) Present(ctx context.Context, issuer v1.GenericIssuer, ch *cmacm
 	}
 	if err == nil {
 		log.V(logf.InfoLevel).Info("presenting DNS01 challenge for domain")
+		if bs, ok := webhookSolver.(batchWebhookSolver); ok && s.batcher != nil {
+			return s.submitWebhookChange(bs, req, util.ChangeActionPresent)
+		}
 		return webhookSolver.Present(req)
 	}
 
@@ -101,6 +110,9 @@ func (s *Solver) Present(ctx context.Context, issuer v1.GenericIssuer, ch *cmacm
 
 	log.V(logf.DebugLevel).Info("presenting DNS01 challenge for domain")
 
+	if bs, ok := slv.(batchSolver); ok && s.batcher != nil {
+		return s.submitChange(bs, issuer, ch, providerConfig, fqdn, util.ChangeActionPresent)
+	}
 	return slv.Present(ch.Spec.DNSName, fqdn, ch.Spec.Key)
 }
 
@@ -144,6 +156,9 @@ func (s *Solver) CleanUp(ctx context.Context, issuer v1.GenericIssuer, ch *cmacm
 	}
 	if err == nil {
 		log.V(logf.DebugLevel).Info("cleaning up DNS01 challenge")
+		if bs, ok := webhookSolver.(batchWebhookSolver); ok && s.batcher != nil {
+			return s.submitWebhookChange(bs, req, util.ChangeActionCleanUp)
+		}
 		return webhookSolver.CleanUp(req)
 	}
 
@@ -157,9 +172,47 @@ func (s *Solver) CleanUp(ctx context.Context, issuer v1.GenericIssuer, ch *cmacm
 		return err
 	}
 
+	if bs, ok := slv.(batchSolver); ok && s.batcher != nil {
+		return s.submitChange(bs, issuer, ch, providerConfig, fqdn, util.ChangeActionCleanUp)
+	}
 	return slv.CleanUp(ch.Spec.DNSName, fqdn, ch.Spec.Key)
 }
 
+// submitChange submits a change to be applied together with changes for
+// other challenges that use the same provider config and zone. It returns a
+// ChangePendingError until the change has been applied.
+func (s *Solver) submitChange(slv batchSolver, issuer v1.GenericIssuer, ch *cmacme.Challenge, providerConfig *cmacme.ACMEChallengeSolverDNS01, fqdn string, action util.ChangeAction) error {
+	zone, err := util.FindZoneByFqdn(fqdn, s.DNS01Nameservers)
+	if err != nil {
+		return err
+	}
+	cfg, err := json.Marshal(providerConfig)
+	if err != nil {
+		return err
+	}
+
+	key := batchKey("legacy", s.ResourceNamespace(issuer), s.CanUseAmbientCredentials(issuer), zone, cfg)
+	change := util.TXTChange{Action: action, Domain: ch.Spec.DNSName, FQDN: fqdn, Value: ch.Spec.Key}
+	return s.batcher.Submit(key, change, slv.ChangeRecords)
+}
+
+// submitWebhookChange submits a change to be applied together with changes
+// for other challenges that use the same webhook solver, config and zone. It
+// returns a ChangePendingError until the change has been applied.
+func (s *Solver) submitWebhookChange(slv batchWebhookSolver, req *whapi.ChallengeRequest, action util.ChangeAction) error {
+	key := batchKey(slv.Name(), req.ResourceNamespace, req.AllowAmbientCredentials, req.ResolvedZone, req.Config.Raw)
+	change := util.TXTChange{Action: action, Domain: req.DNSName, FQDN: req.ResolvedFQDN, Value: req.Key}
+	return s.batcher.Submit(key, change, func(changes []util.TXTChange) error {
+		return slv.ChangeRecords(req, changes)
+	})
+}
+
+// batchKey returns the key used to group changes into batches. Only changes
+// for the same provider, credentials and zone may be batched together.
+func batchKey(solverName, resourceNamespace string, ambient bool, zone string, config []byte) string {
+	return fmt.Sprintf("%s/%s/%t/%s/%s", solverName, resourceNamespace, ambient, zone, config)
+}
+
 func followCNAME(strategy cmacme.CNAMEStrategy) bool {
 	return strategy == cmacme.FollowStrategy
 }
@@ -434,6 +487,12 @@ func (s *Solver) dns01SolverForConfig(config *cmacme.ACMEChallengeSolverDNS01) (
 	case config.RFC2136 != nil:
 		solverName = "rfc2136"
 		c = config.RFC2136
+	case config.ExternalDNS != nil:
+		solverName = "externaldns"
+		c = config.ExternalDNS
+	case config.Embedded != nil:
+		solverName = "embedded"
+		c = config.Embedded
 	}
 	if solverName == "" {
 		return nil, nil, errNotFound
@@ -451,6 +510,11 @@ func NewSolver(ctx *controller.Context) (*Solver, error) {
 	webhookSolvers := []webhook.Solver{
 		&webhookslv.Webhook{},
 		rfc2136.New(rfc2136.WithNamespace(ctx.Namespace)),
+		externaldns.New(
+			externaldns.WithDNS01Nameservers(ctx.DNS01Nameservers),
+			externaldns.WithDNS01CheckAuthoritative(ctx.DNS01CheckAuthoritative),
+		),
+		embedded.NewSolver(ctx.DNS01ServerRecords),
 	}
 
 	initialized := make(map[string]webhook.Solver)
@@ -468,6 +532,11 @@ func NewSolver(ctx *controller.Context) (*Solver, error) {
 		}
 	}
 
+	var batcher *changeBatcher
+	if ctx.DNS01BatchWindow > 0 {
+		batcher = newChangeBatcher(ctx.DNS01BatchWindow)
+	}
+
 	return &Solver{
 		Context:      ctx,
 		secretLister: ctx.KubeSharedInformerFactory.Core().V1().Secrets().Lister(),
@@ -480,6 +549,7 @@ func NewSolver(ctx *controller.Context) (*Solver, error) {
 			digitalocean.NewDNSProviderCredentials,
 		},
 		webhookSolvers: initialized,
+		batcher:        batcher,
 	}, nil
 }
 
@@ -0,0 +1,83 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package embedded
+
+import (
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/jetstack/cert-manager/pkg/acme"
+	cmacme "github.com/jetstack/cert-manager/pkg/apis/acme/v1"
+	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/util"
+)
+
+// ChallengeEventHandler returns an event handler that keeps records in sync
+// with the Challenge resources that use the embedded DNS01 provider.
+// This allows every replica of the controller, including those that are not
+// the elected leader, to answer queries for presented challenges.
+//
+// Records are added once a challenge has been presented, and removed when the
+// challenge reaches a final state or is deleted. Records are never removed
+// for a challenge that is still being processed, as the informer may observe
+// a stale version of the challenge after the solver has already presented it.
+func ChallengeEventHandler(records *RecordSet) cache.ResourceEventHandler {
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			syncChallenge(records, obj)
+		},
+		UpdateFunc: func(_, obj interface{}) {
+			syncChallenge(records, obj)
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			ch, ok := obj.(*cmacme.Challenge)
+			if !ok || !usesEmbeddedDNS(ch) {
+				return
+			}
+			records.Remove(challengeFQDN(ch), ch.Spec.Key)
+		},
+	}
+}
+
+func syncChallenge(records *RecordSet, obj interface{}) {
+	ch, ok := obj.(*cmacme.Challenge)
+	if !ok || !usesEmbeddedDNS(ch) {
+		return
+	}
+	switch {
+	case acme.IsFinalState(ch.Status.State):
+		records.Remove(challengeFQDN(ch), ch.Spec.Key)
+	case ch.Status.Presented:
+		records.Add(challengeFQDN(ch), ch.Spec.Key)
+	}
+}
+
+func usesEmbeddedDNS(ch *cmacme.Challenge) bool {
+	return ch.Spec.Type == cmacme.ACMEChallengeTypeDNS01 &&
+		ch.Spec.Solver.DNS01 != nil &&
+		ch.Spec.Solver.DNS01.Embedded != nil
+}
+
+// challengeFQDN returns the name of the TXT record for the challenge.
+// CNAMEs are never followed, as the '_acme-challenge' zone must be delegated
+// to the embedded DNS server with NS records.
+func challengeFQDN(ch *cmacme.Challenge) string {
+	// DNS01LookupFQDN cannot fail when CNAMEs are not followed.
+	fqdn, _ := util.DNS01LookupFQDN(ch.Spec.DNSName, false)
+	return fqdn
+}
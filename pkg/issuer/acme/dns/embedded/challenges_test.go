@@ -0,0 +1,110 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package embedded
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/tools/cache"
+
+	cmacme "github.com/jetstack/cert-manager/pkg/apis/acme/v1"
+)
+
+func testChallenge(mod func(*cmacme.Challenge)) *cmacme.Challenge {
+	ch := &cmacme.Challenge{
+		Spec: cmacme.ChallengeSpec{
+			Type:    cmacme.ACMEChallengeTypeDNS01,
+			DNSName: "example.com",
+			Key:     "key",
+			Solver: cmacme.ACMEChallengeSolver{
+				DNS01: &cmacme.ACMEChallengeSolverDNS01{
+					Embedded: &cmacme.ACMEIssuerDNS01ProviderEmbedded{},
+				},
+			},
+		},
+	}
+	if mod != nil {
+		mod(ch)
+	}
+	return ch
+}
+
+func TestChallengeEventHandler(t *testing.T) {
+	const fqdn = "_acme-challenge.example.com."
+
+	tests := map[string]struct {
+		initial  []string
+		event    func(cache.ResourceEventHandler)
+		expected []string
+	}{
+		"presented challenge is added": {
+			event: func(h cache.ResourceEventHandler) {
+				h.OnAdd(testChallenge(func(ch *cmacme.Challenge) { ch.Status.Presented = true }))
+			},
+			expected: []string{"key"},
+		},
+		"challenge that has not been presented is not added": {
+			event: func(h cache.ResourceEventHandler) {
+				h.OnAdd(testChallenge(nil))
+			},
+		},
+		"stale challenge that has not been presented does not remove the record": {
+			initial: []string{"key"},
+			event: func(h cache.ResourceEventHandler) {
+				h.OnUpdate(nil, testChallenge(func(ch *cmacme.Challenge) { ch.Status.State = cmacme.Pending }))
+			},
+			expected: []string{"key"},
+		},
+		"challenge in a final state is removed": {
+			initial: []string{"key"},
+			event: func(h cache.ResourceEventHandler) {
+				h.OnUpdate(nil, testChallenge(func(ch *cmacme.Challenge) {
+					ch.Status.Presented = true
+					ch.Status.State = cmacme.Valid
+				}))
+			},
+		},
+		"deleted challenge is removed": {
+			initial: []string{"key"},
+			event: func(h cache.ResourceEventHandler) {
+				h.OnDelete(cache.DeletedFinalStateUnknown{Obj: testChallenge(nil)})
+			},
+		},
+		"challenges using other providers are ignored": {
+			event: func(h cache.ResourceEventHandler) {
+				h.OnAdd(testChallenge(func(ch *cmacme.Challenge) {
+					ch.Status.Presented = true
+					ch.Spec.Solver.DNS01 = &cmacme.ACMEChallengeSolverDNS01{
+						ExternalDNS: &cmacme.ACMEIssuerDNS01ProviderExternalDNS{},
+					}
+				}))
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			records := NewRecordSet()
+			for _, v := range test.initial {
+				records.Add(fqdn, v)
+			}
+			test.event(ChallengeEventHandler(records))
+			assert.Equal(t, test.expected, records.TXT(fqdn))
+		})
+	}
+}
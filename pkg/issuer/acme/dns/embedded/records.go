@@ -0,0 +1,89 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package embedded
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/util"
+)
+
+// RecordSet is an in-memory set of TXT records, keyed by FQDN, that is
+// served by the embedded DNS server.
+// It is safe for concurrent use.
+type RecordSet struct {
+	lock    sync.RWMutex
+	records map[string]map[string]struct{}
+}
+
+// NewRecordSet returns an empty RecordSet.
+func NewRecordSet() *RecordSet {
+	return &RecordSet{records: make(map[string]map[string]struct{})}
+}
+
+// Add adds a TXT record with the given value for fqdn.
+func (r *RecordSet) Add(fqdn, value string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	key := canonicalName(fqdn)
+	values, ok := r.records[key]
+	if !ok {
+		values = make(map[string]struct{})
+		r.records[key] = values
+	}
+	values[value] = struct{}{}
+}
+
+// Remove removes the TXT record with the given value for fqdn, if it exists.
+func (r *RecordSet) Remove(fqdn, value string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	key := canonicalName(fqdn)
+	values, ok := r.records[key]
+	if !ok {
+		return
+	}
+	delete(values, value)
+	if len(values) == 0 {
+		delete(r.records, key)
+	}
+}
+
+// TXT returns the sorted values of all TXT records for fqdn.
+func (r *RecordSet) TXT(fqdn string) []string {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	values := r.records[canonicalName(fqdn)]
+	if len(values) == 0 {
+		return nil
+	}
+	out := make([]string, 0, len(values))
+	for v := range values {
+		out = append(out, v)
+	}
+	sort.Strings(out)
+	return out
+}
+
+func canonicalName(fqdn string) string {
+	return strings.ToLower(util.ToFqdn(fqdn))
+}
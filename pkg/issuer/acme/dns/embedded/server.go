@@ -0,0 +1,188 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package embedded implements a minimal authoritative DNS server that
+// answers TXT queries for delegated '_acme-challenge' zones, along with a
+// DNS01 solver that publishes challenge records to it.
+//
+// Users delegate each '_acme-challenge.<domain>' zone to the server once
+// using NS records, after which DNS01 challenges can be solved without any
+// DNS provider API.
+package embedded
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/miekg/dns"
+
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+)
+
+const (
+	// challengeLabel is the first label of every name that the server is
+	// authoritative for.
+	challengeLabel = "_acme-challenge"
+
+	// defaultTTL is the TTL of all records served.
+	defaultTTL = 60
+)
+
+// Server is an authoritative DNS server for '_acme-challenge' zones. It
+// answers TXT queries using the records in Records, and refuses queries for
+// any other name.
+type Server struct {
+	// ListenAddress is the host:port to listen on for both UDP and TCP
+	// queries.
+	ListenAddress string
+
+	// Records holds the TXT records that will be served.
+	Records *RecordSet
+
+	log       logr.Logger
+	udpServer *dns.Server
+	tcpServer *dns.Server
+}
+
+// Start binds the UDP and TCP listeners and serves DNS queries in the
+// background until stopCh is closed.
+func (s *Server) Start(log logr.Logger, stopCh <-chan struct{}) error {
+	if s.Records == nil {
+		return fmt.Errorf("no record set configured for DNS server")
+	}
+	s.log = log.WithName("dns01-server")
+
+	pc, err := net.ListenPacket("udp", s.ListenAddress)
+	if err != nil {
+		return fmt.Errorf("error listening on UDP address %q: %v", s.ListenAddress, err)
+	}
+	// Listen for TCP on the same port as UDP, which allows a port of 0 to be
+	// used in the ListenAddress.
+	l, err := net.Listen("tcp", pc.LocalAddr().String())
+	if err != nil {
+		pc.Close()
+		return fmt.Errorf("error listening on TCP address %q: %v", pc.LocalAddr(), err)
+	}
+
+	s.udpServer = &dns.Server{PacketConn: pc, Handler: s, ReadTimeout: 2 * time.Second, WriteTimeout: 2 * time.Second}
+	s.tcpServer = &dns.Server{Listener: l, Handler: s, ReadTimeout: 2 * time.Second, WriteTimeout: 2 * time.Second}
+
+	for _, srv := range []*dns.Server{s.udpServer, s.tcpServer} {
+		go func(srv *dns.Server) {
+			if err := srv.ActivateAndServe(); err != nil {
+				s.log.Error(err, "DNS server exited with error")
+			}
+		}(srv)
+	}
+	s.log.V(logf.InfoLevel).Info("listening for DNS queries", "address", pc.LocalAddr().String())
+
+	go func() {
+		<-stopCh
+		s.Shutdown()
+	}()
+
+	return nil
+}
+
+// Addr returns the address the server is listening on.
+func (s *Server) Addr() string {
+	if s.udpServer == nil {
+		return ""
+	}
+	return s.udpServer.PacketConn.LocalAddr().String()
+}
+
+// Shutdown stops the server.
+func (s *Server) Shutdown() {
+	for _, srv := range []*dns.Server{s.udpServer, s.tcpServer} {
+		if srv == nil {
+			continue
+		}
+		if err := srv.Shutdown(); err != nil {
+			s.log.V(logf.DebugLevel).Info("error shutting down DNS server", "error", err)
+		}
+	}
+}
+
+// ServeDNS implements dns.Handler.
+func (s *Server) ServeDNS(w dns.ResponseWriter, req *dns.Msg) {
+	m := new(dns.Msg)
+	m.SetReply(req)
+
+	if req.Opcode != dns.OpcodeQuery || len(req.Question) != 1 {
+		m.SetRcode(req, dns.RcodeRefused)
+		s.writeMsg(w, m)
+		return
+	}
+
+	q := req.Question[0]
+	if q.Qclass != dns.ClassINET || !isChallengeName(q.Name) {
+		m.SetRcode(req, dns.RcodeRefused)
+		s.writeMsg(w, m)
+		return
+	}
+
+	m.Authoritative = true
+	switch q.Qtype {
+	case dns.TypeTXT:
+		for _, v := range s.Records.TXT(q.Name) {
+			m.Answer = append(m.Answer, &dns.TXT{
+				Hdr: dns.RR_Header{Name: q.Name, Rrtype: dns.TypeTXT, Class: dns.ClassINET, Ttl: defaultTTL},
+				Txt: []string{v},
+			})
+		}
+	case dns.TypeSOA:
+		m.Answer = append(m.Answer, soa(q.Name))
+	}
+
+	// Respond with NODATA, including the SOA for negative caching.
+	if len(m.Answer) == 0 {
+		m.Ns = append(m.Ns, soa(q.Name))
+	}
+
+	s.writeMsg(w, m)
+}
+
+func (s *Server) writeMsg(w dns.ResponseWriter, m *dns.Msg) {
+	if err := w.WriteMsg(m); err != nil {
+		s.log.V(logf.DebugLevel).Info("error writing DNS response", "error", err)
+	}
+}
+
+// isChallengeName returns true if the given name is an '_acme-challenge'
+// name, and therefore within a zone delegated to this server.
+func isChallengeName(name string) bool {
+	labels := dns.SplitDomainName(name)
+	return len(labels) > 1 && strings.EqualFold(labels[0], challengeLabel)
+}
+
+// soa returns a synthesised SOA record for the delegated zone.
+func soa(zone string) dns.RR {
+	zone = dns.Fqdn(strings.ToLower(zone))
+	return &dns.SOA{
+		Hdr:     dns.RR_Header{Name: zone, Rrtype: dns.TypeSOA, Class: dns.ClassINET, Ttl: defaultTTL},
+		Ns:      zone,
+		Mbox:    "hostmaster." + zone,
+		Serial:  1,
+		Refresh: 3600,
+		Retry:   600,
+		Expire:  86400,
+		Minttl:  defaultTTL,
+	}
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package embedded
+
+import (
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	whapi "github.com/jetstack/cert-manager/pkg/acme/webhook/apis/acme/v1alpha1"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+)
+
+func runTestServer(t *testing.T, records *RecordSet) string {
+	stopCh := make(chan struct{})
+	t.Cleanup(func() { close(stopCh) })
+
+	s := &Server{ListenAddress: "127.0.0.1:0", Records: records}
+	require.NoError(t, s.Start(logf.Log, stopCh))
+	return s.Addr()
+}
+
+func query(t *testing.T, addr, net, name string, qtype uint16) *dns.Msg {
+	m := new(dns.Msg)
+	m.SetQuestion(name, qtype)
+	c := &dns.Client{Net: net}
+	resp, _, err := c.Exchange(m, addr)
+	require.NoError(t, err)
+	return resp
+}
+
+func TestServer(t *testing.T) {
+	records := NewRecordSet()
+	addr := runTestServer(t, records)
+
+	solver := NewSolver(records)
+	require.NoError(t, solver.Present(&whapi.ChallengeRequest{ResolvedFQDN: "_acme-challenge.example.com.", Key: "key2"}))
+	require.NoError(t, solver.Present(&whapi.ChallengeRequest{ResolvedFQDN: "_ACME-Challenge.Example.com.", Key: "key1"}))
+
+	for _, net := range []string{"udp", "tcp"} {
+		t.Run(net, func(t *testing.T) {
+			resp := query(t, addr, net, "_acme-challenge.example.com.", dns.TypeTXT)
+			assert.Equal(t, dns.RcodeSuccess, resp.Rcode)
+			assert.True(t, resp.Authoritative)
+			var values []string
+			for _, rr := range resp.Answer {
+				txt, ok := rr.(*dns.TXT)
+				require.True(t, ok, "unexpected record type %T", rr)
+				values = append(values, txt.Txt...)
+			}
+			assert.Equal(t, []string{"key1", "key2"}, values)
+		})
+	}
+
+	t.Run("no records", func(t *testing.T) {
+		resp := query(t, addr, "udp", "_acme-challenge.other.example.com.", dns.TypeTXT)
+		assert.Equal(t, dns.RcodeSuccess, resp.Rcode)
+		assert.True(t, resp.Authoritative)
+		assert.Empty(t, resp.Answer)
+		require.Len(t, resp.Ns, 1)
+		assert.Equal(t, dns.TypeSOA, resp.Ns[0].Header().Rrtype)
+	})
+
+	t.Run("SOA", func(t *testing.T) {
+		resp := query(t, addr, "udp", "_acme-challenge.example.com.", dns.TypeSOA)
+		assert.Equal(t, dns.RcodeSuccess, resp.Rcode)
+		require.Len(t, resp.Answer, 1)
+		assert.Equal(t, dns.TypeSOA, resp.Answer[0].Header().Rrtype)
+	})
+
+	t.Run("names outside of challenge zones are refused", func(t *testing.T) {
+		resp := query(t, addr, "udp", "example.com.", dns.TypeTXT)
+		assert.Equal(t, dns.RcodeRefused, resp.Rcode)
+		assert.False(t, resp.Authoritative)
+	})
+
+	t.Run("cleaned up records are no longer served", func(t *testing.T) {
+		require.NoError(t, solver.CleanUp(&whapi.ChallengeRequest{ResolvedFQDN: "_acme-challenge.example.com.", Key: "key1"}))
+		resp := query(t, addr, "udp", "_acme-challenge.example.com.", dns.TypeTXT)
+		require.Len(t, resp.Answer, 1)
+		assert.Equal(t, []string{"key2"}, resp.Answer[0].(*dns.TXT).Txt)
+	})
+}
+
+func TestSolverNotEnabled(t *testing.T) {
+	s := NewSolver(nil)
+	assert.Error(t, s.Present(&whapi.ChallengeRequest{ResolvedFQDN: "_acme-challenge.example.com.", Key: "key"}))
+	assert.NoError(t, s.CleanUp(&whapi.ChallengeRequest{ResolvedFQDN: "_acme-challenge.example.com.", Key: "key"}))
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package embedded
+
+import (
+	"fmt"
+
+	restclient "k8s.io/client-go/rest"
+
+	whapi "github.com/jetstack/cert-manager/pkg/acme/webhook/apis/acme/v1alpha1"
+)
+
+// Solver is a DNS01 solver that publishes challenge records to the embedded
+// DNS server by updating its in-memory RecordSet.
+type Solver struct {
+	records *RecordSet
+}
+
+// NewSolver returns a Solver that publishes records to the given RecordSet.
+// records may be nil if the embedded DNS server is not enabled, in which case
+// all challenges will fail to be presented.
+func NewSolver(records *RecordSet) *Solver {
+	return &Solver{records: records}
+}
+
+func (s *Solver) Name() string {
+	return "embedded"
+}
+
+func (s *Solver) Present(ch *whapi.ChallengeRequest) error {
+	if s.records == nil {
+		return fmt.Errorf("the embedded DNS01 server is not enabled, set --dns01-server-listen-address to enable it")
+	}
+	s.records.Add(ch.ResolvedFQDN, ch.Key)
+	return nil
+}
+
+func (s *Solver) CleanUp(ch *whapi.ChallengeRequest) error {
+	if s.records == nil {
+		return nil
+	}
+	s.records.Remove(ch.ResolvedFQDN, ch.Key)
+	return nil
+}
+
+func (s *Solver) Initialize(kubeClientConfig *restclient.Config, stopCh <-chan struct{}) error {
+	return nil
+}
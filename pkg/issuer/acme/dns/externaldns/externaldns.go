@@ -0,0 +1,325 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package externaldns implements a DNS provider for solving the DNS-01
+// challenge by creating external-dns DNSEndpoint resources. The DNS records
+// are published by external-dns, so cert-manager does not need any
+// credentials for the DNS provider.
+package externaldns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	extapi "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	restclient "k8s.io/client-go/rest"
+
+	whapi "github.com/jetstack/cert-manager/pkg/acme/webhook/apis/acme/v1alpha1"
+	apiutil "github.com/jetstack/cert-manager/pkg/api/util"
+	cmacme "github.com/jetstack/cert-manager/pkg/apis/acme/v1"
+	"github.com/jetstack/cert-manager/pkg/issuer/acme/dns/util"
+	logf "github.com/jetstack/cert-manager/pkg/logs"
+)
+
+const (
+	// SolverLabelKey is set on every DNSEndpoint created by this solver so
+	// that they can be easily identified.
+	SolverLabelKey = "acme.cert-manager.io/dns01-solver"
+
+	// recordTTL is the TTL of the TXT records that will be published.
+	recordTTL = 60
+)
+
+var dnsEndpointGVR = schema.GroupVersionResource{
+	Group:    "externaldns.k8s.io",
+	Version:  "v1alpha1",
+	Resource: "dnsendpoints",
+}
+
+// Solver is a DNS01 solver that manages challenge records by creating
+// DNSEndpoint resources which are picked up and published by external-dns.
+// A single DNSEndpoint is maintained per FQDN, so that challenges for the
+// same name (e.g. 'example.com' and '*.example.com') can be presented at the
+// same time.
+type Solver struct {
+	client dynamic.Interface
+
+	dns01Nameservers        []string
+	dns01CheckAuthoritative bool
+
+	// propagationTimeout is the maximum amount of time Present will wait for
+	// external-dns to publish a record.
+	propagationTimeout time.Duration
+	// pollingInterval is the time between each propagation check.
+	pollingInterval time.Duration
+
+	// preCheckDNS is used to check whether records have propagated.
+	// It can be overridden in tests.
+	preCheckDNS func(fqdn, value string, nameservers []string, useAuthoritative bool) (bool, error)
+}
+
+type Option func(*Solver)
+
+// WithDNS01Nameservers configures the nameservers used to check that records
+// have been published by external-dns.
+func WithDNS01Nameservers(nameservers []string) Option {
+	return func(s *Solver) {
+		s.dns01Nameservers = nameservers
+	}
+}
+
+// WithDNS01CheckAuthoritative configures whether authoritative nameservers
+// are used to check that records have been published by external-dns.
+func WithDNS01CheckAuthoritative(checkAuthoritative bool) Option {
+	return func(s *Solver) {
+		s.dns01CheckAuthoritative = checkAuthoritative
+	}
+}
+
+func New(opts ...Option) *Solver {
+	s := &Solver{
+		propagationTimeout: 120 * time.Second,
+		pollingInterval:    5 * time.Second,
+		preCheckDNS:        util.PreCheckDNS,
+	}
+	for _, o := range opts {
+		o(s)
+	}
+	return s
+}
+
+func (s *Solver) Name() string {
+	return "externaldns"
+}
+
+// Present adds the challenge key to the DNSEndpoint for the challenge FQDN,
+// creating it if it does not exist, and waits for external-dns to publish
+// the record.
+func (s *Solver) Present(ch *whapi.ChallengeRequest) error {
+	cfg, err := loadConfig(ch.Config)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.TODO()
+	client := s.client.Resource(dnsEndpointGVR).Namespace(ch.ResourceNamespace)
+	name, err := endpointName(ch.ResolvedFQDN)
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.Get(ctx, name, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		obj := buildDNSEndpoint(name, ch.ResourceNamespace, ch.ResolvedFQDN, cfg, []string{ch.Key})
+		if _, err := client.Create(ctx, obj, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("error creating DNSEndpoint %s/%s: %v", ch.ResourceNamespace, name, err)
+		}
+		logf.V(logf.DebugLevel).Infof("externaldns: created DNSEndpoint %s/%s for %q", ch.ResourceNamespace, name, ch.ResolvedFQDN)
+	case err != nil:
+		return fmt.Errorf("error getting DNSEndpoint %s/%s: %v", ch.ResourceNamespace, name, err)
+	default:
+		targets, err := endpointTargets(existing, ch.ResolvedFQDN)
+		if err != nil {
+			return err
+		}
+		if !containsString(targets, ch.Key) {
+			obj := buildDNSEndpoint(name, ch.ResourceNamespace, ch.ResolvedFQDN, cfg, append(targets, ch.Key))
+			obj.SetResourceVersion(existing.GetResourceVersion())
+			if _, err := client.Update(ctx, obj, metav1.UpdateOptions{}); err != nil {
+				return fmt.Errorf("error updating DNSEndpoint %s/%s: %v", ch.ResourceNamespace, name, err)
+			}
+			logf.V(logf.DebugLevel).Infof("externaldns: added record to DNSEndpoint %s/%s for %q", ch.ResourceNamespace, name, ch.ResolvedFQDN)
+		}
+	}
+
+	// external-dns publishes records asynchronously, so wait until the record
+	// is visible before reporting the challenge as presented.
+	return util.WaitFor(s.propagationTimeout, s.pollingInterval, func() (bool, error) {
+		return s.preCheckDNS(ch.ResolvedFQDN, ch.Key, s.dns01Nameservers, s.dns01CheckAuthoritative)
+	})
+}
+
+// CleanUp removes the challenge key from the DNSEndpoint for the challenge
+// FQDN, deleting the DNSEndpoint once it contains no more records.
+func (s *Solver) CleanUp(ch *whapi.ChallengeRequest) error {
+	cfg, err := loadConfig(ch.Config)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.TODO()
+	client := s.client.Resource(dnsEndpointGVR).Namespace(ch.ResourceNamespace)
+	name, err := endpointName(ch.ResolvedFQDN)
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error getting DNSEndpoint %s/%s: %v", ch.ResourceNamespace, name, err)
+	}
+
+	targets, err := endpointTargets(existing, ch.ResolvedFQDN)
+	if err != nil {
+		return err
+	}
+
+	var remaining []string
+	for _, t := range targets {
+		if t != ch.Key {
+			remaining = append(remaining, t)
+		}
+	}
+	if len(remaining) == len(targets) {
+		return nil
+	}
+
+	if len(remaining) == 0 {
+		err := client.Delete(ctx, name, metav1.DeleteOptions{
+			Preconditions: &metav1.Preconditions{ResourceVersion: strPtr(existing.GetResourceVersion())},
+		})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("error deleting DNSEndpoint %s/%s: %v", ch.ResourceNamespace, name, err)
+		}
+		return nil
+	}
+
+	obj := buildDNSEndpoint(name, ch.ResourceNamespace, ch.ResolvedFQDN, cfg, remaining)
+	obj.SetResourceVersion(existing.GetResourceVersion())
+	if _, err := client.Update(ctx, obj, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("error updating DNSEndpoint %s/%s: %v", ch.ResourceNamespace, name, err)
+	}
+
+	return nil
+}
+
+func (s *Solver) Initialize(kubeClientConfig *restclient.Config, stopCh <-chan struct{}) error {
+	cl, err := dynamic.NewForConfig(kubeClientConfig)
+	if err != nil {
+		return err
+	}
+	s.client = cl
+	return nil
+}
+
+func loadConfig(cfgJSON *extapi.JSON) (*cmacme.ACMEIssuerDNS01ProviderExternalDNS, error) {
+	cfg := cmacme.ACMEIssuerDNS01ProviderExternalDNS{}
+	if cfgJSON == nil {
+		return &cfg, nil
+	}
+	if err := json.Unmarshal(cfgJSON.Raw, &cfg); err != nil {
+		return nil, fmt.Errorf("error decoding solver config: %v", err)
+	}
+
+	return &cfg, nil
+}
+
+// endpointName returns the name of the DNSEndpoint used for the given FQDN.
+func endpointName(fqdn string) (string, error) {
+	return apiutil.ComputeName("acme-challenge", util.UnFqdn(fqdn))
+}
+
+func buildDNSEndpoint(name, namespace, fqdn string, cfg *cmacme.ACMEIssuerDNS01ProviderExternalDNS, targets []string) *unstructured.Unstructured {
+	labels := make(map[string]string, len(cfg.Labels)+1)
+	for k, v := range cfg.Labels {
+		labels[k] = v
+	}
+	labels[SolverLabelKey] = "externaldns"
+
+	targetsI := make([]interface{}, len(targets))
+	for i, t := range targets {
+		targetsI[i] = t
+	}
+
+	obj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"spec": map[string]interface{}{
+				"endpoints": []interface{}{
+					map[string]interface{}{
+						"dnsName":    util.UnFqdn(fqdn),
+						"recordType": "TXT",
+						"recordTTL":  int64(recordTTL),
+						"targets":    targetsI,
+					},
+				},
+			},
+		},
+	}
+	obj.SetAPIVersion(dnsEndpointGVR.GroupVersion().String())
+	obj.SetKind("DNSEndpoint")
+	obj.SetName(name)
+	obj.SetNamespace(namespace)
+	obj.SetLabels(labels)
+	if len(cfg.Annotations) > 0 {
+		obj.SetAnnotations(cfg.Annotations)
+	}
+
+	return obj
+}
+
+// endpointTargets returns the TXT record values of an existing DNSEndpoint,
+// returning an error if the DNSEndpoint is not one that manages the given
+// FQDN.
+func endpointTargets(obj *unstructured.Unstructured, fqdn string) ([]string, error) {
+	endpoints, _, err := unstructured.NestedSlice(obj.Object, "spec", "endpoints")
+	if err != nil {
+		return nil, fmt.Errorf("error reading endpoints of DNSEndpoint %s/%s: %v", obj.GetNamespace(), obj.GetName(), err)
+	}
+
+	var targets []string
+	for _, e := range endpoints {
+		endpoint, ok := e.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		dnsName, _, _ := unstructured.NestedString(endpoint, "dnsName")
+		recordType, _, _ := unstructured.NestedString(endpoint, "recordType")
+		if util.ToFqdn(dnsName) != util.ToFqdn(fqdn) || recordType != "TXT" {
+			return nil, fmt.Errorf("DNSEndpoint %s/%s contains unexpected record %q of type %q", obj.GetNamespace(), obj.GetName(), dnsName, recordType)
+		}
+		t, _, err := unstructured.NestedStringSlice(endpoint, "targets")
+		if err != nil {
+			return nil, fmt.Errorf("error reading targets of DNSEndpoint %s/%s: %v", obj.GetNamespace(), obj.GetName(), err)
+		}
+		targets = append(targets, t...)
+	}
+
+	return targets, nil
+}
+
+func containsString(list []string, s string) bool {
+	for _, l := range list {
+		if l == s {
+			return true
+		}
+	}
+	return false
+}
+
+func strPtr(s string) *string {
+	return &s
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externaldns
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	extapi "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	whapi "github.com/jetstack/cert-manager/pkg/acme/webhook/apis/acme/v1alpha1"
+	cmacme "github.com/jetstack/cert-manager/pkg/apis/acme/v1"
+)
+
+const (
+	testNamespace = "cert-manager"
+	testFQDN      = "_acme-challenge.example.com."
+)
+
+func newTestSolver(t *testing.T, propagated map[string]bool) *Solver {
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		dnsEndpointGVR: "DNSEndpointList",
+	})
+	s := New(WithDNS01Nameservers([]string{"8.8.8.8:53"}))
+	s.client = client
+	s.pollingInterval = time.Millisecond
+	s.propagationTimeout = 50 * time.Millisecond
+	s.preCheckDNS = func(fqdn, value string, nameservers []string, useAuthoritative bool) (bool, error) {
+		return propagated[value], nil
+	}
+	return s
+}
+
+func newChallengeRequest(t *testing.T, key string, cfg cmacme.ACMEIssuerDNS01ProviderExternalDNS) *whapi.ChallengeRequest {
+	b, err := json.Marshal(cfg)
+	require.NoError(t, err)
+	return &whapi.ChallengeRequest{
+		ResourceNamespace: testNamespace,
+		ResolvedFQDN:      testFQDN,
+		ResolvedZone:      "example.com.",
+		DNSName:           "example.com",
+		Key:               key,
+		Config:            &extapi.JSON{Raw: b},
+	}
+}
+
+func getEndpoint(t *testing.T, s *Solver) (*unstructured.Unstructured, error) {
+	name, err := endpointName(testFQDN)
+	require.NoError(t, err)
+	return s.client.Resource(dnsEndpointGVR).Namespace(testNamespace).Get(context.TODO(), name, metav1.GetOptions{})
+}
+
+func TestPresentAndCleanUp(t *testing.T) {
+	s := newTestSolver(t, map[string]bool{"key1": true, "key2": true})
+	cfg := cmacme.ACMEIssuerDNS01ProviderExternalDNS{
+		Labels:      map[string]string{"dns": "public"},
+		Annotations: map[string]string{"example.com/owner": "cert-manager"},
+	}
+
+	require.NoError(t, s.Present(newChallengeRequest(t, "key1", cfg)))
+	obj, err := getEndpoint(t, s)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"dns": "public", SolverLabelKey: "externaldns"}, obj.GetLabels())
+	assert.Equal(t, cfg.Annotations, obj.GetAnnotations())
+	targets, err := endpointTargets(obj, testFQDN)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"key1"}, targets)
+
+	// Presenting the same key twice should be a no-op
+	require.NoError(t, s.Present(newChallengeRequest(t, "key1", cfg)))
+
+	// A second challenge for the same FQDN should share the DNSEndpoint
+	require.NoError(t, s.Present(newChallengeRequest(t, "key2", cfg)))
+	obj, err = getEndpoint(t, s)
+	require.NoError(t, err)
+	targets, err = endpointTargets(obj, testFQDN)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"key1", "key2"}, targets)
+
+	require.NoError(t, s.CleanUp(newChallengeRequest(t, "key1", cfg)))
+	obj, err = getEndpoint(t, s)
+	require.NoError(t, err)
+	targets, err = endpointTargets(obj, testFQDN)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"key2"}, targets)
+
+	require.NoError(t, s.CleanUp(newChallengeRequest(t, "key2", cfg)))
+	_, err = getEndpoint(t, s)
+	assert.True(t, apierrors.IsNotFound(err), "expected DNSEndpoint to be deleted, got %v", err)
+
+	// Cleaning up a challenge that no longer exists should succeed
+	require.NoError(t, s.CleanUp(newChallengeRequest(t, "key2", cfg)))
+}
+
+func TestPresentWaitsForPropagation(t *testing.T) {
+	s := newTestSolver(t, map[string]bool{})
+
+	err := s.Present(newChallengeRequest(t, "key1", cmacme.ACMEIssuerDNS01ProviderExternalDNS{}))
+	assert.Error(t, err)
+
+	// The DNSEndpoint should still have been created so that external-dns
+	// can publish the record before the challenge is retried.
+	_, err = getEndpoint(t, s)
+	assert.NoError(t, err)
+}
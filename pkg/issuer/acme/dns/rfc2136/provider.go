@@ -30,6 +30,7 @@ import (
 	whapi "github.com/jetstack/cert-manager/pkg/acme/webhook/apis/acme/v1alpha1"
 	cmacme "github.com/jetstack/cert-manager/pkg/apis/acme/v1"
 	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	dnsutil "github.com/jetstack/cert-manager/pkg/issuer/acme/dns/util"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
 )
 
@@ -90,6 +91,17 @@ func (s *Solver) CleanUp(ch *whapi.ChallengeRequest) error {
 	return nil
 }
 
+// ChangeRecords applies a number of TXT record changes for challenges that
+// share the same solver config and zone as ch using a single dynamic update.
+func (s *Solver) ChangeRecords(ch *whapi.ChallengeRequest, changes []dnsutil.TXTChange) error {
+	p, err := s.buildDNSProvider(ch)
+	if err != nil {
+		return err
+	}
+
+	return p.ChangeRecords(ch.ResolvedZone, changes)
+}
+
 func (s *Solver) Initialize(kubeClientConfig *restclient.Config, stopCh <-chan struct{}) error {
 	cl, err := kubernetes.NewForConfig(kubeClientConfig)
 	if err != nil {
@@ -157,5 +169,20 @@ func (s *Solver) buildDNSProvider(ch *whapi.ChallengeRequest) (*DNSProvider, err
 		key = string(secret)
 	}
 
-	return NewDNSProviderCredentials(cfg.Nameserver, cfg.TSIGAlgorithm, cfg.TSIGKeyName, key)
+	var opts []ProviderOption
+	if len(cfg.FailoverNameservers) > 0 {
+		opts = append(opts, WithFailoverNameservers(cfg.FailoverNameservers))
+	}
+	if cfg.SIG0 != nil {
+		privateKey, err := loadSecretKeySelector(l, cfg.SIG0.PrivateKey, "")
+		if err != nil {
+			return nil, err
+		}
+		if len(privateKey) == 0 {
+			return nil, fmt.Errorf("no SIG(0) private key found in secret %q", cfg.SIG0.PrivateKey.Name)
+		}
+		opts = append(opts, WithSIG0(cfg.SIG0.PublicKey, privateKey))
+	}
+
+	return NewDNSProviderCredentials(cfg.Nameserver, cfg.TSIGAlgorithm, cfg.TSIGKeyName, key, opts...)
 }
@@ -22,6 +22,8 @@ limitations under the License.
 package rfc2136
 
 import (
+	"crypto"
+	"encoding/binary"
 	"fmt"
 	"strings"
 	"time"
@@ -31,10 +33,19 @@ import (
 	"github.com/miekg/dns"
 
 	"github.com/jetstack/cert-manager/pkg/internal/apis/certmanager/validation/util"
+	dnsutil "github.com/jetstack/cert-manager/pkg/issuer/acme/dns/util"
 )
 
 var defaultPort = "53"
 
+// sig0Fudge is the amount of time either side of the current time that a
+// SIG(0) signature is valid for, to allow for clock skew with the nameserver.
+const sig0Fudge = 300 * time.Second
+
+// updateTimeout is the maximum amount of time to wait for a reply to a SIG(0)
+// signed update from a nameserver.
+const updateTimeout = 10 * time.Second
+
 // This list must be kept in sync with pkg/apis/certmanager/validation/issuer.go
 var supportedAlgorithms = map[string]string{
 	"HMACMD5":    dns.HmacMD5,
@@ -46,17 +57,67 @@ var supportedAlgorithms = map[string]string{
 // DNSProvider is an implementation of the acme.ChallengeProvider interface that
 // uses dynamic DNS updates (RFC 2136) to create TXT records on a nameserver.
 type DNSProvider struct {
-	nameserver    string
-	tsigAlgorithm string
-	tsigKeyName   string
-	tsigSecret    string
+	nameserver          string
+	failoverNameservers []string
+	tsigAlgorithm       string
+	tsigKeyName         string
+	tsigSecret          string
+	sig0Key             *dns.KEY
+	sig0Signer          crypto.Signer
+}
+
+// ProviderOption configures optional behaviour of a DNSProvider.
+type ProviderOption func(*DNSProvider) error
+
+// WithFailoverNameservers configures additional nameservers that updates are
+// sent to, in order, if an update cannot be applied by the primary
+// nameserver. Each nameserver must be in the same form as the primary.
+func WithFailoverNameservers(nameservers []string) ProviderOption {
+	return func(d *DNSProvider) error {
+		for _, ns := range nameservers {
+			validNameserver, err := util.ValidNameserver(ns)
+			if err != nil {
+				return err
+			}
+			d.failoverNameservers = append(d.failoverNameservers, validNameserver)
+		}
+		return nil
+	}
+}
+
+// WithSIG0 configures updates to be signed using SIG(0) (RFC2931) rather
+// than TSIG. publicKey is a KEY resource record in zone file format, and
+// privateKey is the matching private key in the BIND private key format, as
+// generated by 'dnssec-keygen -T KEY'.
+func WithSIG0(publicKey string, privateKey []byte) ProviderOption {
+	return func(d *DNSProvider) error {
+		rr, err := dns.NewRR(publicKey)
+		if err != nil {
+			return fmt.Errorf("error parsing SIG(0) public key: %v", err)
+		}
+		key, ok := rr.(*dns.KEY)
+		if !ok {
+			return fmt.Errorf("SIG(0) public key must be a KEY record")
+		}
+		priv, err := key.ReadPrivateKey(strings.NewReader(string(privateKey)), "")
+		if err != nil {
+			return fmt.Errorf("error parsing SIG(0) private key: %v", err)
+		}
+		signer, ok := priv.(crypto.Signer)
+		if !ok {
+			return fmt.Errorf("SIG(0) private key of type %T cannot be used for signing", priv)
+		}
+		d.sig0Key = key
+		d.sig0Signer = signer
+		return nil
+	}
 }
 
 // NewDNSProviderCredentials uses the supplied credentials to return a
 // DNSProvider instance configured for rfc2136 dynamic update. To disable TSIG
 // authentication, leave the TSIG parameters as empty strings.
 // nameserver must be a network address in the form "IP" or "IP:port".
-func NewDNSProviderCredentials(nameserver, tsigAlgorithm, tsigKeyName, tsigSecret string) (*DNSProvider, error) {
+func NewDNSProviderCredentials(nameserver, tsigAlgorithm, tsigKeyName, tsigSecret string, opts ...ProviderOption) (*DNSProvider, error) {
 	logf.Log.V(logf.DebugLevel).Info("Creating RFC2136 Provider")
 
 	d := &DNSProvider{}
@@ -84,7 +145,18 @@ func NewDNSProviderCredentials(nameserver, tsigAlgorithm, tsigKeyName, tsigSecre
 	}
 	d.tsigAlgorithm = tsigAlgorithm
 
+	for _, o := range opts {
+		if err := o(d); err != nil {
+			return nil, err
+		}
+	}
+
+	if d.sig0Key != nil && len(d.tsigKeyName) > 0 {
+		return nil, fmt.Errorf("SIG(0) and TSIG cannot both be used to sign updates")
+	}
+
 	logf.V(logf.DebugLevel).Infof("DNSProvider nameserver:       %s\n", d.nameserver)
+	logf.V(logf.DebugLevel).Infof("            failoverNameservers: %v\n", d.failoverNameservers)
 	logf.V(logf.DebugLevel).Infof("            tsigAlgorithm:    %s\n", d.tsigAlgorithm)
 	logf.V(logf.DebugLevel).Infof("            tsigKeyName:      %s\n", d.tsigKeyName)
 	keyLen := len(d.tsigSecret)
@@ -94,6 +166,9 @@ func NewDNSProviderCredentials(nameserver, tsigAlgorithm, tsigKeyName, tsigSecre
 	}
 	masked := d.tsigSecret[0:keyLen/4] + string(mask) + d.tsigSecret[keyLen/4*3:keyLen]
 	logf.V(logf.DebugLevel).Infof("            tsigSecret:       %s\n", masked)
+	if d.sig0Key != nil {
+		logf.V(logf.DebugLevel).Infof("            sig0KeyName:      %s\n", d.sig0Key.Hdr.Name)
+	}
 
 	return d, nil
 }
@@ -108,12 +183,29 @@ func (r *DNSProvider) CleanUp(_, fqdn, zone, value string) error {
 	return r.changeRecord("REMOVE", fqdn, zone, value, 60)
 }
 
+// ChangeRecords applies a number of TXT record changes within the given zone
+// using a single dynamic update.
+func (r *DNSProvider) ChangeRecords(zone string, changes []dnsutil.TXTChange) error {
+	m := new(dns.Msg)
+	m.SetUpdate(zone)
+	for _, c := range changes {
+		rrs := []dns.RR{newTXTRecord(c.FQDN, c.Value, 60)}
+		switch c.Action {
+		case dnsutil.ChangeActionPresent:
+			m.Insert(rrs)
+		case dnsutil.ChangeActionCleanUp:
+			m.Remove(rrs)
+		default:
+			return fmt.Errorf("unexpected action: %s", c.Action)
+		}
+	}
+
+	return r.sendUpdate(m)
+}
+
 func (r *DNSProvider) changeRecord(action, fqdn, zone, value string, ttl int) error {
 	// Create RR
-	rr := new(dns.TXT)
-	rr.Hdr = dns.RR_Header{Name: fqdn, Rrtype: dns.TypeTXT, Class: dns.ClassINET, Ttl: uint32(ttl)}
-	rr.Txt = []string{value}
-	rrs := []dns.RR{rr}
+	rrs := []dns.RR{newTXTRecord(fqdn, value, ttl)}
 
 	// Create dynamic update packet
 	m := new(dns.Msg)
@@ -127,6 +219,18 @@ func (r *DNSProvider) changeRecord(action, fqdn, zone, value string, ttl int) er
 		return fmt.Errorf("unexpected action: %s", action)
 	}
 
+	return r.sendUpdate(m)
+}
+
+func newTXTRecord(fqdn, value string, ttl int) *dns.TXT {
+	rr := new(dns.TXT)
+	rr.Hdr = dns.RR_Header{Name: fqdn, Rrtype: dns.TypeTXT, Class: dns.ClassINET, Ttl: uint32(ttl)}
+	rr.Txt = []string{value}
+	return rr
+}
+
+// sendUpdate signs and sends the dynamic update to the nameservers.
+func (r *DNSProvider) sendUpdate(m *dns.Msg) error {
 	// Setup client
 	c := new(dns.Client)
 	c.SingleInflight = true
@@ -136,14 +240,91 @@ func (r *DNSProvider) changeRecord(action, fqdn, zone, value string, ttl int) er
 		c.TsigSecret = map[string]string{dns.Fqdn(r.tsigKeyName): r.tsigSecret}
 	}
 
-	// Send the query
-	reply, _, err := c.Exchange(m, r.nameserver)
-	if err != nil {
-		return fmt.Errorf("DNS update failed: %v", err)
+	// SIG(0) signing. The signature covers the packed message, so the signed
+	// bytes are sent as-is rather than being repacked by the client.
+	var signed []byte
+	if r.sig0Key != nil {
+		var err error
+		if signed, err = r.sig0Sign(m); err != nil {
+			return fmt.Errorf("error signing DNS update: %v", err)
+		}
+	}
+
+	// Send the update to each nameserver in turn until one succeeds
+	var errs []string
+	for _, ns := range append([]string{r.nameserver}, r.failoverNameservers...) {
+		var reply *dns.Msg
+		var err error
+		if signed != nil {
+			reply, err = exchangeRaw(c, signed, ns)
+		} else {
+			reply, _, err = c.Exchange(m, ns)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", ns, err))
+			continue
+		}
+		if reply != nil && reply.Rcode != dns.RcodeSuccess {
+			errs = append(errs, fmt.Sprintf("%s: Server replied: %s", ns, dns.RcodeToString[reply.Rcode]))
+			continue
+		}
+		if len(errs) > 0 {
+			logf.V(logf.DebugLevel).Infof("DNS update succeeded using failover nameserver %s after errors: %s", ns, strings.Join(errs, "; "))
+		}
+		return nil
+	}
+
+	if len(errs) == 1 && len(r.failoverNameservers) == 0 {
+		return fmt.Errorf("DNS update failed. %s", errs[0])
 	}
-	if reply != nil && reply.Rcode != dns.RcodeSuccess {
-		return fmt.Errorf("DNS update failed. Server replied: %s", dns.RcodeToString[reply.Rcode])
+	return fmt.Errorf("DNS update failed on all nameservers: %s", strings.Join(errs, "; "))
+}
+
+// sig0Sign returns the wire format of m signed with the SIG(0) key.
+func (r *DNSProvider) sig0Sign(m *dns.Msg) ([]byte, error) {
+	now := time.Now()
+	sig := &dns.SIG{
+		RRSIG: dns.RRSIG{
+			Algorithm:  r.sig0Key.Algorithm,
+			KeyTag:     r.sig0Key.KeyTag(),
+			SignerName: dns.Fqdn(r.sig0Key.Hdr.Name),
+			Inception:  uint32(now.Add(-sig0Fudge).Unix()),
+			Expiration: uint32(now.Add(sig0Fudge).Unix()),
+		},
+	}
+	return sig.Sign(r.sig0Signer, m)
+}
+
+// exchangeRaw sends an already packed message to the nameserver and waits
+// for a reply. Replies whose message ID does not match the query are
+// discarded, so that a stray or spoofed datagram cannot be mistaken for the
+// server's answer.
+func exchangeRaw(c *dns.Client, msg []byte, nameserver string) (*dns.Msg, error) {
+	if len(msg) < 2 {
+		return nil, dns.ErrShortRead
+	}
+	id := binary.BigEndian.Uint16(msg)
+
+	co, err := c.Dial(nameserver)
+	if err != nil {
+		return nil, err
 	}
+	defer co.Close()
 
-	return nil
+	if err := co.SetDeadline(time.Now().Add(updateTimeout)); err != nil {
+		return nil, err
+	}
+	if _, err := co.Write(msg); err != nil {
+		return nil, err
+	}
+	for {
+		reply, err := co.ReadMsg()
+		if err != nil {
+			return nil, err
+		}
+		if reply.Id == id {
+			return reply, nil
+		}
+		logf.V(logf.DebugLevel).Infof("Discarding DNS reply from %s with unexpected message ID %d, expected %d", nameserver, reply.Id, id)
+	}
 }
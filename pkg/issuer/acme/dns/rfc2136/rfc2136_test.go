@@ -24,6 +24,7 @@ package rfc2136
 import (
 	"context"
 	"fmt"
+	"net"
 	"strings"
 	"testing"
 	"time"
@@ -32,6 +33,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	dnsutil "github.com/jetstack/cert-manager/pkg/issuer/acme/dns/util"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
 	testserver "github.com/jetstack/cert-manager/test/acme/dns/server"
 )
@@ -328,6 +330,192 @@ func TestRFC2136ValidUpdatePacket(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestRFC2136FailoverNameservers(t *testing.T) {
+	ctx := logf.NewContext(context.TODO(), nil, t.Name())
+	failing := &testserver.BasicServer{
+		Zones:   []string{rfc2136TestZone},
+		Handler: dns.HandlerFunc((&testHandlers{t: t}).serverHandlerReturnErr),
+	}
+	if err := failing.Run(ctx); err != nil {
+		t.Fatalf("failed to start test server: %v", err)
+	}
+	defer failing.Shutdown()
+	server := &testserver.BasicServer{
+		Zones:   []string{rfc2136TestZone},
+		Handler: dns.HandlerFunc((&testHandlers{t: t}).serverHandlerReturnSuccess),
+	}
+	if err := server.Run(ctx); err != nil {
+		t.Fatalf("failed to start test server: %v", err)
+	}
+	defer server.Shutdown()
+
+	provider, err := NewDNSProviderCredentials(failing.ListenAddr(), "", "", "", WithFailoverNameservers([]string{server.ListenAddr()}))
+	require.NoError(t, err)
+	assert.NoError(t, provider.Present(rfc2136TestDomain, "_acme-challenge."+rfc2136TestDomain+".", rfc2136TestDomain+".", rfc2136TestKeyAuth))
+
+	// If every nameserver fails then all errors should be returned
+	provider, err = NewDNSProviderCredentials(failing.ListenAddr(), "", "", "", WithFailoverNameservers([]string{failing.ListenAddr()}))
+	require.NoError(t, err)
+	err = provider.Present(rfc2136TestDomain, "_acme-challenge."+rfc2136TestDomain+".", rfc2136TestDomain+".", rfc2136TestKeyAuth)
+	if assert.Error(t, err) {
+		assert.Equal(t, 2, strings.Count(err.Error(), "NOTZONE"), "expected an error for each nameserver, got: %v", err)
+	}
+}
+
+func TestRFC2136InvalidFailoverNameserver(t *testing.T) {
+	_, err := NewDNSProviderCredentials("127.0.0.1:0", "", "", "", WithFailoverNameservers([]string{":53"}))
+	assert.Error(t, err)
+}
+
+func TestRFC2136ChangeRecords(t *testing.T) {
+	ctx := logf.NewContext(context.TODO(), nil, t.Name())
+	var updates []*dns.Msg
+	server := &testserver.BasicServer{
+		Zones: []string{rfc2136TestZone},
+		Handler: dns.HandlerFunc(func(w dns.ResponseWriter, req *dns.Msg) {
+			if req.Opcode == dns.OpcodeUpdate {
+				updates = append(updates, req)
+			}
+			(&testHandlers{t: t}).serverHandlerReturnSuccess(w, req)
+		}),
+	}
+	if err := server.Run(ctx); err != nil {
+		t.Fatalf("failed to start test server: %v", err)
+	}
+	defer server.Shutdown()
+
+	provider, err := NewDNSProviderCredentials(server.ListenAddr(), "", "", "")
+	require.NoError(t, err)
+	err = provider.ChangeRecords(rfc2136TestZone, []dnsutil.TXTChange{
+		{Action: dnsutil.ChangeActionPresent, FQDN: "_acme-challenge.a.example.com.", Value: "key1"},
+		{Action: dnsutil.ChangeActionPresent, FQDN: "_acme-challenge.b.example.com.", Value: "key2"},
+		{Action: dnsutil.ChangeActionCleanUp, FQDN: "_acme-challenge.c.example.com.", Value: "key3"},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, updates, 1, "expected all changes to be sent in a single update")
+	require.Len(t, updates[0].Ns, 3)
+	assert.Equal(t, uint16(dns.ClassINET), updates[0].Ns[0].Header().Class)
+	assert.Equal(t, uint16(dns.ClassINET), updates[0].Ns[1].Header().Class)
+	assert.Equal(t, uint16(dns.ClassNONE), updates[0].Ns[2].Header().Class)
+}
+
+func generateSIG0Key(t *testing.T) (string, []byte) {
+	key := &dns.KEY{DNSKEY: dns.DNSKEY{
+		Hdr:       dns.RR_Header{Name: rfc2136TestZone, Rrtype: dns.TypeKEY, Class: dns.ClassINET, Ttl: 3600},
+		Flags:     256,
+		Protocol:  3,
+		Algorithm: dns.ECDSAP256SHA256,
+	}}
+	priv, err := key.Generate(256)
+	require.NoError(t, err)
+	return key.String(), []byte(key.PrivateKeyString(priv))
+}
+
+func TestRFC2136SIG0Signing(t *testing.T) {
+	publicKey, privateKey := generateSIG0Key(t)
+	provider, err := NewDNSProviderCredentials("127.0.0.1:0", "", "", "", WithSIG0(publicKey, privateKey))
+	require.NoError(t, err)
+
+	m := new(dns.Msg)
+	m.SetUpdate(rfc2136TestZone)
+	txtRR, _ := dns.NewRR(fmt.Sprintf("%s %d IN TXT %s", rfc2136TestFqdn, rfc2136TestTTL, rfc2136TestValue))
+	m.Insert([]dns.RR{txtRR})
+
+	buf, err := provider.sig0Sign(m)
+	require.NoError(t, err)
+
+	signed := new(dns.Msg)
+	require.NoError(t, signed.Unpack(buf))
+	require.NotEmpty(t, signed.Extra)
+	sig, ok := signed.Extra[len(signed.Extra)-1].(*dns.SIG)
+	require.True(t, ok, "expected last additional record to be a SIG")
+	assert.Equal(t, rfc2136TestZone, sig.SignerName)
+	assert.NoError(t, sig.Verify(provider.sig0Key, buf))
+}
+
+func TestRFC2136SIG0Client(t *testing.T) {
+	ctx := logf.NewContext(context.TODO(), nil, t.Name())
+	var gotSIG *dns.SIG
+	server := &testserver.BasicServer{
+		Zones: []string{rfc2136TestZone},
+		Handler: dns.HandlerFunc(func(w dns.ResponseWriter, req *dns.Msg) {
+			if len(req.Extra) > 0 {
+				gotSIG, _ = req.Extra[len(req.Extra)-1].(*dns.SIG)
+			}
+			(&testHandlers{t: t}).serverHandlerReturnSuccess(w, req)
+		}),
+	}
+	if err := server.Run(ctx); err != nil {
+		t.Fatalf("failed to start test server: %v", err)
+	}
+	defer server.Shutdown()
+
+	publicKey, privateKey := generateSIG0Key(t)
+	provider, err := NewDNSProviderCredentials(server.ListenAddr(), "", "", "", WithSIG0(publicKey, privateKey))
+	require.NoError(t, err)
+	require.NoError(t, provider.Present(rfc2136TestDomain, "_acme-challenge."+rfc2136TestDomain+".", rfc2136TestDomain+".", rfc2136TestKeyAuth))
+	if assert.NotNil(t, gotSIG, "expected update to be signed with SIG(0)") {
+		assert.Equal(t, provider.sig0Key.KeyTag(), gotSIG.KeyTag)
+	}
+}
+
+func TestRFC2136ExchangeRawDiscardsMismatchedID(t *testing.T) {
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer pc.Close()
+
+	go func() {
+		buf := make([]byte, dns.MaxMsgSize)
+		n, addr, err := pc.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+		req := new(dns.Msg)
+		if err := req.Unpack(buf[:n]); err != nil {
+			return
+		}
+
+		// A reply with the wrong ID must be ignored by the client.
+		spoofed := new(dns.Msg)
+		spoofed.SetRcode(req, dns.RcodeRefused)
+		spoofed.Id = req.Id + 1
+		if b, err := spoofed.Pack(); err == nil {
+			pc.WriteTo(b, addr)
+		}
+
+		reply := new(dns.Msg)
+		reply.SetReply(req)
+		if b, err := reply.Pack(); err == nil {
+			pc.WriteTo(b, addr)
+		}
+	}()
+
+	m := new(dns.Msg)
+	m.SetUpdate(rfc2136TestZone)
+	msg, err := m.Pack()
+	require.NoError(t, err)
+
+	reply, err := exchangeRaw(new(dns.Client), msg, pc.LocalAddr().String())
+	require.NoError(t, err)
+	assert.Equal(t, m.Id, reply.Id)
+	assert.Equal(t, dns.RcodeSuccess, reply.Rcode)
+}
+
+func TestRFC2136SIG0WithTSIG(t *testing.T) {
+	publicKey, privateKey := generateSIG0Key(t)
+	_, err := NewDNSProviderCredentials("127.0.0.1:0", "", rfc2136TestTsigKeyName, rfc2136TestTsigSecret, WithSIG0(publicKey, privateKey))
+	assert.Error(t, err)
+}
+
+func TestRFC2136SIG0InvalidKey(t *testing.T) {
+	publicKey, _ := generateSIG0Key(t)
+	_, err := NewDNSProviderCredentials("127.0.0.1:0", "", "", "", WithSIG0(publicKey, []byte("not a key")))
+	assert.Error(t, err)
+	_, err = NewDNSProviderCredentials("127.0.0.1:0", "", "", "", WithSIG0("example.com. IN TXT \"not a key\"", nil))
+	assert.Error(t, err)
+}
+
 // testHandlers provides DNS server handlers for use in tests and has a
 // reference to testing.T so that the handlers (which do not return errors) can
 // make test assertions and fail tests.
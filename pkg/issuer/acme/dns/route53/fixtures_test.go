@@ -75,3 +75,25 @@ var ChangeResourceRecordSets403Response = `<?xml version="1.0"?>
   </Error>
   <RequestId>SOMEREQUESTID</RequestId>
 </ErrorResponse>`
+
+var ChangeResourceRecordSetsInvalidChangeBatchResponse = `<?xml version="1.0"?>
+<InvalidChangeBatch xmlns="https://route53.amazonaws.com/doc/2013-04-01/">
+  <Messages>
+    <Message>Tried to delete resource record set [name='_acme-challenge.c.example.com.', type='TXT'] but it was not found</Message>
+  </Messages>
+  <RequestId>SOMEREQUESTID</RequestId>
+</InvalidChangeBatch>`
+
+var ListResourceRecordSetsResponse = `<?xml version="1.0" encoding="UTF-8"?>
+<ListResourceRecordSetsResponse xmlns="https://route53.amazonaws.com/doc/2013-04-01/">
+   <ResourceRecordSets>
+      <ResourceRecordSet>
+         <Name>%s</Name>
+         <Type>TXT</Type>
+         <TTL>10</TTL>
+         <ResourceRecords>%s</ResourceRecords>
+      </ResourceRecordSet>
+   </ResourceRecordSets>
+   <IsTruncated>true</IsTruncated>
+   <MaxItems>1</MaxItems>
+</ListResourceRecordSetsResponse>`
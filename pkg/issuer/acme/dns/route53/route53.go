@@ -180,32 +180,173 @@ func (r *DNSProvider) changeRecord(action, fqdn, value string, ttl int) error {
 		return fmt.Errorf("failed to determine Route 53 hosted zone ID: %v", err)
 	}
 
-	recordSet := newTXTRecordSet(fqdn, value, ttl)
+	return r.changeRecordSets(hostedZoneID, action, []*route53.ResourceRecordSet{newTXTRecordSet(fqdn, ttl, value)})
+}
+
+// ChangeRecords applies a number of TXT record changes using a single Route
+// 53 change batch per hosted zone and action. Values for the same FQDN are
+// merged into a single record set.
+func (r *DNSProvider) ChangeRecords(changes []util.TXTChange) error {
+	type batchKey struct {
+		hostedZoneID string
+		action       string
+	}
+	var keys []batchKey
+	values := make(map[batchKey]map[string][]string)
+	fqdns := make(map[batchKey][]string)
+	for _, c := range changes {
+		hostedZoneID, err := r.getHostedZoneID(c.FQDN)
+		if err != nil {
+			return fmt.Errorf("failed to determine Route 53 hosted zone ID: %v", err)
+		}
+		action := route53.ChangeActionUpsert
+		if c.Action == util.ChangeActionCleanUp {
+			action = route53.ChangeActionDelete
+		}
+		k := batchKey{hostedZoneID: hostedZoneID, action: action}
+		if _, ok := values[k]; !ok {
+			keys = append(keys, k)
+			values[k] = make(map[string][]string)
+		}
+		if _, ok := values[k][c.FQDN]; !ok {
+			fqdns[k] = append(fqdns[k], c.FQDN)
+		}
+		value := `"` + c.Value + `"`
+		if !pkgutil.Contains(values[k][c.FQDN], value) {
+			values[k][c.FQDN] = append(values[k][c.FQDN], value)
+		}
+	}
+
+	var errs []string
+	for _, k := range keys {
+		var recordSets []*route53.ResourceRecordSet
+		for _, fqdn := range fqdns[k] {
+			recordSets = append(recordSets, newTXTRecordSet(fqdn, route53TTL, values[k][fqdn]...))
+		}
+		if err := r.changeRecordSets(k.hostedZoneID, k.action, recordSets); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+// changeRecordSets applies the given action to all of the record sets in a
+// single change batch, and waits for the change to be in sync.
+func (r *DNSProvider) changeRecordSets(hostedZoneID, action string, recordSets []*route53.ResourceRecordSet) error {
+	err := r.submitChange(hostedZoneID, action, recordSets)
+	if awserr, ok := err.(awserr.Error); ok {
+		if action == route53.ChangeActionDelete && awserr.Code() == route53.ErrCodeInvalidChangeBatch {
+			// A DELETE only succeeds if it exactly matches the current record
+			// set, and the whole batch is rejected if any of its changes
+			// fail. This happens if a record set was already deleted, or if it
+			// has other values too, e.g. for another challenge for the same
+			// FQDN. Delete the values from each record set individually so
+			// that one record set cannot stop the others from being cleaned up.
+			r.log.V(logf.DebugLevel).WithValues("error", err).Info("deleting values from each record set individually after InvalidChangeBatch error")
+			return r.deleteRecordSetsValues(hostedZoneID, recordSets)
+		}
+		return fmt.Errorf("failed to change Route 53 record set: %v", removeReqID(err))
+	}
+	return err
+}
+
+// deleteRecordSetsValues deletes the values of each of the given record sets
+// from the current record set with the same name and type.
+func (r *DNSProvider) deleteRecordSetsValues(hostedZoneID string, recordSets []*route53.ResourceRecordSet) error {
+	var errs []string
+	for _, recordSet := range recordSets {
+		if err := r.deleteRecordSetValues(hostedZoneID, recordSet); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// deleteRecordSetValues reads the current record set with the same name and
+// type as the given record set, and removes the given record set's values
+// from it. The record set is updated to the remaining values, or deleted if
+// no values remain. Values that do not exist are ignored.
+func (r *DNSProvider) deleteRecordSetValues(hostedZoneID string, recordSet *route53.ResourceRecordSet) error {
+	resp, err := r.client.ListResourceRecordSets(&route53.ListResourceRecordSetsInput{
+		HostedZoneId:    aws.String(hostedZoneID),
+		StartRecordName: recordSet.Name,
+		StartRecordType: recordSet.Type,
+		MaxItems:        aws.String("1"),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list Route 53 record sets: %v", removeReqID(err))
+	}
+
+	var current *route53.ResourceRecordSet
+	for _, rs := range resp.ResourceRecordSets {
+		if strings.EqualFold(util.ToFqdn(aws.StringValue(rs.Name)), util.ToFqdn(aws.StringValue(recordSet.Name))) &&
+			aws.StringValue(rs.Type) == aws.StringValue(recordSet.Type) {
+			current = rs
+		}
+	}
+	if current == nil {
+		// the record set has already been deleted
+		return nil
+	}
+
+	deleted := make(map[string]bool)
+	for _, rr := range recordSet.ResourceRecords {
+		deleted[aws.StringValue(rr.Value)] = true
+	}
+	var remaining []*route53.ResourceRecord
+	for _, rr := range current.ResourceRecords {
+		if !deleted[aws.StringValue(rr.Value)] {
+			remaining = append(remaining, rr)
+		}
+	}
+
+	switch {
+	case len(remaining) == len(current.ResourceRecords):
+		// none of the values exist
+		return nil
+	case len(remaining) == 0:
+		err = r.submitChange(hostedZoneID, route53.ChangeActionDelete, []*route53.ResourceRecordSet{current})
+	default:
+		updated := *current
+		updated.ResourceRecords = remaining
+		err = r.submitChange(hostedZoneID, route53.ChangeActionUpsert, []*route53.ResourceRecordSet{&updated})
+	}
+	if _, ok := err.(awserr.Error); ok {
+		return fmt.Errorf("failed to change Route 53 record set: %v", removeReqID(err))
+	}
+	return err
+}
+
+// submitChange applies the given action to all of the record sets in a
+// single change batch, and waits for the change to be in sync. Errors from
+// submitting the change are returned unchanged so that callers can inspect
+// their error code.
+func (r *DNSProvider) submitChange(hostedZoneID, action string, recordSets []*route53.ResourceRecordSet) error {
+	var changes []*route53.Change
+	for _, recordSet := range recordSets {
+		changes = append(changes, &route53.Change{
+			Action:            aws.String(action),
+			ResourceRecordSet: recordSet,
+		})
+	}
 	reqParams := &route53.ChangeResourceRecordSetsInput{
 		HostedZoneId: aws.String(hostedZoneID),
 		ChangeBatch: &route53.ChangeBatch{
 			Comment: aws.String("Managed by cert-manager"),
-			Changes: []*route53.Change{
-				{
-					Action:            &action,
-					ResourceRecordSet: recordSet,
-				},
-			},
+			Changes: changes,
 		},
 	}
 
 	resp, err := r.client.ChangeResourceRecordSets(reqParams)
 	if err != nil {
-		if awserr, ok := err.(awserr.Error); ok {
-			if action == route53.ChangeActionDelete && awserr.Code() == route53.ErrCodeInvalidChangeBatch {
-				r.log.V(logf.DebugLevel).WithValues("error", err).Info("ignoring InvalidChangeBatch error")
-				// If we try to delete something and get a 'InvalidChangeBatch' that
-				// means it's already deleted, no need to consider it an error.
-				return nil
-			}
-		}
-		return fmt.Errorf("failed to change Route 53 record set: %v", removeReqID(err))
-
+		return err
 	}
 
 	statusID := resp.ChangeInfo.Id
@@ -271,14 +412,16 @@ func (r *DNSProvider) getHostedZoneID(fqdn string) (string, error) {
 	return hostedZoneID, nil
 }
 
-func newTXTRecordSet(fqdn, value string, ttl int) *route53.ResourceRecordSet {
+func newTXTRecordSet(fqdn string, ttl int, values ...string) *route53.ResourceRecordSet {
+	var records []*route53.ResourceRecord
+	for _, value := range values {
+		records = append(records, &route53.ResourceRecord{Value: aws.String(value)})
+	}
 	return &route53.ResourceRecordSet{
-		Name: aws.String(fqdn),
-		Type: aws.String(route53.RRTypeTxt),
-		TTL:  aws.Int64(int64(ttl)),
-		ResourceRecords: []*route53.ResourceRecord{
-			{Value: aws.String(value)},
-		},
+		Name:            aws.String(fqdn),
+		Type:            aws.String(route53.RRTypeTxt),
+		TTL:             aws.Int64(int64(ttl)),
+		ResourceRecords: records,
 	}
 }
 
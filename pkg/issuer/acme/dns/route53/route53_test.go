@@ -11,8 +11,12 @@ package route53
 import (
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
+	"sync"
 	"testing"
 
 	logf "github.com/jetstack/cert-manager/pkg/logs"
@@ -61,7 +65,7 @@ func makeRoute53Provider(ts *httptest.Server) (*DNSProvider, error) {
 		return nil, err
 	}
 	client := route53.New(sess)
-	return &DNSProvider{client: client, dns01Nameservers: util.RecursiveNameservers}, nil
+	return &DNSProvider{client: client, dns01Nameservers: util.RecursiveNameservers, log: logf.Log.WithName("route53")}, nil
 }
 
 func TestAmbientCredentialsFromEnv(t *testing.T) {
@@ -149,6 +153,138 @@ func TestRoute53Present(t *testing.T) {
 	assert.Equal(t, `failed to change Route 53 record set: AccessDenied: User: arn:aws:iam::0123456789:user/test-cert-manager is not authorized to perform: route53:ChangeResourceRecordSets on resource: arn:aws:route53:::hostedzone/OPQRSTU`, err.Error())
 }
 
+func TestRoute53ChangeRecords(t *testing.T) {
+	var lock sync.Mutex
+	var changeBodies []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/xml")
+		switch r.URL.Path {
+		case "/2013-04-01/hostedzone/ABCDEFG/rrset/":
+			b, err := ioutil.ReadAll(r.Body)
+			require.NoError(t, err)
+			lock.Lock()
+			changeBodies = append(changeBodies, string(b))
+			lock.Unlock()
+			_, _ = w.Write([]byte(ChangeResourceRecordSetsResponse))
+		case "/2013-04-01/change/123456":
+			_, _ = w.Write([]byte(GetChangeResponse))
+		default:
+			require.FailNow(t, fmt.Sprintf("Requested path not found in response map: %s", r.URL.Path))
+		}
+	}))
+	defer ts.Close()
+
+	provider, err := makeRoute53Provider(ts)
+	require.NoError(t, err, "Expected to make a Route 53 provider without error")
+	provider.hostedZoneID = "ABCDEFG"
+
+	err = provider.ChangeRecords([]util.TXTChange{
+		{Action: util.ChangeActionPresent, Domain: "example.com", FQDN: "_acme-challenge.example.com.", Value: "key1"},
+		{Action: util.ChangeActionPresent, Domain: "example.com", FQDN: "_acme-challenge.example.com.", Value: "key2"},
+		{Action: util.ChangeActionPresent, Domain: "foo.example.com", FQDN: "_acme-challenge.foo.example.com.", Value: "key3"},
+		{Action: util.ChangeActionPresent, Domain: "foo.example.com", FQDN: "_acme-challenge.foo.example.com.", Value: "key3"},
+	})
+	require.NoError(t, err)
+
+	// All changes should have been made in a single request, with the values
+	// for the same FQDN merged into a single record set.
+	require.Len(t, changeBodies, 1)
+	assert.Equal(t, 2, strings.Count(changeBodies[0], "<Action>UPSERT</Action>"))
+	assert.Equal(t, 1, strings.Count(changeBodies[0], "&#34;key3&#34;"))
+	for _, v := range []string{"_acme-challenge.example.com.", "_acme-challenge.foo.example.com.", "&#34;key1&#34;", "&#34;key2&#34;"} {
+		assert.Contains(t, changeBodies[0], v)
+	}
+
+	changeBodies = nil
+	err = provider.ChangeRecords([]util.TXTChange{
+		{Action: util.ChangeActionPresent, Domain: "example.com", FQDN: "_acme-challenge.example.com.", Value: "key1"},
+		{Action: util.ChangeActionCleanUp, Domain: "foo.example.com", FQDN: "_acme-challenge.foo.example.com.", Value: "key3"},
+	})
+	require.NoError(t, err)
+
+	// Different actions are sent in separate requests
+	require.Len(t, changeBodies, 2)
+	assert.Contains(t, changeBodies[0], "<Action>UPSERT</Action>")
+	assert.Contains(t, changeBodies[1], "<Action>DELETE</Action>")
+}
+
+func TestRoute53ChangeRecordsInvalidDeleteBatch(t *testing.T) {
+	// the current values of each record set in the hosted zone
+	current := map[string][]string{
+		"_acme-challenge.a.example.com.": {"key1"},
+		"_acme-challenge.b.example.com.": {"key2", "other"},
+		"_acme-challenge.d.example.com.": {"key4"},
+	}
+
+	var lock sync.Mutex
+	var changeBodies []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/xml")
+		switch r.URL.Path {
+		case "/2013-04-01/hostedzone/ABCDEFG/rrset":
+			name := r.URL.Query().Get("name")
+			var records string
+			for _, v := range current[name] {
+				records += fmt.Sprintf("<ResourceRecord><Value>%q</Value></ResourceRecord>", v)
+			}
+			if len(records) == 0 {
+				// Route 53 returns the next record set if there is none
+				// with the requested name
+				name, records = "_acme-challenge.z.example.com.", `<ResourceRecord><Value>"z"</Value></ResourceRecord>`
+			}
+			_, _ = fmt.Fprintf(w, ListResourceRecordSetsResponse, name, records)
+		case "/2013-04-01/hostedzone/ABCDEFG/rrset/":
+			b, err := ioutil.ReadAll(r.Body)
+			require.NoError(t, err)
+			body := string(b)
+			switch {
+			case strings.Count(body, "<Change>") > 1:
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(ChangeResourceRecordSetsInvalidChangeBatchResponse))
+			case strings.Contains(body, "_acme-challenge.d.example.com."):
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte(ChangeResourceRecordSets403Response))
+			default:
+				lock.Lock()
+				changeBodies = append(changeBodies, body)
+				lock.Unlock()
+				_, _ = w.Write([]byte(ChangeResourceRecordSetsResponse))
+			}
+		case "/2013-04-01/change/123456":
+			_, _ = w.Write([]byte(GetChangeResponse))
+		default:
+			require.FailNow(t, fmt.Sprintf("Requested path not found in response map: %s", r.URL.Path))
+		}
+	}))
+	defer ts.Close()
+
+	provider, err := makeRoute53Provider(ts)
+	require.NoError(t, err, "Expected to make a Route 53 provider without error")
+	provider.hostedZoneID = "ABCDEFG"
+
+	err = provider.ChangeRecords([]util.TXTChange{
+		{Action: util.ChangeActionCleanUp, Domain: "a.example.com", FQDN: "_acme-challenge.a.example.com.", Value: "key1"},
+		{Action: util.ChangeActionCleanUp, Domain: "b.example.com", FQDN: "_acme-challenge.b.example.com.", Value: "key2"},
+		{Action: util.ChangeActionCleanUp, Domain: "c.example.com", FQDN: "_acme-challenge.c.example.com.", Value: "key3"},
+		{Action: util.ChangeActionCleanUp, Domain: "d.example.com", FQDN: "_acme-challenge.d.example.com.", Value: "key4"},
+	})
+	// The record set that could not be changed should be reported, but not
+	// stop the others from being cleaned up.
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "AccessDenied")
+
+	require.Len(t, changeBodies, 2)
+	// the only value of the record set is deleted with the record set
+	assert.Contains(t, changeBodies[0], "<Action>DELETE</Action>")
+	assert.Contains(t, changeBodies[0], "_acme-challenge.a.example.com.")
+	assert.Contains(t, changeBodies[0], "&#34;key1&#34;")
+	// the remaining values of the record set are kept
+	assert.Contains(t, changeBodies[1], "<Action>UPSERT</Action>")
+	assert.Contains(t, changeBodies[1], "_acme-challenge.b.example.com.")
+	assert.Contains(t, changeBodies[1], "&#34;other&#34;")
+	assert.NotContains(t, changeBodies[1], "&#34;key2&#34;")
+}
+
 func TestAssumeRole(t *testing.T) {
 	creds := &sts.Credentials{
 		AccessKeyId:     aws.String("foo"),
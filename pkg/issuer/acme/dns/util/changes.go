@@ -0,0 +1,40 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+// ChangeAction is the type of change being made to a TXT record.
+type ChangeAction string
+
+const (
+	// ChangeActionPresent adds a value to a TXT record.
+	ChangeActionPresent ChangeAction = "Present"
+	// ChangeActionCleanUp removes a value from a TXT record.
+	ChangeActionCleanUp ChangeAction = "CleanUp"
+)
+
+// TXTChange is a single change to a DNS01 challenge TXT record. DNS providers
+// that are able to apply several changes in a single API request accept a
+// list of TXTChanges so that changes for many challenges can be batched.
+type TXTChange struct {
+	Action ChangeAction
+	// Domain is the domain name the challenge is for.
+	Domain string
+	// FQDN is the fully qualified name of the TXT record.
+	FQDN string
+	// Value is the TXT record value.
+	Value string
+}
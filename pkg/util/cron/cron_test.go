@@ -0,0 +1,192 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseErrors(t *testing.T) {
+	for _, spec := range []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"*-5 * * * *",
+		"a * * * *",
+		"@every 1h",
+		"1-2-3 * * * *",
+		"/5 * * * *",
+		"5/x * * * *",
+		"1,,2 * * * *",
+		"* * 32 * *",
+		"* * * * sat-sun",
+		"* * * jan-mar/0 *",
+	} {
+		if _, err := Parse(spec); err == nil {
+			t.Errorf("expected an error parsing %q", spec)
+		}
+	}
+}
+
+func TestNext(t *testing.T) {
+	// A Wednesday
+	from := time.Date(2021, 3, 17, 10, 30, 15, 0, time.UTC)
+	tests := map[string]struct {
+		spec     string
+		from     time.Time
+		expected time.Time
+	}{
+		"every minute": {
+			spec:     "* * * * *",
+			expected: time.Date(2021, 3, 17, 10, 31, 0, 0, time.UTC),
+		},
+		"3am on Sundays": {
+			spec:     "0 3 * * SUN",
+			expected: time.Date(2021, 3, 21, 3, 0, 0, 0, time.UTC),
+		},
+		"7 is Sunday": {
+			spec:     "0 3 * * 7",
+			expected: time.Date(2021, 3, 21, 3, 0, 0, 0, time.UTC),
+		},
+		"steps and lists": {
+			spec:     "*/20 9,11 * * *",
+			expected: time.Date(2021, 3, 17, 11, 0, 0, 0, time.UTC),
+		},
+		"ranges with steps": {
+			spec:     "10-50/20 10 * * *",
+			expected: time.Date(2021, 3, 17, 10, 50, 0, 0, time.UTC),
+		},
+		"value with a step runs to the end of the range": {
+			spec:     "5/15 * * * *",
+			expected: time.Date(2021, 3, 17, 10, 35, 0, 0, time.UTC),
+		},
+		"step larger than the range matches only its start": {
+			spec:     "0-5/10 10 * * *",
+			expected: time.Date(2021, 3, 18, 10, 0, 0, 0, time.UTC),
+		},
+		"month steps": {
+			spec:     "0 0 1 */3 *",
+			expected: time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"hour range later in the day": {
+			spec:     "0 22-23 * * *",
+			expected: time.Date(2021, 3, 17, 22, 0, 0, 0, time.UTC),
+		},
+		"day of week range": {
+			spec:     "0 9 * * MON-FRI",
+			expected: time.Date(2021, 3, 18, 9, 0, 0, 0, time.UTC),
+		},
+		"day of week range ending in 7 includes Sunday": {
+			spec:     "0 9 * * 6-7",
+			from:     time.Date(2021, 3, 20, 10, 0, 0, 0, time.UTC),
+			expected: time.Date(2021, 3, 21, 9, 0, 0, 0, time.UTC),
+		},
+		"day of week names are case insensitive": {
+			spec:     "0 0 * * Sat",
+			expected: time.Date(2021, 3, 20, 0, 0, 0, 0, time.UTC),
+		},
+		"day of week only": {
+			spec:     "0 0 * * mon",
+			expected: time.Date(2021, 3, 22, 0, 0, 0, 0, time.UTC),
+		},
+		"day of month only": {
+			spec:     "0 0 25 * *",
+			expected: time.Date(2021, 3, 25, 0, 0, 0, 0, time.UTC),
+		},
+		"day of month skips months that are too short": {
+			spec:     "0 0 31 * *",
+			from:     time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC),
+			expected: time.Date(2021, 5, 31, 0, 0, 0, 0, time.UTC),
+		},
+		"day of month range or day of week": {
+			spec:     "0 0 25-26 * sat",
+			expected: time.Date(2021, 3, 20, 0, 0, 0, 0, time.UTC),
+		},
+		"day of month and day of week in a restricted month": {
+			spec:     "0 0 1 jun mon",
+			expected: time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"year rollover": {
+			spec:     "0 0 1 1 *",
+			expected: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"month names": {
+			spec:     "0 0 1 jun *",
+			expected: time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"day of month or day of week": {
+			spec:     "0 0 20 * mon",
+			expected: time.Date(2021, 3, 20, 0, 0, 0, 0, time.UTC),
+		},
+		"descriptor": {
+			spec:     "@weekly",
+			expected: time.Date(2021, 3, 21, 0, 0, 0, 0, time.UTC),
+		},
+		"leap day": {
+			spec:     "0 0 29 2 *",
+			expected: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+		},
+		"never": {
+			spec:     "0 0 30 2 *",
+			expected: time.Time{},
+		},
+		"exact match is not returned": {
+			spec:     "0 3 * * *",
+			from:     time.Date(2021, 3, 17, 3, 0, 0, 0, time.UTC),
+			expected: time.Date(2021, 3, 18, 3, 0, 0, 0, time.UTC),
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			s, err := Parse(test.spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			f := test.from
+			if f.IsZero() {
+				f = from
+			}
+			if next := s.Next(f); !next.Equal(test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, next)
+			}
+		})
+	}
+}
+
+func TestNextInLocation(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := Parse("0 3 * * *")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// During British Summer Time, 3am in London is 2am UTC
+	next := s.Next(time.Date(2021, 7, 1, 12, 0, 0, 0, time.UTC).In(loc))
+	if expected := time.Date(2021, 7, 2, 2, 0, 0, 0, time.UTC); !next.Equal(expected) {
+		t.Errorf("expected %v, got %v", expected, next)
+	}
+}
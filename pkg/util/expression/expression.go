@@ -0,0 +1,130 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package expression compiles and evaluates the CEL expressions of
+// CertificateRequestPolicies.
+package expression
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/checker"
+	"github.com/google/cel-go/checker/decls"
+	"github.com/google/cel-go/common/types"
+	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+	utilcache "k8s.io/apimachinery/pkg/util/cache"
+)
+
+// RequestVariable is the name of the variable that expressions are evaluated
+// against. It holds the decoded CSR and the metadata of the
+// CertificateRequest being evaluated.
+const RequestVariable = "request"
+
+var (
+	envOnce sync.Once
+	env     *cel.Env
+	envErr  error
+)
+
+func getEnv() (*cel.Env, error) {
+	envOnce.Do(func() {
+		env, envErr = cel.NewEnv(
+			cel.Declarations(decls.NewVar(RequestVariable, decls.NewMapType(decls.String, decls.Dyn))),
+		)
+	})
+	return env, envErr
+}
+
+// Compile parses and type checks the expression, which must evaluate to a
+// bool, and returns a program that may be evaluated with Evaluate.
+func Compile(expression string) (cel.Program, error) {
+	env, err := getEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	if t := ast.ResultType(); t.GetPrimitive() != exprpb.Type_BOOL && t.GetDyn() == nil {
+		return nil, fmt.Errorf("expression must evaluate to a bool, not %s", checker.FormatCheckedType(t))
+	}
+
+	return env.Program(ast)
+}
+
+// Evaluate evaluates the program against the given value of the request
+// variable, and returns whether it evaluated to true.
+func Evaluate(program cel.Program, request map[string]interface{}) (bool, error) {
+	val, _, err := program.Eval(map[string]interface{}{RequestVariable: request})
+	if err != nil {
+		return false, err
+	}
+
+	result, ok := val.(types.Bool)
+	if !ok {
+		return false, fmt.Errorf("expression evaluated to %v, not a bool", val)
+	}
+	return bool(result), nil
+}
+
+const (
+	// defaultCacheSize is the number of compiled expressions that a Cache
+	// holds. The least recently used expressions, such as those of deleted
+	// or updated policies, are evicted first.
+	defaultCacheSize = 1024
+
+	// cacheTTL is how long a compiled expression is cached for before it is
+	// compiled again.
+	cacheTTL = time.Hour
+)
+
+type compiled struct {
+	program cel.Program
+	err     error
+}
+
+// Cache compiles expressions once, and caches the result for subsequent
+// evaluations of the same expression. The number of cached expressions is
+// bounded. It is safe for concurrent use.
+type Cache struct {
+	compiled *utilcache.LRUExpireCache
+}
+
+// NewCache returns an empty Cache.
+func NewCache() *Cache {
+	return newCache(defaultCacheSize)
+}
+
+func newCache(size int) *Cache {
+	return &Cache{compiled: utilcache.NewLRUExpireCache(size)}
+}
+
+// Compile returns the compiled program for the expression, compiling it if
+// it is not cached.
+func (c *Cache) Compile(expression string) (cel.Program, error) {
+	if result, ok := c.compiled.Get(expression); ok {
+		return result.(compiled).program, result.(compiled).err
+	}
+
+	program, err := Compile(expression)
+	c.compiled.Add(expression, compiled{program: program, err: err}, cacheTTL)
+	return program, err
+}
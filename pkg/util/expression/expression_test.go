@@ -0,0 +1,127 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package expression
+
+import (
+	"testing"
+)
+
+func TestCompileAndEvaluate(t *testing.T) {
+	request := map[string]interface{}{
+		"namespace": "sandbox",
+		"groups":    []string{"system:authenticated", "developers"},
+		"dnsNames":  []string{"app.sandbox.svc", "api.sandbox.svc"},
+		"isCA":      false,
+	}
+
+	tests := map[string]struct {
+		expression  string
+		compileErr  bool
+		expected    bool
+		evaluateErr bool
+	}{
+		"all DNS names in the requesting namespace": {
+			expression: "request.dnsNames.all(n, n.endsWith(request.namespace + '.svc'))",
+			expected:   true,
+		},
+		"requester is in a group": {
+			expression: "'admins' in request.groups",
+			expected:   false,
+		},
+		"bool field": {
+			expression: "!request.isCA",
+			expected:   true,
+		},
+		"syntax error": {
+			expression: "request.dnsNames.all(",
+			compileErr: true,
+		},
+		"non-bool result": {
+			expression: "request.namespace + 'x'",
+			compileErr: true,
+		},
+		"undeclared variable": {
+			expression: "certificate.isCA",
+			compileErr: true,
+		},
+		"missing field": {
+			expression:  "request.missing == 'x'",
+			evaluateErr: true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			program, err := Compile(test.expression)
+			if test.compileErr != (err != nil) {
+				t.Fatalf("expected compile error %t, got %v", test.compileErr, err)
+			}
+			if err != nil {
+				return
+			}
+
+			result, err := Evaluate(program, request)
+			if test.evaluateErr != (err != nil) {
+				t.Fatalf("expected evaluation error %t, got %v", test.evaluateErr, err)
+			}
+			if result != test.expected {
+				t.Errorf("expected %t, got %t", test.expected, result)
+			}
+		})
+	}
+}
+
+func TestCache(t *testing.T) {
+	c := NewCache()
+	first, err := c.Compile("true")
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := c.Compile("true")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first != second {
+		t.Errorf("expected the expression to be compiled once")
+	}
+	if _, err := c.Compile("("); err == nil {
+		t.Errorf("expected an error compiling an invalid expression")
+	}
+}
+
+func TestCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	c := newCache(2)
+	first, err := c.Compile("true")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Compile("false"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Compile("1 == 1"); err != nil {
+		t.Fatal(err)
+	}
+	if keys := c.compiled.Keys(); len(keys) != 2 {
+		t.Errorf("expected the cache to hold 2 expressions, got %v", keys)
+	}
+	again, err := c.Compile("true")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first == again {
+		t.Errorf("expected the least recently used expression to have been evicted")
+	}
+}
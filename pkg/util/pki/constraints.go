@@ -0,0 +1,191 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/x509"
+	"fmt"
+	"strings"
+	"time"
+
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	v1 "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// ConstrainedRequest holds the properties of a requested certificate that are
+// checked against the constraints of the issuer that is asked to sign it.
+type ConstrainedRequest struct {
+	Duration            time.Duration
+	IsCA                bool
+	PrivateKeyAlgorithm v1.PrivateKeyAlgorithm
+	PrivateKeySize      int
+	CommonName          string
+	DNSNames            []string
+	IPAddresses         []string
+	URIs                []string
+	EmailAddresses      []string
+}
+
+// ConstrainedRequestForCSR returns the properties of the certificate requested
+// by the given x509 certificate request, with the given duration and isCA.
+func ConstrainedRequestForCSR(csr *x509.CertificateRequest, duration time.Duration, isCA bool) (ConstrainedRequest, error) {
+	algorithm, size, err := PublicKeyAlgorithmAndSize(csr.PublicKey)
+	if err != nil {
+		return ConstrainedRequest{}, err
+	}
+
+	return ConstrainedRequest{
+		Duration:            duration,
+		IsCA:                isCA,
+		PrivateKeyAlgorithm: algorithm,
+		PrivateKeySize:      size,
+		CommonName:          csr.Subject.CommonName,
+		DNSNames:            csr.DNSNames,
+		IPAddresses:         IPAddressesToString(csr.IPAddresses),
+		URIs:                URLsToString(csr.URIs),
+		EmailAddresses:      csr.EmailAddresses,
+	}, nil
+}
+
+// ConstrainedRequestForCertificate returns the properties of the certificate
+// that will be requested for the given Certificate, applying the same
+// defaults as are used when its private key is generated.
+func ConstrainedRequestForCertificate(crt *v1.Certificate) ConstrainedRequest {
+	req := ConstrainedRequest{
+		Duration:            v1.DefaultCertificateDuration,
+		IsCA:                crt.Spec.IsCA,
+		PrivateKeyAlgorithm: v1.RSAKeyAlgorithm,
+		CommonName:          crt.Spec.CommonName,
+		DNSNames:            crt.Spec.DNSNames,
+		IPAddresses:         crt.Spec.IPAddresses,
+		URIs:                crt.Spec.URIs,
+		EmailAddresses:      crt.Spec.EmailAddresses,
+	}
+	if crt.Spec.Duration != nil {
+		req.Duration = crt.Spec.Duration.Duration
+	}
+	if crt.Spec.PrivateKey != nil {
+		if len(crt.Spec.PrivateKey.Algorithm) > 0 {
+			req.PrivateKeyAlgorithm = crt.Spec.PrivateKey.Algorithm
+		}
+		req.PrivateKeySize = crt.Spec.PrivateKey.Size
+	}
+
+	if req.PrivateKeySize == 0 {
+		switch req.PrivateKeyAlgorithm {
+		case v1.RSAKeyAlgorithm:
+			req.PrivateKeySize = MinRSAKeySize
+		case v1.ECDSAKeyAlgorithm:
+			req.PrivateKeySize = ECCurve256
+		}
+	}
+
+	return req
+}
+
+// ConstrainedRequestsForCertificate returns the properties of every
+// certificate that will be requested for the given Certificate: the one for
+// its primary private key, followed by one for each of its private key
+// profiles, which share everything but the private key.
+func ConstrainedRequestsForCertificate(crt *v1.Certificate) []ConstrainedRequest {
+	reqs := []ConstrainedRequest{ConstrainedRequestForCertificate(crt)}
+	for _, profile := range crt.Spec.PrivateKeyProfiles {
+		profileCrt := crt.DeepCopy()
+		profileCrt.Spec.PrivateKey = profile.PrivateKey.DeepCopy()
+		profileCrt.Spec.PrivateKeyProfiles = nil
+		reqs = append(reqs, ConstrainedRequestForCertificate(profileCrt))
+	}
+	return reqs
+}
+
+// CheckIssuerConstraints returns an error describing every way in which the
+// given request violates the issuer's constraints, or nil if the request
+// satisfies them or the issuer has no constraints.
+func CheckIssuerConstraints(constraints *v1.IssuerConstraints, req ConstrainedRequest) error {
+	if constraints == nil {
+		return nil
+	}
+
+	var errs []error
+	if constraints.MinDuration != nil && req.Duration < constraints.MinDuration.Duration {
+		errs = append(errs, fmt.Errorf("duration %s is less than the minimum duration %s", req.Duration, constraints.MinDuration.Duration))
+	}
+	if constraints.MaxDuration != nil && req.Duration > constraints.MaxDuration.Duration {
+		errs = append(errs, fmt.Errorf("duration %s is greater than the maximum duration %s", req.Duration, constraints.MaxDuration.Duration))
+	}
+
+	if req.IsCA && !constraints.AllowCA {
+		errs = append(errs, fmt.Errorf("CA certificates are not allowed"))
+	}
+
+	if len(constraints.AllowedPrivateKeys) > 0 && !privateKeyAllowed(constraints.AllowedPrivateKeys, req.PrivateKeyAlgorithm, req.PrivateKeySize) {
+		errs = append(errs, fmt.Errorf("%s private key of size %d is not allowed", req.PrivateKeyAlgorithm, req.PrivateKeySize))
+	}
+
+	if len(constraints.AllowedDomains) > 0 {
+		// The common name is commonly treated as a DNS name, so it is
+		// constrained in the same way to prevent it from being used to
+		// request a certificate for any other domain.
+		if len(req.CommonName) > 0 && !domainAllowed(constraints.AllowedDomains, req.CommonName) {
+			errs = append(errs, fmt.Errorf("common name %q is not within the allowed domains", req.CommonName))
+		}
+		for _, dnsName := range req.DNSNames {
+			if !domainAllowed(constraints.AllowedDomains, dnsName) {
+				errs = append(errs, fmt.Errorf("DNS name %q is not within the allowed domains", dnsName))
+			}
+		}
+		for _, email := range req.EmailAddresses {
+			if i := strings.LastIndex(email, "@"); i < 0 || !domainAllowed(constraints.AllowedDomains, email[i+1:]) {
+				errs = append(errs, fmt.Errorf("email address %q is not within the allowed domains", email))
+			}
+		}
+		// IP addresses and URIs cannot be checked against the allowed
+		// domains, so are not allowed at all.
+		for _, ip := range req.IPAddresses {
+			errs = append(errs, fmt.Errorf("IP address %q is not allowed when allowed domains are set", ip))
+		}
+		for _, uri := range req.URIs {
+			errs = append(errs, fmt.Errorf("URI %q is not allowed when allowed domains are set", uri))
+		}
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+func privateKeyAllowed(allowed []v1.IssuerPrivateKeyConstraint, algorithm v1.PrivateKeyAlgorithm, size int) bool {
+	for _, key := range allowed {
+		if key.Algorithm != algorithm {
+			continue
+		}
+		if algorithm == v1.Ed25519KeyAlgorithm || size >= key.MinSize {
+			return true
+		}
+	}
+	return false
+}
+
+func domainAllowed(allowed []string, dnsName string) bool {
+	dnsName = strings.ToLower(strings.TrimPrefix(dnsName, "*."))
+	for _, domain := range allowed {
+		domain = strings.ToLower(domain)
+		if dnsName == domain || strings.HasSuffix(dnsName, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
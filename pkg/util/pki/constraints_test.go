@@ -0,0 +1,243 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+)
+
+func TestCheckIssuerConstraints(t *testing.T) {
+	constraints := &v1.IssuerConstraints{
+		MinDuration: &metav1.Duration{Duration: time.Hour},
+		MaxDuration: &metav1.Duration{Duration: time.Hour * 24 * 90},
+		AllowedPrivateKeys: []v1.IssuerPrivateKeyConstraint{
+			{Algorithm: v1.RSAKeyAlgorithm, MinSize: 3072},
+			{Algorithm: v1.Ed25519KeyAlgorithm},
+		},
+		AllowedDomains: []string{"example.com"},
+	}
+	valid := ConstrainedRequest{
+		Duration:            time.Hour * 24,
+		PrivateKeyAlgorithm: v1.RSAKeyAlgorithm,
+		PrivateKeySize:      4096,
+		DNSNames:            []string{"example.com", "*.foo.Example.com"},
+	}
+
+	tests := map[string]struct {
+		constraints *v1.IssuerConstraints
+		mutate      func(*ConstrainedRequest)
+		expErr      string
+	}{
+		"no constraints": {
+			mutate: func(req *ConstrainedRequest) { req.IsCA = true },
+		},
+		"valid request": {
+			constraints: constraints,
+		},
+		"duration too short": {
+			constraints: constraints,
+			mutate:      func(req *ConstrainedRequest) { req.Duration = time.Minute },
+			expErr:      "duration 1m0s is less than the minimum duration 1h0m0s",
+		},
+		"duration too long": {
+			constraints: constraints,
+			mutate:      func(req *ConstrainedRequest) { req.Duration = time.Hour * 24 * 365 * 100 },
+			expErr:      "duration 876000h0m0s is greater than the maximum duration 2160h0m0s",
+		},
+		"CA not allowed": {
+			constraints: constraints,
+			mutate:      func(req *ConstrainedRequest) { req.IsCA = true },
+			expErr:      "CA certificates are not allowed",
+		},
+		"private key too small": {
+			constraints: constraints,
+			mutate:      func(req *ConstrainedRequest) { req.PrivateKeySize = 2048 },
+			expErr:      "RSA private key of size 2048 is not allowed",
+		},
+		"private key algorithm not allowed": {
+			constraints: constraints,
+			mutate: func(req *ConstrainedRequest) {
+				req.PrivateKeyAlgorithm, req.PrivateKeySize = v1.ECDSAKeyAlgorithm, 521
+			},
+			expErr: "ECDSA private key of size 521 is not allowed",
+		},
+		"Ed25519 private key allowed": {
+			constraints: constraints,
+			mutate: func(req *ConstrainedRequest) {
+				req.PrivateKeyAlgorithm, req.PrivateKeySize = v1.Ed25519KeyAlgorithm, 0
+			},
+		},
+		"DNS names outside of the allowed domains": {
+			constraints: constraints,
+			mutate: func(req *ConstrainedRequest) {
+				req.DNSNames = []string{"example.com", "badexample.com", "*.com"}
+			},
+			expErr: `[DNS name "badexample.com" is not within the allowed domains, DNS name "*.com" is not within the allowed domains]`,
+		},
+		"common name outside of the allowed domains": {
+			constraints: constraints,
+			mutate:      func(req *ConstrainedRequest) { req.CommonName = "foo.org" },
+			expErr:      `common name "foo.org" is not within the allowed domains`,
+		},
+		"email addresses outside of the allowed domains": {
+			constraints: constraints,
+			mutate: func(req *ConstrainedRequest) {
+				req.EmailAddresses = []string{"user@foo.example.com", "user@foo.org", "user"}
+			},
+			expErr: `[email address "user@foo.org" is not within the allowed domains, email address "user" is not within the allowed domains]`,
+		},
+		"IP addresses and URIs with allowed domains": {
+			constraints: constraints,
+			mutate: func(req *ConstrainedRequest) {
+				req.IPAddresses = []string{"10.0.0.1"}
+				req.URIs = []string{"spiffe://example.com/foo"}
+			},
+			expErr: `[IP address "10.0.0.1" is not allowed when allowed domains are set, URI "spiffe://example.com/foo" is not allowed when allowed domains are set]`,
+		},
+		"IP addresses and URIs without allowed domains": {
+			constraints: &v1.IssuerConstraints{},
+			mutate: func(req *ConstrainedRequest) {
+				req.IPAddresses = []string{"10.0.0.1"}
+				req.URIs = []string{"spiffe://example.com/foo"}
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := valid
+			if test.mutate != nil {
+				test.mutate(&req)
+			}
+			err := CheckIssuerConstraints(test.constraints, req)
+			if test.expErr == "" {
+				if err != nil {
+					t.Errorf("expected no error, got %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != test.expErr {
+				t.Errorf("expected error %q, got %v", test.expErr, err)
+			}
+		})
+	}
+}
+
+func TestConstrainedRequestForCertificate(t *testing.T) {
+	crt := &v1.Certificate{
+		Spec: v1.CertificateSpec{
+			IsCA:       true,
+			DNSNames:   []string{"example.com"},
+			PrivateKey: &v1.CertificatePrivateKey{Algorithm: v1.ECDSAKeyAlgorithm},
+		},
+	}
+	req := ConstrainedRequestForCertificate(crt)
+	if req.Duration != v1.DefaultCertificateDuration || !req.IsCA ||
+		req.PrivateKeyAlgorithm != v1.ECDSAKeyAlgorithm || req.PrivateKeySize != ECCurve256 {
+		t.Errorf("unexpected request for certificate: %+v", req)
+	}
+}
+
+func TestConstrainedRequestsForCertificate(t *testing.T) {
+	crt := &v1.Certificate{
+		Spec: v1.CertificateSpec{
+			DNSNames:   []string{"example.com"},
+			PrivateKey: &v1.CertificatePrivateKey{Algorithm: v1.RSAKeyAlgorithm, Size: 4096},
+			PrivateKeyProfiles: []v1.CertificatePrivateKeyProfile{
+				{Name: "ecdsa", PrivateKey: v1.CertificatePrivateKey{Algorithm: v1.ECDSAKeyAlgorithm}},
+			},
+		},
+	}
+	reqs := ConstrainedRequestsForCertificate(crt)
+	if len(reqs) != 2 {
+		t.Fatalf("expected a request for the primary private key and each profile, got %d", len(reqs))
+	}
+	if reqs[0].PrivateKeyAlgorithm != v1.RSAKeyAlgorithm || reqs[0].PrivateKeySize != 4096 {
+		t.Errorf("unexpected request for the primary private key: %+v", reqs[0])
+	}
+	if reqs[1].PrivateKeyAlgorithm != v1.ECDSAKeyAlgorithm || reqs[1].PrivateKeySize != ECCurve256 ||
+		len(reqs[1].DNSNames) != 1 || reqs[1].DNSNames[0] != "example.com" {
+		t.Errorf("unexpected request for the private key profile: %+v", reqs[1])
+	}
+}
+
+func TestConstrainedRequestForCSRAllowedDomains(t *testing.T) {
+	constraints := &v1.IssuerConstraints{AllowedDomains: []string{"example.com"}}
+	pk, err := GenerateECPrivateKey(ECCurve256)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		template *x509.CertificateRequest
+		expErr   string
+	}{
+		"common name and DNS names within the allowed domains": {
+			template: &x509.CertificateRequest{
+				Subject:  pkix.Name{CommonName: "example.com"},
+				DNSNames: []string{"www.example.com"},
+			},
+		},
+		"common name only outside of the allowed domains": {
+			template: &x509.CertificateRequest{
+				Subject: pkix.Name{CommonName: "foo.org"},
+			},
+			expErr: `common name "foo.org" is not within the allowed domains`,
+		},
+		"IP address SAN": {
+			template: &x509.CertificateRequest{
+				DNSNames:    []string{"example.com"},
+				IPAddresses: []net.IP{net.ParseIP("10.0.0.1")},
+			},
+			expErr: `IP address "10.0.0.1" is not allowed when allowed domains are set`,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			csrDER, err := x509.CreateCertificateRequest(nil, test.template, pk)
+			if err != nil {
+				t.Fatal(err)
+			}
+			csr, err := x509.ParseCertificateRequest(csrDER)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			req, err := ConstrainedRequestForCSR(csr, time.Hour, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = CheckIssuerConstraints(constraints, req)
+			if test.expErr == "" {
+				if err != nil {
+					t.Errorf("expected no error, got %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != test.expErr {
+				t.Errorf("expected error %q, got %v", test.expErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+)
+
+// CertificateFingerprint returns the hex encoded SHA-256 fingerprint of the
+// DER encoding of the given certificate.
+func CertificateFingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(sum[:])
+}
+
+// IsSignedBy returns whether cert was signed by the private key of signer.
+// Unlike x509.Certificate.CheckSignatureFrom, signer is not required to be a
+// valid CA certificate.
+func IsSignedBy(cert, signer *x509.Certificate) bool {
+	if !bytes.Equal(cert.RawIssuer, signer.RawSubject) {
+		return false
+	}
+	return signer.CheckSignature(cert.SignatureAlgorithm, cert.RawTBSCertificate, cert.Signature) == nil
+}
+
+// FindSigner returns the first certificate in candidates that signed cert, or
+// nil if none of them did.
+func FindSigner(cert *x509.Certificate, candidates []*x509.Certificate) *x509.Certificate {
+	for _, c := range candidates {
+		if IsSignedBy(cert, c) {
+			return c
+		}
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/x509"
+	"testing"
+)
+
+func TestFindSigner(t *testing.T) {
+	root := mustCreateBundle(t, nil, "root")
+	intermediate := mustCreateBundle(t, root, "intermediate")
+	leaf := mustCreateBundle(t, intermediate, "leaf")
+	// a CA with the same subject as the intermediate but a different key,
+	// as happens when a CA's key is rotated
+	rotated := mustCreateBundle(t, root, "intermediate")
+
+	if signer := FindSigner(leaf.cert, []*x509.Certificate{root.cert, intermediate.cert}); signer != intermediate.cert {
+		t.Errorf("expected the intermediate to be found as the signer, got %v", signer)
+	}
+	if signer := FindSigner(leaf.cert, []*x509.Certificate{root.cert, rotated.cert}); signer != nil {
+		t.Errorf("expected no signer to be found, got %v", signer.Subject)
+	}
+	if !IsSignedBy(root.cert, root.cert) {
+		t.Errorf("expected self-signed certificate to be signed by itself")
+	}
+
+	if CertificateFingerprint(intermediate.cert) == CertificateFingerprint(rotated.cert) {
+		t.Errorf("expected different certificates to have different fingerprints")
+	}
+	if fp := CertificateFingerprint(leaf.cert); len(fp) != 64 {
+		t.Errorf("expected a hex encoded SHA-256 fingerprint, got %q", fp)
+	}
+}
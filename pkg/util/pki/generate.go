@@ -189,6 +189,22 @@ func PublicKeyForPrivateKey(pk crypto.PrivateKey) (crypto.PublicKey, error) {
 	}
 }
 
+// PublicKeyAlgorithmAndSize returns the algorithm of the given public key, and
+// its size in bits for RSA keys or the curve size for ECDSA keys. The size of
+// Ed25519 keys is returned as 0.
+func PublicKeyAlgorithmAndSize(pub crypto.PublicKey) (v1.PrivateKeyAlgorithm, int, error) {
+	switch k := pub.(type) {
+	case *rsa.PublicKey:
+		return v1.RSAKeyAlgorithm, k.N.BitLen(), nil
+	case *ecdsa.PublicKey:
+		return v1.ECDSAKeyAlgorithm, k.Curve.Params().BitSize, nil
+	case ed25519.PublicKey:
+		return v1.Ed25519KeyAlgorithm, 0, nil
+	default:
+		return "", 0, fmt.Errorf("unsupported public key type: %T", pub)
+	}
+}
+
 // PublicKeyMatchesCertificate checks whether the given public key matches the
 // public key in the given x509.Certificate.
 // Returns false and no error if the public key is *not* the same as the certificate's key
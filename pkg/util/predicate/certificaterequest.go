@@ -35,3 +35,14 @@ func CertificateRequestRevision(revision int) Func {
 		return req.Annotations[cmapi.CertificateRequestRevisionAnnotationKey] == fmt.Sprintf("%d", revision)
 	}
 }
+
+// CertificateRequestPrivateKeyProfile returns a predicate that used to filter
+// CertificateRequest to only those created for the named private key profile.
+// An empty name selects the CertificateRequests created for the primary
+// private key.
+func CertificateRequestPrivateKeyProfile(name string) Func {
+	return func(obj runtime.Object) bool {
+		req := obj.(*cmapi.CertificateRequest)
+		return req.Annotations[cmapi.CertificateRequestPrivateKeyProfileAnnotationKey] == name
+	}
+}
@@ -76,3 +76,49 @@ func TestCertificateRequestRevision(t *testing.T) {
 		})
 	}
 }
+
+func TestCertificateRequestPrivateKeyProfile(t *testing.T) {
+	requestWithProfile := func(name string) *cmapi.CertificateRequest {
+		return &cmapi.CertificateRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{
+					cmapi.CertificateRequestPrivateKeyProfileAnnotationKey: name,
+				},
+			},
+		}
+	}
+	tests := map[string]struct {
+		profile  string
+		request  *cmapi.CertificateRequest
+		expected bool
+	}{
+		"returns true if profile matches": {
+			profile:  "ecdsa",
+			request:  requestWithProfile("ecdsa"),
+			expected: true,
+		},
+		"returns false if profile does not match": {
+			profile:  "rsa",
+			request:  requestWithProfile("ecdsa"),
+			expected: false,
+		},
+		"returns true for the primary key if profile is not set": {
+			profile:  "",
+			request:  &cmapi.CertificateRequest{},
+			expected: true,
+		},
+		"returns false for the primary key if profile is set": {
+			profile:  "",
+			request:  requestWithProfile("ecdsa"),
+			expected: false,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := CertificateRequestPrivateKeyProfile(test.profile)(test.request)
+			if got != test.expected {
+				t.Errorf("unexpected response: got=%t, exp=%t", got, test.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package truststore encodes trusted CA certificates as JKS and PKCS#12
+// truststores.
+package truststore
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/x509"
+	"fmt"
+	"time"
+
+	jks "github.com/pavel-v-chernykh/keystore-go"
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+// EncodePKCS12 encodes the given CA certificates as a PKCS#12 truststore
+// using the password provided.
+func EncodePKCS12(password string, cas []*x509.Certificate) ([]byte, error) {
+	return pkcs12.EncodeTrustStore(rand.Reader, cas, password)
+}
+
+// EncodeJKS encodes the given CA certificates as a JKS truststore using the
+// password provided. The first certificate is stored with the alias 'ca', and
+// any further certificates with the aliases 'ca-1', 'ca-2' and so on.
+func EncodeJKS(password []byte, cas []*x509.Certificate) ([]byte, error) {
+	ks := make(jks.KeyStore, len(cas))
+	for i, ca := range cas {
+		alias := "ca"
+		if i > 0 {
+			alias = fmt.Sprintf("ca-%d", i)
+		}
+		ks[alias] = &jks.TrustedCertificateEntry{
+			Entry: jks.Entry{
+				CreationDate: time.Now(),
+			},
+			Certificate: jks.Certificate{
+				Type:    "X509",
+				Content: ca.Raw,
+			},
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	if err := jks.Encode(buf, ks, password); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2021 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package truststore
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+
+	jks "github.com/pavel-v-chernykh/keystore-go"
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+func mustSelfSignCA(t *testing.T, commonName string) *x509.Certificate {
+	pk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, pk.Public(), pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert
+}
+
+func TestEncodePKCS12(t *testing.T) {
+	cas := []*x509.Certificate{mustSelfSignCA(t, "root-1"), mustSelfSignCA(t, "root-2")}
+
+	out, err := EncodePKCS12("changeit", cas)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	certs, err := pkcs12.DecodeTrustStore(out, "changeit")
+	if err != nil {
+		t.Fatalf("error decoding truststore: %v", err)
+	}
+	if len(certs) != len(cas) {
+		t.Fatalf("expected %d certificates, got %d", len(cas), len(certs))
+	}
+	for i := range cas {
+		if !certs[i].Equal(cas[i]) {
+			t.Errorf("certificate %d does not match", i)
+		}
+	}
+}
+
+func TestEncodeJKS(t *testing.T) {
+	cas := []*x509.Certificate{mustSelfSignCA(t, "root-1"), mustSelfSignCA(t, "root-2")}
+
+	out, err := EncodeJKS([]byte("changeit"), cas)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ks, err := jks.Decode(bytes.NewReader(out), []byte("changeit"))
+	if err != nil {
+		t.Fatalf("error decoding truststore: %v", err)
+	}
+	for alias, ca := range map[string]*x509.Certificate{"ca": cas[0], "ca-1": cas[1]} {
+		entry, ok := ks[alias].(*jks.TrustedCertificateEntry)
+		if !ok {
+			t.Errorf("expected a trusted certificate entry with alias %q", alias)
+			continue
+		}
+		if !bytes.Equal(entry.Certificate.Content, ca.Raw) {
+			t.Errorf("certificate with alias %q does not match", alias)
+		}
+	}
+}
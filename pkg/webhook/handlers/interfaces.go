@@ -23,6 +23,8 @@ import (
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	"k8s.io/client-go/kubernetes"
+
+	cmclient "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
 )
 
 type ValidatingAdmissionHook interface {
@@ -32,13 +34,19 @@ type ValidatingAdmissionHook interface {
 
 	// InitPlugins will initialise all plugins which are registered for this
 	// validating admission hook.
-	InitPlugins(client kubernetes.Interface)
+	InitPlugins(client kubernetes.Interface, cmClient cmclient.Interface)
 }
 
 type MutatingAdmissionHook interface {
 	// Admit is called to decide whether to accept the admission request. The returned AdmissionResponse may
 	// use the Patch field to mutate the object from the passed AdmissionRequest.
 	Mutate(ctx context.Context, admissionSpec *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse
+
+	// InitPlugins will initialise all plugins which are registered for this
+	// mutating admission hook. Informers started by the plugins are stopped
+	// when stopCh is closed. An error is returned if the plugins could not
+	// be initialised.
+	InitPlugins(client kubernetes.Interface, stopCh <-chan struct{}) error
 }
 
 type ConversionHook interface {
@@ -20,32 +20,78 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/go-logr/logr"
 	admissionv1 "k8s.io/api/admission/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
 
 	"github.com/jetstack/cert-manager/pkg/internal/api/mutation"
+	"github.com/jetstack/cert-manager/pkg/internal/apis/certmanager/namespacedefaults"
 	logf "github.com/jetstack/cert-manager/pkg/logs"
 )
 
+// defaultCacheSyncTimeout is how long InitPlugins waits for the caches of the
+// informers used by the mutation plugins to sync.
+const defaultCacheSyncTimeout = time.Minute
+
 type RegistryBackedMutator struct {
 	log      logr.Logger
 	decoder  runtime.Decoder
 	registry *mutation.Registry
+
+	// cacheSyncTimeout is how long InitPlugins waits for informer caches to
+	// sync before giving up.
+	cacheSyncTimeout time.Duration
 }
 
 func NewRegistryBackedMutator(log logr.Logger, scheme *runtime.Scheme, registry *mutation.Registry) *RegistryBackedMutator {
 	factory := serializer.NewCodecFactory(scheme)
 	return &RegistryBackedMutator{
-		log:      log,
-		decoder:  factory.UniversalDecoder(),
-		registry: registry,
+		log:              log,
+		decoder:          factory.UniversalDecoder(),
+		registry:         registry,
+		cacheSyncTimeout: defaultCacheSyncTimeout,
 	}
 }
 
+// InitPlugins registers the mutation functions that need a Kubernetes client,
+// such as the defaulting of Certificates from their Namespace's annotations.
+// The informers that these functions read from are run until stopCh is
+// closed. An error is returned if their caches do not sync within the
+// cache sync timeout.
+func (c *RegistryBackedMutator) InitPlugins(client kubernetes.Interface, stopCh <-chan struct{}) error {
+	factory := informers.NewSharedInformerFactory(client, time.Minute)
+	namespaces := factory.Core().V1().Namespaces()
+	namespaceInformer := namespaces.Informer()
+
+	factory.Start(stopCh)
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.cacheSyncTimeout)
+	defer cancel()
+	go func() {
+		select {
+		case <-stopCh:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	if !cache.WaitForCacheSync(ctx.Done(), namespaceInformer.HasSynced) {
+		return fmt.Errorf("timed out after %v waiting for namespace informer cache to sync", c.cacheSyncTimeout)
+	}
+
+	if err := namespacedefaults.New(c.log, namespaces.Lister()).AddToMutationRegistry(c.registry); err != nil {
+		return fmt.Errorf("failed to register namespace defaults: %v", err)
+	}
+
+	return nil
+}
+
 func (c *RegistryBackedMutator) Mutate(_ context.Context, admissionSpec *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
 	status := &admissionv1.AdmissionResponse{}
 	status.UID = admissionSpec.UID
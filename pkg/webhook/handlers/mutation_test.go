@@ -19,14 +19,18 @@ package handlers
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"reflect"
 	"testing"
+	"time"
 
 	"gomodules.xyz/jsonpatch/v2"
 	admissionv1 "k8s.io/api/admission/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	coretesting "k8s.io/client-go/testing"
 	"k8s.io/klog/v2/klogr"
 	"k8s.io/utils/diff"
 
@@ -123,3 +127,23 @@ func runAdmissionTest(t *testing.T, fn admissionFn, test admissionTestT) {
 		t.Errorf("Response was not as expected: %v", diff.ObjectGoPrintSideBySide(&test.expectedResponse, resp))
 	}
 }
+
+func TestInitPluginsCacheSyncTimeout(t *testing.T) {
+	scheme := runtime.NewScheme()
+	registry := mutation.NewRegistry(scheme)
+	install.Install(scheme)
+
+	cl := fake.NewSimpleClientset()
+	cl.PrependReactor("list", "namespaces", func(action coretesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("simulated error")
+	})
+
+	c := NewRegistryBackedMutator(klogr.New(), scheme, registry)
+	c.cacheSyncTimeout = 100 * time.Millisecond
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	if err := c.InitPlugins(cl, stopCh); err == nil {
+		t.Errorf("expected an error when the namespace informer cache does not sync")
+	}
+}
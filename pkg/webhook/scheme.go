@@ -24,6 +24,7 @@ import (
 	acmeinstall "github.com/jetstack/cert-manager/pkg/internal/apis/acme/install"
 	cminstall "github.com/jetstack/cert-manager/pkg/internal/apis/certmanager/install"
 	metainstall "github.com/jetstack/cert-manager/pkg/internal/apis/meta/install"
+	trustinstall "github.com/jetstack/cert-manager/pkg/internal/apis/trust/install"
 )
 
 // Define a Scheme that has all cert-manager API types registered, including
@@ -51,9 +52,11 @@ func init() {
 	cminstall.Install(Scheme)
 	acmeinstall.Install(Scheme)
 	metainstall.Install(Scheme)
+	trustinstall.Install(Scheme)
 
 	cminstall.InstallValidation(ValidationRegistry)
 	acmeinstall.InstallValidation(ValidationRegistry)
+	trustinstall.InstallValidation(ValidationRegistry)
 
 	cminstall.InstallMutation(MutationRegistry)
 }
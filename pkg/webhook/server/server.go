@@ -19,6 +19,7 @@ package server
 import (
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -99,6 +100,17 @@ type Server struct {
 	// provided by this CertificateSource.
 	CertificateSource servertls.CertificateSource
 
+	// ClientCAs, if specified, is the pool of CA certificates used to verify
+	// client certificates. Clients that do not present a certificate signed
+	// by one of these CAs will be rejected.
+	// A CertificateSource must be specified if this is set.
+	ClientCAs *x509.CertPool
+
+	// AllowedClientNames, if specified, restricts the clients verified using
+	// ClientCAs to those whose certificate has one of these names as its
+	// common name or as a DNS subject alternative name.
+	AllowedClientNames []string
+
 	ValidationWebhook handlers.ValidatingAdmissionHook
 	MutationWebhook   handlers.MutatingAdmissionHook
 	ConversionWebhook handlers.ConversionHook
@@ -151,6 +163,13 @@ func (s *Server) Run(stopCh <-chan struct{}) error {
 		healthzChan = s.startServer(l, internalStopCh, mux)
 	}
 
+	if s.CertificateSource == nil && s.ClientCAs != nil {
+		return errors.New("client certificate verification requires a CertificateSource")
+	}
+	if s.ClientCAs == nil && len(s.AllowedClientNames) > 0 {
+		return errors.New("allowed client names require client certificate verification")
+	}
+
 	// create a listener for actual webhook requests
 	l, err := net.Listen("tcp", s.ListenAddr)
 	if err != nil {
@@ -162,20 +181,14 @@ func (s *Server) Run(stopCh <-chan struct{}) error {
 	if s.CertificateSource != nil {
 		s.Log.V(logf.InfoLevel).Info("listening for secure connections", "address", s.ListenAddr)
 		certSourceChan = s.startCertificateSource(internalStopCh)
-		cipherSuites, err := ciphers.TLSCipherSuites(s.CipherSuites)
+		tlsConfig, err := s.tlsConfig()
 		if err != nil {
 			return err
 		}
-		minVersion, err := ciphers.TLSVersion(s.MinTLSVersion)
-		if err != nil {
-			return err
+		if s.ClientCAs != nil {
+			s.Log.V(logf.InfoLevel).Info("requiring verified client certificates", "allowed_client_names", s.AllowedClientNames)
 		}
-		l = tls.NewListener(l, &tls.Config{
-			GetCertificate:           s.CertificateSource.GetCertificate,
-			CipherSuites:             cipherSuites,
-			MinVersion:               minVersion,
-			PreferServerCipherSuites: true,
-		})
+		l = tls.NewListener(l, tlsConfig)
 	} else {
 		s.Log.V(logf.InfoLevel).Info("listening for insecure connections", "address", s.ListenAddr)
 	}
@@ -227,6 +240,57 @@ func (s *Server) Port() (int, error) {
 	return tcpAddr.Port, nil
 }
 
+// tlsConfig returns the TLS configuration used to serve webhook requests.
+func (s *Server) tlsConfig() (*tls.Config, error) {
+	cipherSuites, err := ciphers.TLSCipherSuites(s.CipherSuites)
+	if err != nil {
+		return nil, err
+	}
+	minVersion, err := ciphers.TLSVersion(s.MinTLSVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &tls.Config{
+		GetCertificate:           s.CertificateSource.GetCertificate,
+		CipherSuites:             cipherSuites,
+		MinVersion:               minVersion,
+		PreferServerCipherSuites: true,
+	}
+
+	if s.ClientCAs != nil {
+		cfg.ClientAuth = tls.RequireAndVerifyClientCert
+		cfg.ClientCAs = s.ClientCAs
+		if len(s.AllowedClientNames) > 0 {
+			cfg.VerifyConnection = s.verifyClientName
+		}
+	}
+
+	return cfg, nil
+}
+
+// verifyClientName rejects connections from clients whose verified
+// certificate does not have one of the AllowedClientNames as its common name
+// or as a DNS subject alternative name.
+func (s *Server) verifyClientName(cs tls.ConnectionState) error {
+	if len(cs.PeerCertificates) == 0 {
+		return errors.New("no client certificate was presented")
+	}
+
+	leaf := cs.PeerCertificates[0]
+	names := append([]string{leaf.Subject.CommonName}, leaf.DNSNames...)
+	for _, name := range names {
+		for _, allowed := range s.AllowedClientNames {
+			if name == allowed {
+				return nil
+			}
+		}
+	}
+
+	s.Log.V(logf.WarnLevel).Info("rejecting connection from client with a certificate that is not allowed", "names", names)
+	return fmt.Errorf("client certificate names %v are not allowed", names)
+}
+
 func (s *Server) startServer(l net.Listener, stopCh <-chan struct{}, handle http.Handler) <-chan error {
 	ch := make(chan error)
 	go func() {
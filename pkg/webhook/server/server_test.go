@@ -18,6 +18,9 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -84,3 +87,52 @@ func TestConvert(t *testing.T) {
 		})
 	}
 }
+
+func TestVerifyClientName(t *testing.T) {
+	tests := map[string]struct {
+		allowed []string
+		peers   []*x509.Certificate
+		err     string
+	}{
+		"no client certificate": {
+			allowed: []string{"front-proxy-client"},
+			err:     "no client certificate was presented",
+		},
+		"allowed common name": {
+			allowed: []string{"front-proxy-client"},
+			peers: []*x509.Certificate{{
+				Subject: pkix.Name{CommonName: "front-proxy-client"},
+			}},
+		},
+		"allowed DNS name": {
+			allowed: []string{"apiserver.example.com"},
+			peers: []*x509.Certificate{{
+				Subject:  pkix.Name{CommonName: "kube-apiserver"},
+				DNSNames: []string{"apiserver.example.com"},
+			}},
+		},
+		"name not allowed": {
+			allowed: []string{"front-proxy-client"},
+			peers: []*x509.Certificate{{
+				Subject:  pkix.Name{CommonName: "attacker"},
+				DNSNames: []string{"attacker.example.com"},
+			}},
+			err: "client certificate names [attacker attacker.example.com] are not allowed",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := &Server{
+				AllowedClientNames: tc.allowed,
+				Log:                &testingcmlogs.TestLogger{T: t},
+			}
+			err := s.verifyClientName(tls.ConnectionState{PeerCertificates: tc.peers})
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
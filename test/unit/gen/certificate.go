@@ -150,6 +150,21 @@ func SetCertificateLastFailureTime(p metav1.Time) CertificateModifier {
 	}
 }
 
+func SetCertificateFailedIssuanceAttempts(attempts int) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Status.FailedIssuanceAttempts = &attempts
+	}
+}
+
+func SetCertificateRetryBackoff(min, max time.Duration) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Spec.RetryBackoff = &v1.CertificateRetryBackoff{
+			Min: &metav1.Duration{Duration: min},
+			Max: &metav1.Duration{Duration: max},
+		}
+	}
+}
+
 func SetCertificateNotAfter(p metav1.Time) CertificateModifier {
 	return func(crt *v1.Certificate) {
 		crt.Status.NotAfter = &p
@@ -180,6 +195,12 @@ func SetCertificateNamespace(namespace string) CertificateModifier {
 	}
 }
 
+func SetCertificateCreationTimestamp(creationTimestamp metav1.Time) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.ObjectMeta.CreationTimestamp = creationTimestamp
+	}
+}
+
 func SetCertificateKeyUsages(usages ...v1.KeyUsage) CertificateModifier {
 	return func(crt *v1.Certificate) {
 		crt.Spec.Usages = usages
@@ -251,3 +272,12 @@ func SetCertificateRevisionHistoryLimit(limit int32) CertificateModifier {
 		crt.Spec.RevisionHistoryLimit = &limit
 	}
 }
+
+func SetCertificateSecretTemplate(annotations, labels map[string]string) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Spec.SecretTemplate = &v1.CertificateSecretTemplate{
+			Annotations: annotations,
+			Labels:      labels,
+		}
+	}
+}
@@ -340,6 +340,18 @@ func SetIssuerVenafi(a v1.VenafiIssuer) IssuerModifier {
 	}
 }
 
+func SetIssuerConstraints(c v1.IssuerConstraints) IssuerModifier {
+	return func(iss v1.GenericIssuer) {
+		iss.GetSpec().Constraints = &c
+	}
+}
+
+func SetIssuerNamespaceSelector(s metav1.LabelSelector) IssuerModifier {
+	return func(iss v1.GenericIssuer) {
+		iss.GetSpec().NamespaceSelector = &s
+	}
+}
+
 func AddIssuerCondition(c v1.IssuerCondition) IssuerModifier {
 	return func(iss v1.GenericIssuer) {
 		iss.GetStatus().Conditions = append(iss.GetStatus().Conditions, c)